@@ -0,0 +1,77 @@
+// Package atlas answers questions about the shape of the game map
+package atlas
+
+// Graph maps each location to the locations adjacent to it
+type Graph map[int32][]int32
+
+// IsAdjacent returns whether a player can step from one location straight into
+// another
+func (g Graph) IsAdjacent(from int32, to int32) bool {
+	for _, adjacent := range g[from] {
+		if adjacent == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ShortestPath returns the locations a player steps into on the shortest walk
+// between two locations, ending with the destination. the path is empty if the
+// player is already there and nil if the destination can't be reached
+func (g Graph) ShortestPath(from int32, to int32) []int32 {
+	if from == to {
+		return []int32{}
+	}
+
+	// breadth first search, remembering how each location was reached
+	previous := map[int32]int32{from: from}
+	queue := []int32{from}
+	for len(queue) > 0 {
+		location := queue[0]
+		queue = queue[1:]
+
+		for _, adjacent := range g[location] {
+			if _, seen := previous[adjacent]; seen {
+				continue
+			}
+			previous[adjacent] = location
+
+			if adjacent == to {
+				// walk back to the start and reverse
+				var path []int32
+				for step := to; step != from; step = previous[step] {
+					path = append(path, step)
+				}
+				for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+					path[i], path[j] = path[j], path[i]
+				}
+				return path
+			}
+			queue = append(queue, adjacent)
+		}
+	}
+
+	return nil
+}
+
+// Nearest returns the closest location to start, start included, that matches.
+// ties go to whichever location the search reaches first
+func (g Graph) Nearest(start int32, match func(location int32) bool) (int32, bool) {
+	seen := map[int32]bool{start: true}
+	queue := []int32{start}
+	for len(queue) > 0 {
+		location := queue[0]
+		queue = queue[1:]
+		if match(location) {
+			return location, true
+		}
+
+		for _, adjacent := range g[location] {
+			if !seen[adjacent] {
+				seen[adjacent] = true
+				queue = append(queue, adjacent)
+			}
+		}
+	}
+	return -1, false
+}
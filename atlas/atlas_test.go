@@ -0,0 +1,117 @@
+package atlas
+
+import (
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestShortestPath(t *testing.T) {
+	// 0 - 1 - 2 - 3
+	//  \_____4__/
+	// 5 stands alone
+	graph := Graph{
+		0: {1, 4},
+		1: {0, 2},
+		2: {1, 3},
+		3: {2, 4},
+		4: {0, 3},
+		5: {},
+	}
+
+	tests := []struct {
+		from, to int32
+		path     []int32
+	}{
+		{0, 0, []int32{}},
+		{0, 1, []int32{1}},
+		{0, 3, []int32{4, 3}},
+		{1, 3, []int32{2, 3}},
+		{0, 5, nil},
+		{5, 0, nil},
+	}
+
+	for _, test := range tests {
+		path := graph.ShortestPath(test.from, test.to)
+		if !reflect.DeepEqual(path, test.path) {
+			t.Errorf("path from %d to %d: expected %v, got %v", test.from, test.to, test.path, path)
+		}
+	}
+}
+
+func TestIsAdjacent(t *testing.T) {
+	graph := Graph{0: {1}, 1: {0}}
+	if !graph.IsAdjacent(0, 1) || !graph.IsAdjacent(1, 0) {
+		t.Error("expected 0 and 1 to be adjacent")
+	}
+	if graph.IsAdjacent(0, 0) || graph.IsAdjacent(0, 2) {
+		t.Error("expected 0 to only be adjacent to 1")
+	}
+}
+
+func TestNearest(t *testing.T) {
+	// 0 - 1 - 2 - 3
+	// 4 stands alone
+	graph := Graph{
+		0: {1},
+		1: {0, 2},
+		2: {1, 3},
+		3: {2},
+		4: {},
+	}
+
+	tests := []struct {
+		start   int32
+		held    []int32
+		nearest int32
+		found   bool
+	}{
+		{0, []int32{0, 3}, 0, true},
+		{0, []int32{2, 3}, 2, true},
+		{3, []int32{0, 1}, 1, true},
+		{0, []int32{4}, -1, false},
+		{4, nil, -1, false},
+	}
+
+	for _, test := range tests {
+		held := make(map[int32]bool)
+		for _, location := range test.held {
+			held[location] = true
+		}
+		nearest, found := graph.Nearest(test.start, func(location int32) bool {
+			return held[location]
+		})
+		if nearest != test.nearest || found != test.found {
+			t.Errorf("nearest to %d of %v: expected %d %t, got %d %t", test.start, test.held,
+				test.nearest, test.found, nearest, found)
+		}
+	}
+}
+
+func TestRenderMap(t *testing.T) {
+	template := "<path style=\"fill:\n*tile1a;stroke:none\" />\n<path style=\"fill:\n*tile02\" />"
+	expected := "<path style=\"fill:\nurl(#26);stroke:none\" />\n<path style=\"fill:\nurl(#2)\" />"
+
+	var out strings.Builder
+	err := RenderMap(strings.NewReader(template), &out, func(locationID int32) string {
+		return fmt.Sprintf("url(#%d)", locationID)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != expected {
+		t.Errorf("expected %q, got %q", expected, out.String())
+	}
+}
+
+func TestMapTemplateGenerated(t *testing.T) {
+	template, err := ioutil.ReadFile("../maptemplate.svg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(template) != MapTemplate {
+		t.Error("expected the built in map template to match maptemplate.svg, run go generate ./atlas")
+	}
+}
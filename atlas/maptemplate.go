@@ -0,0 +1,1178 @@
+// Code generated by go run maptemplate_generate.go; DO NOT EDIT.
+
+package atlas
+
+// MapTemplate is the svg every map is drawn from
+const MapTemplate = "" +
+	"<?xml version=\"1.0\" encoding=\"UTF-8\" standalone=\"no\"?>\r\n" +
+	"<!-- Created with Inkscape (http://www.inkscape.org/) -->\r\n" +
+	"\r\n" +
+	"<svg\r\n" +
+	"   xmlns:osb=\"http://www.openswatchbook.org/uri/2009/osb\"\r\n" +
+	"   xmlns:dc=\"http://purl.org/dc/elements/1.1/\"\r\n" +
+	"   xmlns:cc=\"http://creativecommons.org/ns#\"\r\n" +
+	"   xmlns:rdf=\"http://www.w3.org/1999/02/22-rdf-syntax-ns#\"\r\n" +
+	"   xmlns:svg=\"http://www.w3.org/2000/svg\"\r\n" +
+	"   xmlns=\"http://www.w3.org/2000/svg\"\r\n" +
+	"   xmlns:sodipodi=\"http://sodipodi.sourceforge.net/DTD/sodipodi-0.dtd\"\r\n" +
+	"   xmlns:inkscape=\"http://www.inkscape.org/namespaces/inkscape\"\r\n" +
+	"   version=\"1.1\"\r\n" +
+	"   id=\"svg10\"\r\n" +
+	"   width=\"1000\"\r\n" +
+	"   height=\"965\"\r\n" +
+	"   viewBox=\"0 0 1000 965\"\r\n" +
+	"   sodipodi:docname=\"maptest.svg\"\r\n" +
+	"   inkscape:version=\"0.92.4 (5da689c313, 2019-01-14)\"\r\n" +
+	"   style=\"enable-background:new\"\r\n" +
+	"   inkscape:export-filename=\"C:\\Users\\Sijay Liu\\summerofgame\\heavens_throne\\maptest.png\"\r\n" +
+	"   inkscape:export-xdpi=\"96\"\r\n" +
+	"   inkscape:export-ydpi=\"96\">\r\n" +
+	"  <metadata\r\n" +
+	"     id=\"metadata16\">\r\n" +
+	"    <rdf:RDF>\r\n" +
+	"      <cc:Work\r\n" +
+	"         rdf:about=\"\">\r\n" +
+	"        <dc:format>image/svg+xml</dc:format>\r\n" +
+	"        <dc:type\r\n" +
+	"           rdf:resource=\"http://purl.org/dc/dcmitype/StillImage\" />\r\n" +
+	"        <dc:title></dc:title>\r\n" +
+	"      </cc:Work>\r\n" +
+	"    </rdf:RDF>\r\n" +
+	"  </metadata>\r\n" +
+	"  <defs\r\n" +
+	"     id=\"defs14\">\r\n" +
+	"\t<pattern id=\"graydotgray\" x=\"5\" y=\"5\" width=\"10\" height=\"10\" patternUnits=\"userSpaceOnUse\">\r\n" +
+	"\t\t<rect width=\"10\" height=\"10\" style=\"fill: #606060\" />\r\n" +
+	"\t</pattern>\r\n" +
+	"\t<pattern id=\"greendotgreen\" x=\"5\" y=\"5\" width=\"10\" height=\"10\" patternUnits=\"userSpaceOnUse\">\r\n" +
+	"\t\t<rect width=\"10\" height=\"10\" style=\"fill: #065526\" />\r\n" +
+	"\t</pattern>\r\n" +
+	"\t<pattern id=\"purpledotpurple\" x=\"5\" y=\"5\" width=\"10\" height=\"10\" patternUnits=\"userSpaceOnUse\">\r\n" +
+	"\t\t<rect width=\"10\" height=\"10\" style=\"fill: #7851A9\" />\r\n" +
+	"\t</pattern>\r\n" +
+	"\t<pattern id=\"orangedotorange\" x=\"5\" y=\"5\" width=\"10\" height=\"10\" patternUnits=\"userSpaceOnUse\">\r\n" +
+	"\t\t<rect width=\"10\" height=\"10\" style=\"fill: #EC731B\" />\r\n" +
+	"\t</pattern>\r\n" +
+	"\t<pattern id=\"graydotpurple\" x=\"5\" y=\"5\" width=\"20\" height=\"20\" patternUnits=\"userSpaceOnUse\">\r\n" +
+	"\t\t<rect width=\"20\" height=\"20\" style=\"fill: #606060\" />\r\n" +
+	"\t\t<circle cx=\"20\" cy=\"10\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"0\" cy=\"10\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"20\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"0\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"20\" cy=\"10\" r=\"3\" style=\"fill: #7851A9\" />\r\n" +
+	"\t\t<circle cx=\"0\" cy=\"10\" r=\"3\" style=\"fill: #7851A9\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"20\" r=\"3\" style=\"fill: #7851A9\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"0\" r=\"3\" style=\"fill: #7851A9\" />\r\n" +
+	"\t</pattern>\r\n" +
+	"\t<pattern id=\"graydotgreen\" x=\"5\" y=\"5\" width=\"20\" height=\"20\" patternUnits=\"userSpaceOnUse\">\r\n" +
+	"\t\t<rect width=\"20\" height=\"20\" style=\"fill: #606060\" />\r\n" +
+	"\t\t<circle cx=\"20\" cy=\"10\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"0\" cy=\"10\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"20\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"0\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"20\" cy=\"10\" r=\"3\" style=\"fill: #065526\" />\r\n" +
+	"\t\t<circle cx=\"0\" cy=\"10\" r=\"3\" style=\"fill: #065526\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"20\" r=\"3\" style=\"fill: #065526\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"0\" r=\"3\" style=\"fill: #065526\" />\r\n" +
+	"\t</pattern>\r\n" +
+	"\t<pattern id=\"graydotorange\" x=\"5\" y=\"5\" width=\"20\" height=\"20\" patternUnits=\"userSpaceOnUse\">\r\n" +
+	"\t\t<rect width=\"20\" height=\"20\" style=\"fill: #606060\" />\r\n" +
+	"\t\t<circle cx=\"20\" cy=\"10\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"0\" cy=\"10\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"20\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"0\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"20\" cy=\"10\" r=\"3\" style=\"fill: #EC731B\" />\r\n" +
+	"\t\t<circle cx=\"0\" cy=\"10\" r=\"3\" style=\"fill: #EC731B\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"20\" r=\"3\" style=\"fill: #EC731B\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"0\" r=\"3\" style=\"fill: #EC731B\" />\r\n" +
+	"\t</pattern>\r\n" +
+	"\t<pattern id=\"greendotpurple\" x=\"5\" y=\"5\" width=\"20\" height=\"20\" patternUnits=\"userSpaceOnUse\">\r\n" +
+	"\t\t<rect width=\"20\" height=\"20\" style=\"fill: #065526\" />\r\n" +
+	"\t\t<circle cx=\"20\" cy=\"10\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"0\" cy=\"10\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"20\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"0\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"20\" cy=\"10\" r=\"3\" style=\"fill: #7851A9\" />\r\n" +
+	"\t\t<circle cx=\"0\" cy=\"10\" r=\"3\" style=\"fill: #7851A9\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"20\" r=\"3\" style=\"fill: #7851A9\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"0\" r=\"3\" style=\"fill: #7851A9\" />\r\n" +
+	"\t</pattern>\r\n" +
+	"\t<pattern id=\"greendotorange\" x=\"5\" y=\"5\" width=\"20\" height=\"20\" patternUnits=\"userSpaceOnUse\">\r\n" +
+	"\t\t<rect width=\"20\" height=\"20\" style=\"fill: #065526\" />\r\n" +
+	"\t\t<circle cx=\"20\" cy=\"10\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"0\" cy=\"10\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"20\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"0\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"20\" cy=\"10\" r=\"3\" style=\"fill: #EC731B\" />\r\n" +
+	"\t\t<circle cx=\"0\" cy=\"10\" r=\"3\" style=\"fill: #EC731B\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"20\" r=\"3\" style=\"fill: #EC731B\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"0\" r=\"3\" style=\"fill: #EC731B\" />\r\n" +
+	"\t</pattern>\r\n" +
+	"\t<pattern id=\"purpledotgreen\" x=\"5\" y=\"5\" width=\"20\" height=\"20\" patternUnits=\"userSpaceOnUse\">\r\n" +
+	"\t\t<rect width=\"20\" height=\"20\" style=\"fill: #7851A9\" />\r\n" +
+	"\t\t<circle cx=\"20\" cy=\"10\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"0\" cy=\"10\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"20\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"0\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"20\" cy=\"10\" r=\"3\" style=\"fill: #065526\" />\r\n" +
+	"\t\t<circle cx=\"0\" cy=\"10\" r=\"3\" style=\"fill: #065526\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"20\" r=\"3\" style=\"fill: #065526\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"0\" r=\"3\" style=\"fill: #065526\" />\r\n" +
+	"\t</pattern>\r\n" +
+	"\t<pattern id=\"purpledotorange\" x=\"5\" y=\"5\" width=\"20\" height=\"20\" patternUnits=\"userSpaceOnUse\">\r\n" +
+	"\t\t<rect width=\"20\" height=\"20\" style=\"fill: #7851A9\" />\r\n" +
+	"\t\t<circle cx=\"20\" cy=\"10\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"0\" cy=\"10\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"20\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"0\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"20\" cy=\"10\" r=\"3\" style=\"fill: #EC731B\" />\r\n" +
+	"\t\t<circle cx=\"0\" cy=\"10\" r=\"3\" style=\"fill: #EC731B\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"20\" r=\"3\" style=\"fill: #EC731B\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"0\" r=\"3\" style=\"fill: #EC731B\" />\r\n" +
+	"\t</pattern>\r\n" +
+	"\t<pattern id=\"orangedotpurple\" x=\"5\" y=\"5\" width=\"20\" height=\"20\" patternUnits=\"userSpaceOnUse\">\r\n" +
+	"\t\t<rect width=\"20\" height=\"20\" style=\"fill: #EC731B\" />\r\n" +
+	"\t\t<circle cx=\"20\" cy=\"10\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"0\" cy=\"10\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"20\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"0\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"20\" cy=\"10\" r=\"3\" style=\"fill: #7851A9\" />\r\n" +
+	"\t\t<circle cx=\"0\" cy=\"10\" r=\"3\" style=\"fill: #7851A9\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"20\" r=\"3\" style=\"fill: #7851A9\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"0\" r=\"3\" style=\"fill: #7851A9\" />\r\n" +
+	"\t</pattern>\r\n" +
+	"\t<pattern id=\"orangedotgreen\" x=\"5\" y=\"5\" width=\"20\" height=\"20\" patternUnits=\"userSpaceOnUse\">\r\n" +
+	"\t\t<rect width=\"20\" height=\"20\" style=\"fill: #EC731B\" />\r\n" +
+	"\t\t<circle cx=\"20\" cy=\"10\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"0\" cy=\"10\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"20\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"0\" r=\"4\" style=\"fill: #000000\" />\r\n" +
+	"\t\t<circle cx=\"20\" cy=\"10\" r=\"3\" style=\"fill: #065526\" />\r\n" +
+	"\t\t<circle cx=\"0\" cy=\"10\" r=\"3\" style=\"fill: #065526\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"20\" r=\"3\" style=\"fill: #065526\" />\r\n" +
+	"\t\t<circle cx=\"10\" cy=\"0\" r=\"3\" style=\"fill: #065526\" />\r\n" +
+	"\t</pattern>\r\n" +
+	"    <linearGradient\r\n" +
+	"       id=\"linearGradient6232\"\r\n" +
+	"       osb:paint=\"gradient\">\r\n" +
+	"      <stop\r\n" +
+	"         style=\"stop-color:#ffffff;stop-opacity:1;\"\r\n" +
+	"         offset=\"0\"\r\n" +
+	"         id=\"stop6228\" />\r\n" +
+	"      <stop\r\n" +
+	"         style=\"stop-color:#ffffff;stop-opacity:0;\"\r\n" +
+	"         offset=\"1\"\r\n" +
+	"         id=\"stop6230\" />\r\n" +
+	"    </linearGradient>\r\n" +
+	"    <filter\r\n" +
+	"       style=\"color-interpolation-filters:sRGB\"\r\n" +
+	"       id=\"filter5339\"\r\n" +
+	"       inkscape:label=\"landtile\"\r\n" +
+	"       x=\"0\"\r\n" +
+	"       y=\"0\"\r\n" +
+	"       height=\"1\"\r\n" +
+	"       width=\"1\">\r\n" +
+	"      <feTurbulence\r\n" +
+	"         id=\"feTurbulence5341\"\r\n" +
+	"         result=\"result1\"\r\n" +
+	"         baseFrequency=\"0.020000000000000011\"\r\n" +
+	"         type=\"turbulence\"\r\n" +
+	"         seed=\"9\"\r\n" +
+	"         stdDeviation=\"17.98\"\r\n" +
+	"         numOctaves=\"1\" />\r\n" +
+	"      <feGaussianBlur\r\n" +
+	"         stdDeviation=\"20.98\"\r\n" +
+	"         id=\"feGaussianBlur5353\" />\r\n" +
+	"      <feColorMatrix\r\n" +
+	"         id=\"feColorMatrix5347\"\r\n" +
+	"         type=\"saturate\"\r\n" +
+	"         values=\"0\" />\r\n" +
+	"      <feColorMatrix\r\n" +
+	"         id=\"feColorMatrix5355\"\r\n" +
+	"         type=\"luminanceToAlpha\" />\r\n" +
+	"      <feColorMatrix\r\n" +
+	"         id=\"feColorMatrix5381\"\r\n" +
+	"         values=\"1 0 0 0 0 0 1 0 0 0 0 0 1 0 0 0 0 0 1.5 0 \" />\r\n" +
+	"      <feComposite\r\n" +
+	"         in2=\"SourceGraphic\"\r\n" +
+	"         id=\"feComposite5343\"\r\n" +
+	"         operator=\"in\"\r\n" +
+	"         result=\"result2\" />\r\n" +
+	"      <feBlend\r\n" +
+	"         mode=\"multiply\"\r\n" +
+	"         id=\"feBlend5351\"\r\n" +
+	"         in2=\"SourceGraphic\" />\r\n" +
+	"    </filter>\r\n" +
+	"    <filter\r\n" +
+	"       width=\"1\"\r\n" +
+	"       height=\"1\"\r\n" +
+	"       y=\"0\"\r\n" +
+	"       x=\"0\"\r\n" +
+	"       inkscape:label=\"mountaintile\"\r\n" +
+	"       id=\"filter5373\"\r\n" +
+	"       style=\"color-interpolation-filters:sRGB\">\r\n" +
+	"      <feTurbulence\r\n" +
+	"         numOctaves=\"10\"\r\n" +
+	"         stdDeviation=\"17.98\"\r\n" +
+	"         seed=\"33\"\r\n" +
+	"         type=\"fractalNoise\"\r\n" +
+	"         baseFrequency=\"0.22000000000000014\"\r\n" +
+	"         result=\"result1\"\r\n" +
+	"         id=\"feTurbulence5361\" />\r\n" +
+	"      <feGaussianBlur\r\n" +
+	"         stdDeviation=\"1.01\"\r\n" +
+	"         id=\"feGaussianBlur5375\" />\r\n" +
+	"      <feColorMatrix\r\n" +
+	"         values=\"0\"\r\n" +
+	"         type=\"saturate\"\r\n" +
+	"         id=\"feColorMatrix5365\" />\r\n" +
+	"      <feColorMatrix\r\n" +
+	"         type=\"luminanceToAlpha\"\r\n" +
+	"         id=\"feColorMatrix5367\" />\r\n" +
+	"      <feComposite\r\n" +
+	"         result=\"result2\"\r\n" +
+	"         operator=\"in\"\r\n" +
+	"         id=\"feComposite5369\"\r\n" +
+	"         in2=\"SourceGraphic\" />\r\n" +
+	"      <feMorphology\r\n" +
+	"         id=\"feMorphology5377\"\r\n" +
+	"         operator=\"erode\"\r\n" +
+	"         radius=\"4\" />\r\n" +
+	"      <feBlend\r\n" +
+	"         in2=\"SourceGraphic\"\r\n" +
+	"         id=\"feBlend5371\"\r\n" +
+	"         mode=\"color-burn\" />\r\n" +
+	"    </filter>\r\n" +
+	"    <filter\r\n" +
+	"       width=\"1\"\r\n" +
+	"       height=\"1\"\r\n" +
+	"       y=\"0\"\r\n" +
+	"       x=\"0\"\r\n" +
+	"       inkscape:label=\"laketile\"\r\n" +
+	"       id=\"filter5397\"\r\n" +
+	"       style=\"color-interpolation-filters:sRGB\">\r\n" +
+	"      <feTurbulence\r\n" +
+	"         numOctaves=\"2\"\r\n" +
+	"         stdDeviation=\"17.98\"\r\n" +
+	"         seed=\"19\"\r\n" +
+	"         type=\"turbulence\"\r\n" +
+	"         baseFrequency=\"0.11800000000000009\"\r\n" +
+	"         result=\"result1\"\r\n" +
+	"         id=\"feTurbulence5383\" />\r\n" +
+	"      <feGaussianBlur\r\n" +
+	"         id=\"feGaussianBlur5385\"\r\n" +
+	"         stdDeviation=\"4.0099999999999998\" />\r\n" +
+	"      <feColorMatrix\r\n" +
+	"         values=\"0\"\r\n" +
+	"         type=\"saturate\"\r\n" +
+	"         id=\"feColorMatrix5387\" />\r\n" +
+	"      <feColorMatrix\r\n" +
+	"         type=\"luminanceToAlpha\"\r\n" +
+	"         id=\"feColorMatrix5389\" />\r\n" +
+	"      <feColorMatrix\r\n" +
+	"         values=\"1 0 0 0 0 0 1 0 0 0 0 0 1 0 0 0 0 0 1.5 0 \"\r\n" +
+	"         id=\"feColorMatrix5391\" />\r\n" +
+	"      <feComposite\r\n" +
+	"         result=\"result2\"\r\n" +
+	"         operator=\"in\"\r\n" +
+	"         id=\"feComposite5393\"\r\n" +
+	"         in2=\"SourceGraphic\" />\r\n" +
+	"      <feBlend\r\n" +
+	"         in2=\"SourceGraphic\"\r\n" +
+	"         id=\"feBlend5395\"\r\n" +
+	"         mode=\"color-burn\" />\r\n" +
+	"    </filter>\r\n" +
+	"  </defs>\r\n" +
+	"  <sodipodi:namedview\r\n" +
+	"     pagecolor=\"#123456\"\r\n" +
+	"     bordercolor=\"#666666\"\r\n" +
+	"     borderopacity=\"1\"\r\n" +
+	"     objecttolerance=\"10\"\r\n" +
+	"     gridtolerance=\"10\"\r\n" +
+	"     guidetolerance=\"10\"\r\n" +
+	"     inkscape:pageopacity=\"0\"\r\n" +
+	"     inkscape:pageshadow=\"2\"\r\n" +
+	"     inkscape:window-width=\"1920\"\r\n" +
+	"     inkscape:window-height=\"1000\"\r\n" +
+	"     id=\"namedview12\"\r\n" +
+	"     showgrid=\"false\"\r\n" +
+	"     inkscape:zoom=\"1\"\r\n" +
+	"     inkscape:cx=\"506.52853\"\r\n" +
+	"     inkscape:cy=\"508.26107\"\r\n" +
+	"     inkscape:window-x=\"-11\"\r\n" +
+	"     inkscape:window-y=\"911\"\r\n" +
+	"     inkscape:window-maximized=\"1\"\r\n" +
+	"     inkscape:current-layer=\"layer2\" />\r\n" +
+	"  <rect width=\"100%\" height=\"100%\" fill=\"#123456\"/>\r\n" +
+	"  <path\r\n" +
+	"     id=\"path3724\"\r\n" +
+	"     style=\"fill:#000000\"\r\n" +
+	"     d=\"m 764.34145,44.29675 -5.16016,1.22265 c -2.8383,0.67219 -8.06613,2.19685 -11.61718,3.38867 l -6.45704,2.16797 -2.69531,10.81641 c -1.48205,5.94979 -2.89745,11.02246 -3.14648,11.27149 -0.24903,0.24903 -3.60815,0.15922 -7.46485,-0.19922 l -7.01367,-0.65234 -6.32422,-7.88672 c -9.6737,-12.06185 -8.01154,-11.31171 -20.61914,-9.30274 -5.95281,0.94856 -11.35824,2.2252 -12.01172,2.83789 -0.65349,0.6127 -2.55197,5.39108 -4.21875,10.61914 l -3.03125,9.50586 -10.68945,2.23633 c -5.87948,1.22999 -10.90276,2.45159 -11.16406,2.71289 -0.2613,0.2613 -1.90688,5.31962 -3.65625,11.24219 l -3.18164,10.76953 -9.43555,1.75586 c -5.18959,0.96561 -9.81895,1.63034 -10.28906,1.47851 -0.47011,-0.15172 -3.39787,-4.53444 -6.50586,-9.74023 l -5.65039,-9.46484 -8.99609,-1 -8.99415,-1 -3.28906,-9.5 c -1.80926,-5.225 -3.87979,-10.09252 -4.60156,-10.81641 -1.08459,-1.08778 -2.7933,-0.58162 -9.84961,2.91797 l -8.53906,4.23437 -7.78321,-3.16992 c -9.13929,-3.7223 -7.8789,-4.18837 -20.24218,7.48828 l -8.30664,7.84571 0.95312,8 0.95313,8 -5.44727,8.31445 -5.44727,8.31641 -9.43164,-0.25 c -5.18697,-0.13829 -9.59045,-0.0558 -9.78515,0.18359 -0.1947,0.23937 -2.44544,4.31542 -5.00195,9.05859 l -4.64844,8.625 5.08594,14.375 c 2.79737,7.90683 5.47127,14.78421 5.9414,15.28321 0.47013,0.499 4.10218,2.33775 8.07227,4.08593 7.13633,3.14238 7.26726,3.26889 11.53515,11.16016 l 4.31836,7.98242 -2.60547,7.74414 -2.60351,7.7461 -8.31446,4.12304 -8.3164,4.125 -6.47656,-5.6875 c -3.56235,-3.12757 -7.2755,-5.68554 -8.25196,-5.68554 -0.97645,0 -4.76694,0.96283 -8.42382,2.14062 l -6.6504,2.14063 -9.13281,-6.14063 c -5.0235,-3.37779 -9.72828,-6.14062 -10.45508,-6.14062 -0.7268,0 -3.50931,4.03538 -6.18359,8.96875 -4.32448,7.97755 -5.48774,9.34315 -10.51172,12.33593 l -5.64844,3.36329 -4.95507,-3.38672 c -2.72565,-1.86346 -5.56399,-3.74576 -6.30665,-4.18164 -0.77652,-0.45576 -2.47615,-6.48689 -4,-14.19532 -3.0369,-15.3623 -2.19584,-14.55592 -15.41601,-14.78711 l -6.76563,-0.11718 -6.59375,10.19336 c -9.10322,14.07312 -8.93182,13.55528 -7.00976,21.20703 0.89947,3.58084 1.63476,6.91154 1.63476,7.40234 0,0.4908 -3.8041,3.50286 -8.45312,6.69336 l -8.45117,5.80078 -9.9336,-3.14844 c -5.46308,-1.73183 -11.00233,-3.14843 -12.30859,-3.14843 -1.30626,0 -5.39267,2.025 -9.08203,4.5 -3.68936,2.475 -7.20969,4.5 -7.82227,4.5 -0.61258,0 -4.18985,-1.35 -7.94922,-3 -10.04003,-4.4066 -10.12828,-4.3726 -14.52734,5.5 -2.0831,4.675 -4.08614,8.5 -4.45117,8.5 -1.65198,0 -10.63937,-3.37192 -11.67188,-4.37891 -0.63286,-0.61721 -2.27825,-6.44279 -3.65625,-12.94531 -1.37799,-6.50252 -2.73061,-12.04702 -3.00586,-12.32227 -0.27524,-0.27525 -5.28327,-0.94472 -11.1289,-1.48633 -12.01885,-1.11357 -9.90572,-1.91182 -22.50977,8.52344 l -7.11523,5.89063 -3.7168,-2.94141 c -2.04422,-1.61686 -5.63977,-4.4632 -7.99023,-6.32617 -3.70463,-2.93627 -5.42997,-3.52909 -12.96289,-4.45117 l -8.68946,-1.0625 -2.21093,-11.72266 c -1.2156,-6.44698 -2.47298,-12.14704 -2.79493,-12.66797 -0.32195,-0.52092 -3.48727,-2.56973 -7.0332,-4.55273 -3.54592,-1.98299 -6.64625,-3.93293 -6.89062,-4.33203 -0.24438,-0.39911 -0.76363,-6.12461 -1.15235,-12.72461 l -0.70508,-12 6.10547,-7.63867 c 3.35891,-4.20071 6.10742,-8.62355 6.10742,-9.83008 0,-2.79014 -6.82641,-25.43489 -7.7832,-25.81836 -0.39396,-0.1579 -4.76036,-1.96051 -9.70312,-4.00586 l -8.98633,-3.71875 -2.91406,-9.61719 -2.91602,-9.61523 -9.09766,-4.05469 -9.09961,-4.05664 -3.26562,-10.46094 -3.26367,-10.46289 -11.79102,-4.61133 c -6.48461,-2.536 -12.06328,-4.60938 -12.39648,-4.60938 -0.33321,0 -3.80418,4.00869 -7.71289,8.91016 l -7.10547,8.91211 -8.732418,0.6211 c -17.698373,1.25789 -15.990613,0.44916 -22.611335,10.70898 -3.231428,5.00759 -6.093268,9.93749 -6.359375,10.95508 -0.300798,1.15026 1.855685,5.49723 5.699219,11.49023 l 6.181638,9.63868 -5.699216,8.56445 -5.699219,8.5625 2.138672,10.06836 c 1.175834,5.53713 2.132353,10.52287 2.126953,11.08008 -0.0054,0.5572 -3.414636,3.68168 -7.576172,6.9414 -4.161536,3.25972 -7.937491,6.62826 -8.390625,7.48633 -0.453134,0.85808 -0.310764,6.73345 0.316406,13.05664 l 1.140625,11.49609 9.486328,2.50586 9.484376,2.50782 2.779292,9.46093 c 1.52875,5.20373 3.18081,9.86245 3.67188,10.35352 0.77178,0.77178 19.340961,3.55408 24.337891,3.64648 1.17507,0.0217 2.96626,2.4771 5.4043,7.4043 l 3.64843,7.37305 -3.69336,9.22851 c -2.73584,6.83545 -3.41367,9.5688 -2.61328,10.53321 0.59439,0.7162 5.22315,2.05245 10.28711,2.9707 7.77645,1.41009 10.32711,2.39593 16.40821,6.3457 l 7.19921,4.67774 1.13477,7 c 2.21567,13.66172 0.85118,12.17383 15.99805,17.41015 7.40747,2.56078 14.0635,5.02539 14.79101,5.47657 0.72751,0.45117 3.44307,5.14551 6.03516,10.43164 l 4.71289,9.61132 11.83203,2.23633 c 6.50768,1.22981 11.93828,2.37079 12.06836,2.53516 2.71313,3.42851 9.52734,13.39165 9.52734,13.92969 0,0.40181 -1.67154,3.41375 -3.71484,6.69336 -3.44369,5.52733 -4.03737,6.00622 -8.13867,6.56835 -12.12327,1.66168 -15.22057,1.02426 -26.5332,-5.46679 l -10.58399,-6.07422 -12.84765,4.24023 -12.84766,4.24024 -9.41602,-6.41602 -9.41797,-6.41601 -0.63476,-13 c -0.34979,-7.15 -0.6873,-13.33428 -0.75,-13.74219 -0.15072,-0.98054 -21.67669,-11.75781 -23.48438,-11.75781 -1.13028,0 -6.759048,6.36627 -13.880861,15.70312 -0.5865,0.76893 0.041,4.53782 1.73438,10.42383 l 2.65234,9.21875 -6.81836,6.77148 -6.81836,6.76954 -5.794918,-3.44336 c -3.187063,-1.89412 -6.596112,-3.44336 -7.576171,-3.44336 -1.736436,0 -17.110148,14.91312 -17.148438,16.63476 -0.01058,0.47566 0.981836,6.76607 2.205078,13.97852 l 2.22461,13.11328 -3.705079,8.2207 c -2.037662,4.52141 -3.705078,9.07702 -3.705078,10.125 0,1.04798 2.879197,7.36578 6.398438,14.03907 l 6.398437,12.13281 -2.398437,7.05273 c -1.319241,3.87873 -2.398438,8.28722 -2.398438,9.79688 0,3.09722 4.813165,26.05144 5.634766,26.87304 0.2929,0.2929 3.982767,2.33314 8.199219,4.53321 l 7.666015,4 1.16211,8.5 c 2.029136,14.82943 1.971526,15.15876 -4.16211,23.91211 -3.025,4.317 -5.5,8.65652 -5.5,9.64257 0,0.98606 2.72259,5.10746 6.048828,9.16016 3.326248,4.0527 6.259948,7.92316 6.519528,8.59961 0.25958,0.67645 -1.563002,3.22636 -4.048824,5.66602 -2.485824,2.43965 -4.519532,5.25196 -4.519532,6.25 0,0.99803 1.409442,5.17055 3.13086,9.27148 2.81007,6.69441 3.44864,7.50548 6.249996,7.94531 1.71608,0.26944 6.94414,1.06691 11.61914,1.77149 l 8.500001,1.28125 0.25586,6.5 c 0.42716,10.85509 0.22905,10.57896 8.98828,12.47461 16.10344,3.48507 14.98336,2.8429 16.23438,9.29492 1.80612,9.31493 2.41818,10.23047 6.85352,10.23047 2.13111,0 6.68276,0.29183 10.11328,0.65039 l 6.23633,0.65234 -0.33985,8.0625 -0.3418,8.0625 7,4.25391 c 3.85,2.33934 6.95976,4.82982 6.91016,5.53515 -0.0496,0.70533 -0.37531,4.43321 -0.72461,8.28321 -0.34932,3.85 -0.30871,7.48259 0.0898,8.07226 0.39887,0.58966 4.02263,3.19 8.05274,5.7793 l 7.32812,4.70703 -2.73437,4.7207 c -1.50306,2.59603 -3.33661,5.60848 -4.07617,6.69336 -1.77674,2.60642 -1.85143,19.49591 -0.0957,21.7461 1.17108,1.50096 14.80223,5.78125 18.41211,5.78125 0.90142,0 5.1466,-2.25 9.4336,-5 4.28701,-2.75 8.15667,-4.99528 8.59961,-4.98828 0.44293,0.007 3.01137,2.5282 5.70703,5.60156 l 4.90039,5.58789 -3.78711,7.76758 c -4.21325,8.64159 -4.21996,9.25451 -0.24805,21.53125 l 2.26367,7 h 11.23047 11.23047 l 3.96094,4.5 c 2.17873,2.475 3.98051,4.84829 4.00391,5.27539 0.0234,0.4271 -2.43204,4.67737 -5.45704,9.44531 -3.025,4.76795 -5.5,9.06297 -5.5,9.54297 0,1.39004 18.68037,9.73799 21.73438,9.71289 2.44924,-0.0201 24.22483,-5.47251 24.73437,-6.19336 0.11004,-0.15568 2.33838,-3.3207 4.95313,-7.0332 2.61475,-3.7125 5.27683,-6.79401 5.91601,-6.84571 0.63923,-0.0517 5.88166,-0.6142 11.64844,-1.25 l 10.48438,-1.15429 5.15429,-7.48633 5.1543,-7.48438 8.36133,-1.7207 c 12.5827,-2.58682 12.34428,-2.64462 20.28516,5.02539 6.97379,6.73591 7.20135,6.86069 15.83398,8.6582 4.81734,1.00308 9.13797,2.31549 9.60156,2.91602 0.46359,0.60052 1.37708,5.04078 2.03125,9.86719 0.65417,4.8264 1.41793,9.36971 1.69727,10.09765 0.27934,0.72795 3.7447,2.85396 7.69922,4.72461 l 7.18945,3.40235 1.5918,7.12109 1.58984,7.12305 -4.83008,7.82812 c -2.65659,4.30599 -4.83008,8.71233 -4.83008,9.79102 0,1.07868 2.71499,5.24237 6.03321,9.2539 l 6.0332,7.29493 -4.0332,8.02148 c -2.21822,4.41182 -4.03321,8.60093 -4.03321,9.30859 0,0.70767 2.29875,4.12647 5.10743,7.59766 l 5.10742,6.31055 -3.60742,9.53906 c -1.98368,5.24638 -3.60743,10.44679 -3.60743,11.55664 0,1.20937 2.66952,4.46847 6.66016,8.13477 10.76648,9.89143 9.29526,9.54151 19.34961,4.61328 l 8.75977,-4.29297 9.78711,3.39844 9.7871,3.40039 3.38868,8 c 1.8637,4.4 3.7769,8.39264 4.25195,8.87304 0.47505,0.4804 6.85985,2.56653 14.18945,4.63672 7.3296,2.07018 13.77617,3.68057 14.32617,3.57813 0.55,-0.10244 3.13175,-4.27875 5.73633,-9.28125 l 4.73633,-9.09571 8.76367,-1.25 c 4.82042,-0.68693 9.28642,-1.74675 9.92578,-2.35546 0.63936,-0.60871 2.48038,-6.95547 4.08985,-14.10547 l 2.92578,-13 8.55664,-2.77539 8.55469,-2.77735 2.23632,-9.04687 c 2.49735,-10.10695 2.60763,-10.21177 12.13672,-11.40235 3.04221,-0.3801 6.01281,-0.98769 6.60157,-1.35156 0.58875,-0.36387 2.02824,-4.48349 3.19921,-9.1543 3.94264,-15.72655 3.06516,-14.48786 14.20118,-20.07617 l 9.98632,-5.00976 -0.60546,-6.70313 c -0.33246,-3.68689 -0.84841,-9.0662 -1.14649,-11.95312 l -0.54101,-5.24805 5.77539,-6.05664 5.77539,-6.05469 -3.69727,-11.19531 -3.69726,-11.19336 7.78711,-7.78711 7.78906,-7.78906 7.81836,3.5625 7.81836,3.5625 10.63672,-6.17383 c 5.85054,-3.39577 10.83953,-6.34583 11.08593,-6.55664 0.2464,-0.2108 1.37957,-6.32303 2.51953,-13.58203 l 2.07227,-13.19727 9.35937,-2.17187 c 5.1477,-1.19456 9.66539,-2.66417 10.03711,-3.26563 0.37173,-0.60147 1.47723,-4.1852 2.45704,-7.96289 l 1.78125,-6.86914 6.6621,-0.62891 c 14.50163,-1.36977 16.70935,-1.03231 23.21094,3.55274 3.27313,2.30827 5.94932,4.67077 5.94532,5.25 -0.004,0.57923 -0.91714,4.84892 -2.0293,9.48828 -1.63947,6.83907 -1.78121,8.66855 -0.74414,9.66797 2.03126,1.95751 23.71057,6.12712 24.57617,4.72656 0.39286,-0.63567 1.5707,-5.13229 2.61719,-9.99219 1.67095,-7.75985 2.50683,-9.58828 6.85742,-15.00976 l 4.95508,-6.17383 7.51172,3.42383 c 4.13178,1.88292 8.24288,3.42097 9.13671,3.41797 0.89384,-0.003 5.49186,-3.15014 10.2168,-6.99219 l 8.58985,-6.98633 2.16015,-7.8789 c 1.18756,-4.33332 2.1582,-8.78202 2.1582,-9.88672 0,-1.33243 -2.5435,-3.92407 -7.55664,-7.69727 -4.15633,-3.1283 -7.7715,-6.24849 -8.03515,-6.93555 -0.26365,-0.68706 0.84943,-4.36982 2.47461,-8.18359 l 2.95507,-6.93359 8.57032,-1.04688 8.57031,-1.04492 4.28516,4.54492 c 9.55441,10.13474 9.00359,8.90084 7.81054,17.47852 l -1.0664,7.65625 4.41601,7.70508 4.41602,7.70703 -6.79492,7 c -6.34702,6.53994 -6.86959,7.4272 -7.97461,13.5 -0.65051,3.575 -1.41566,7.29415 -1.69922,8.26562 -0.36258,1.24221 1.42155,3.56974 6.01172,7.84571 l 6.52539,6.08007 -3.10938,5.6543 c -4.79067,8.70633 -3.94472,8.42889 -15.70703,5.14063 -5.72439,-1.60033 -10.75877,-2.69271 -11.1875,-2.42774 -1.05744,0.65353 -9.9043,15.28897 -9.9043,16.38477 0,0.48029 3.38211,6.75966 7.51563,13.95312 l 7.51562,13.07813 -3.11132,5.51172 -3.10938,5.51367 h -9.4375 c -7.30407,0 -9.56114,0.32516 -9.99023,1.44336 -0.30488,0.79451 0.20478,6.08201 1.13281,11.75 l 1.6875,10.30664 -3.68945,9.20507 -3.6875,9.20704 5.27734,11.29296 c 2.90246,6.21134 5.52772,11.60228 5.83594,11.97852 0.30823,0.37623 4.83554,1.40746 10.06054,2.29297 5.225,0.8855 10.29764,1.8492 11.27149,2.14062 1.21904,0.36479 3.86704,-1.44528 8.5,-5.8125 l 6.72851,-6.34375 8.07227,0.54493 c 8.01601,0.54209 8.11408,0.58216 14.07812,5.76953 3.30311,2.87296 6.57681,5.22461 7.2754,5.22461 0.69858,0 4.89539,-2.55529 9.32617,-5.67774 l 8.05664,-5.67773 6.86523,1.17773 c 3.77567,0.64745 7.51872,1.17774 8.31836,1.17774 0.79964,0 4.9263,-4.725 9.17188,-10.5 l 7.7207,-10.5 h 10.39062 10.39258 l 8.66602,-7.40039 8.66601,-7.39844 8,0.8457 c 4.4,0.46489 10.09321,0.86874 12.65039,0.89844 l 4.64844,0.0547 6.44727,-8.75 c 3.54598,-4.8125 6.79621,-9.35995 7.22265,-10.10352 0.52685,-0.91867 -1.41338,-3.55723 -6.05468,-8.23437 l -6.83008,-6.88282 2.1289,-8.74023 c 1.17063,-4.80683 1.90292,-9.10155 1.62891,-9.54492 -0.27402,-0.44337 -5.32011,-3.22533 -11.21484,-6.18164 l -10.71875,-5.375 -10.36524,2.44531 -10.36718,2.44336 -3.0879,-2.81836 c -1.69808,-1.55041 -5.78789,-5.30488 -9.08789,-8.34375 -5.57108,-5.13024 -6.73803,-5.72373 -16.34375,-8.29102 -5.6899,-1.52072 -10.9593,-2.52986 -11.70898,-2.24218 -0.74968,0.28768 -3.02108,3.01925 -5.04688,6.07031 -4.29529,6.4692 -4.76525,6.55285 -15.34374,2.73437 l -7.44532,-2.68554 -2.88086,-7.14844 -2.88086,-7.14844 4.72266,-7.10156 c 2.59719,-3.90582 5.0785,-7.10156 5.51367,-7.10156 0.43518,0 3.85689,0.71128 7.60352,1.58203 l 6.81054,1.58398 9.66211,-4.75 c 9.17034,-4.50827 9.91904,-5.12134 14.75,-12.07031 2.7991,-4.02631 5.08789,-8.40532 5.08789,-9.73242 0,-1.64878 -1.74177,-4.00471 -5.5,-7.43945 -3.025,-2.76468 -5.5,-5.31874 -5.5,-5.67383 0,-0.35508 2.7,-4.51447 6,-9.24414 3.3,-4.72968 6,-8.89286 6,-9.25196 0,-0.35909 -2.43313,-3.90623 -5.4082,-7.88281 l -5.41015,-7.23047 3.06445,-7.69531 c 1.68551,-4.23258 3.5581,-8.18839 4.16015,-8.78906 0.60206,-0.60068 4.53945,-2.37426 8.75,-3.94336 l 7.65625,-2.85352 4.86133,-8.51953 4.85938,-8.51758 13.48437,-2.53711 13.48242,-2.53515 4.5,-8.43164 4.5,-8.42969 7.5,-3.1582 c 5.29115,-2.22839 7.56959,-3.72212 7.73438,-5.07032 0.12847,-1.05107 -1.89653,-5.96015 -4.5,-10.91015 l -4.73438,-9 -9.88085,-0.64453 -9.88086,-0.64454 -4.44922,-4.44921 -4.44922,-4.44922 2.60156,-8.52344 c 1.42989,-4.68788 2.32717,-9.14537 1.99609,-9.90625 -0.33107,-0.76089 -4.16471,-4.50995 -8.51953,-8.33008 -4.35482,-3.82012 -7.91797,-7.69358 -7.91797,-8.60937 0,-0.9158 0.94209,-6.27692 2.0918,-11.91407 l 2.08985,-10.25 -3.73829,-5.42773 c -2.05626,-2.9856 -5.36085,-7.22703 -7.34179,-9.42383 l -3.60157,-3.99414 -12.3164,3.40625 -12.31836,3.4043 -2.26563,-3.62305 c -1.24561,-1.99245 -3.4548,-5.67051 -4.91015,-8.17383 l -2.64649,-4.55078 7.60352,-11.09375 7.60547,-11.0918 -5.21289,-9.63867 -5.21094,-9.63867 3.58594,-8.56836 c 1.97238,-4.71176 3.58593,-9.17961 3.58593,-9.92969 0,-0.85545 -3.82063,-2.55285 -10.25,-4.55468 -8.8469,-2.75455 -10.75701,-3.75281 -13.96093,-7.28711 -2.04163,-2.25216 -3.98621,-4.88536 -4.32032,-5.85157 -0.5157,-1.49135 2.14427,-7.16194 6.35547,-13.54687 0.47293,-0.71705 3.52026,-1.98257 6.76953,-2.8125 3.24928,-0.82993 7.59375,-1.94766 9.65625,-2.48438 4.46963,-1.16313 4.46654,-1.1248 1.60743,-14.58789 l -2.16407,-10.1875 -8.82031,-7.0625 c -4.85082,-3.88406 -9.65323,-7.06054 -10.67187,-7.06054 -1.21305,0 -3.46321,2.54414 -6.52344,7.375 -2.56979,4.05666 -5.23708,7.83833 -5.92578,8.40429 -0.68871,0.56596 -4.51894,1.17825 -8.51172,1.35938 l -7.25977,0.32812 -5.11523,-6.24804 c -4.87761,-5.95585 -5.16397,-6.63667 -6.12695,-14.57813 l -1.01172,-8.33203 4.13672,-6.96289 c 2.2752,-3.82972 4.12477,-7.83629 4.10937,-8.9043 -0.018,-1.24672 -2.7027,-3.89238 -7.5,-7.38867 -4.10961,-2.99509 -7.47266,-5.81925 -7.47266,-6.27734 0,-0.45808 1.35,-3.50676 3,-6.77539 1.65,-3.26863 3,-6.76091 3,-7.75977 0,-0.99885 -2.25,-4.03158 -5,-6.74023 -2.75,-2.70865 -5,-5.32173 -5,-5.80664 0,-0.48492 2.475,-4.27189 5.5,-8.41407 3.025,-4.14218 5.5,-7.85902 5.5,-8.25976 0,-0.40075 -2.01753,-5.04728 -4.48437,-10.32617 -4.95623,-10.60605 -4.61072,-10.36999 -16.01563,-10.94336 l -5,-0.25 -3.13867,-9.64649 -3.14062,-9.64453 1.85156,-8.85547 c 3.05318,-14.61022 3.23074,-14.02081 -7.10547,-23.53711 l -9.03515,-8.3164 0.28515,-6 0.2832,-6 9.25,-4.79102 c 5.0875,-2.63436 9.25,-5.41608 9.25,-6.18164 0,-0.76555 -1.9963,-5.49599 -4.4375,-10.51172 l -4.43945,-9.11914 5.16602,-9.79101 5.16601,-9.79102 -2.30664,-11.97852 c -1.26936,-6.58815 -2.61026,-12.44234 -2.97851,-13.00781 -0.36825,-0.56546 -4.42112,-3.182 -9.00782,-5.81641 z m -0.76953,1.17578 8.47656,4.80273 c 4.66197,2.64053 8.67165,5.47579 8.91016,6.30079 0.2385,0.825 1.39111,6.34289 2.5625,12.26367 l 2.13086,10.76562 -5.2793,9.96875 -5.2793,9.96875 4.20899,8.76758 c 2.31482,4.82115 4.32283,9.3482 4.46289,10.06055 0.1448,0.73648 -3.62849,3.32474 -8.7461,6 l -9,4.70508 -0.30078,6.96875 -0.30078,6.96679 9.22266,8.57227 9.22265,8.57031 -2.26171,11.50977 -2.26368,11.51172 3.3418,9.94922 3.33984,9.95117 8.29883,1 8.29883,1 4.36523,9.1914 4.36329,9.19141 -5.91211,8.07226 c -3.25228,4.43996 -5.91407,8.39044 -5.91407,8.7793 0,0.38885 2.57325,3.30766 5.71875,6.48633 l 5.72071,5.7793 -3.74219,7.56054 c -2.05743,4.15799 -3.54496,7.87071 -3.30664,8.25 0.23831,0.37928 3.83591,3.16446 7.99414,6.18946 4.15823,3.025 7.57249,5.91045 7.58789,6.41211 0.0154,0.50166 -1.98293,4.34164 -4.44141,8.53515 l -4.4707,7.625 1.25,8.56641 c 1.18918,8.14365 1.52072,8.89755 6.7207,15.3164 l 5.46875,6.75 8,-0.6914 c 4.4,-0.38043 8.56325,-1.15688 9.25196,-1.72656 0.6887,-0.56968 3.35599,-4.35545 5.92578,-8.41211 2.5698,-4.05666 5.07893,-7.375 5.57617,-7.375 0.70409,0 11.74065,8.43989 17.12891,13.09961 0.704,0.60882 5.5134,19.55014 5.5957,22.03711 0.0116,0.35095 -4.05167,1.64459 -9.03125,2.875 -4.97957,1.2304 -9.69626,2.87986 -10.48047,3.66406 -0.7842,0.78421 -3.05376,4.33792 -5.04492,7.89844 l -3.62109,6.47461 2.53515,3.2246 c 6.42416,8.17281 6.94602,8.52436 17.41406,11.70118 5.6375,1.71085 10.25,3.27586 10.25,3.47851 0,0.20264 -1.575,4.0494 -3.5,8.54688 -1.925,4.49748 -3.5,8.87834 -3.5,9.73633 0,0.85797 2.025,5.274 4.5,9.81445 2.475,4.54045 4.5,8.7816 4.5,9.42383 0,0.64223 -3.25028,5.88429 -7.22461,11.64843 l -7.22656,10.48047 3.47656,6.47461 c 1.91176,3.56141 4.39123,7.63457 5.51172,9.05078 l 2.03711,2.57422 11.8086,-3.17187 c 6.49434,-1.74491 12.41047,-2.94259 13.14648,-2.66016 0.736,0.28243 3.93469,3.96231 7.10742,8.17774 l 5.76758,7.66406 -1.72265,10.14453 c -0.94764,5.57848 -1.96496,11.05809 -2.26172,12.17773 -0.42546,1.60524 1.26633,3.61404 8.0039,9.5 9.62504,8.40847 9.43025,7.75323 5.98438,20.19727 l -1.86328,6.73437 4.93945,5.01563 4.94141,5.01758 h 6.6621 c 12.25095,0 12.44843,0.11399 17.54493,10.26172 2.4832,4.94435 4.14434,9.34047 3.6914,9.76757 -0.45295,0.42711 -3.97422,2.08269 -7.82422,3.67969 -6.92316,2.87177 -7.04378,2.98706 -11,10.51367 -2.20007,4.18558 -4.33024,7.94157 -4.73437,8.34571 -0.40414,0.40413 -6.69174,1.9029 -13.97266,3.33203 l -13.23828,2.59961 -4.88867,8.58593 -4.89063,8.58594 -7.95507,2.89258 c -4.37535,1.59091 -8.30227,3.35251 -8.72657,3.91406 -2.02087,2.67456 -7.19411,17.5548 -6.50781,18.71875 0.42232,0.71625 2.71389,3.85577 5.0918,6.97657 2.3779,3.12072 4.32226,6.18867 4.32226,6.81835 0,0.62968 -2.68932,4.92932 -5.97656,9.55469 -3.28724,4.62537 -5.73926,9.02739 -5.44922,9.7832 0.29004,0.75583 2.97937,3.49249 5.97657,6.08008 2.9972,2.5876 5.44921,5.21185 5.44921,5.83203 0,0.62017 -2.1375,4.45047 -4.75,8.51172 -4.56963,7.1037 -5.11073,7.56485 -14.25,12.16602 l -9.5,4.7832 -5.5,-1.30859 c -9.80355,-2.33453 -10.17054,-2.20267 -15.35351,5.5918 -2.5557,3.84338 -4.64649,7.60161 -4.64649,8.3496 0,0.748 1.2673,4.46562 2.81446,8.26172 l 2.8125,6.90235 8.9375,3.18359 c 4.91534,1.75173 9.48142,3.2142 10.14843,3.25 0.66702,0.0358 3.02827,-2.60716 5.2461,-5.87305 2.21782,-3.26589 4.38943,-6.15781 4.82617,-6.42773 0.43674,-0.26992 5.38974,0.79007 11.00586,2.35547 10.0795,2.8095 10.3221,2.95053 18.95898,10.91992 4.81231,4.4404 9.425,8.07989 10.25,8.08789 0.825,0.008 5.79112,-0.94179 11.03516,-2.10937 l 9.53516,-2.1211 10.21484,5.125 c 5.61846,2.81948 10.21484,5.54455 10.21484,6.05469 0,0.51014 -0.89147,4.66477 -1.98242,9.23242 l -1.98437,8.30469 6.48437,6.5332 c 3.56595,3.59349 6.48242,6.92156 6.48242,7.39453 0,0.47297 -3.00116,4.89098 -6.66992,9.81836 l -6.66992,8.95899 -5.08008,-0.57227 c -2.79374,-0.31433 -8.34057,-0.86445 -12.32617,-1.22266 l -7.24609,-0.65039 -8.91993,7.62891 -8.91992,7.62891 h -10.36914 -10.37109 l -7.80664,10.49414 c -6.42658,8.64012 -8.18715,10.41698 -9.96289,10.04687 -1.1864,-0.24728 -4.6253,-0.93817 -7.64063,-1.53515 l -5.48242,-1.08594 -8.82227,6.11523 -8.82031,6.11328 -6.19726,-5.4375 -6.19532,-5.4375 -8.87109,-0.73632 -8.87305,-0.73828 -6.29101,6.23437 -6.28906,6.23437 -11.05079,-1.83789 -11.04882,-1.83789 -4.61133,-9.53711 c -2.53587,-5.24526 -4.84121,-10.68841 -5.12305,-12.09765 -0.3202,-1.60099 0.78388,-5.77442 2.94141,-11.125 l 3.45312,-8.56446 -1.76367,-10.38476 c -0.96905,-5.71141 -1.55606,-10.72031 -1.30664,-11.13281 0.24941,-0.4125 4.66813,-0.75 9.82031,-0.75 h 9.36719 l 3.07422,-5.37305 c 1.69036,-2.95445 3.07226,-5.89694 3.07226,-6.53906 0,-0.64211 -3.35847,-7.01148 -7.46289,-14.1543 l -7.46093,-12.98633 4.53711,-7.47461 c 2.49561,-4.11061 4.98242,-7.47265 5.52734,-7.47265 0.54493,0 5.47913,1.2028 10.96484,2.67383 11.50555,3.08523 10.70335,3.41184 16.00586,-6.52344 l 3.38867,-6.34766 -6.39062,-5.90625 -6.39063,-5.9043 1.31055,-7.74609 c 1.28664,-7.61164 1.42777,-7.86937 8.14063,-14.82226 3.75729,-3.89167 6.83007,-7.39351 6.83007,-7.78126 0,-0.38769 -1.8257,-3.92871 -4.05859,-7.86914 l -4.06055,-7.16406 0.6543,-8.55469 0.65234,-8.55468 -6.84375,-7.37696 -6.8457,-7.37695 -8.99805,1.18945 c -4.94933,0.65483 -9.25844,1.49944 -9.57617,1.87696 -0.31774,0.37753 -2.02733,4.00253 -3.79883,8.05468 -3.77557,8.63624 -4.0337,8.04573 7.08594,16.28907 3.73415,2.76823 6.77873,5.55206 6.76563,6.1875 -0.0131,0.63544 -1.04265,4.64831 -2.28907,8.91797 -2.24718,7.69781 -2.33827,7.82171 -10.70312,14.75 -4.63964,3.84284 -8.7268,6.98828 -9.08399,6.98828 -0.35716,0 -4.14275,-1.59347 -8.4121,-3.53907 -4.26934,-1.94561 -8.13662,-3.40811 -8.59375,-3.25 -0.45714,0.1581 -3.02742,2.88722 -5.71094,6.06446 -4.41387,5.22597 -5.09501,6.7278 -7.14063,15.75 -1.24368,5.48526 -2.54986,9.96472 -2.90234,9.95312 -1.93259,-0.0637 -21.29278,-4.79861 -21.83398,-5.33984 -0.3533,-0.35329 0.29157,-4.80418 1.43164,-9.89063 1.14008,-5.08645 1.89595,-9.53483 1.67968,-9.88476 -0.21627,-0.34994 -3.43734,-2.6233 -7.15625,-5.05274 l -6.76172,-4.41601 -11.98242,0.55273 -11.98242,0.55274 -2.42187,8.20117 -2.41993,8.20312 -9.33593,2.12696 c -5.13526,1.16985 -9.55236,2.47435 -9.81446,2.89843 -0.26209,0.42408 -1.19581,5.67545 -2.07617,11.66993 -0.88037,5.99448 -1.992,11.86855 -2.46875,13.05273 -0.81497,2.02424 -18.59833,13.34766 -20.96289,13.34766 -0.59265,0 -4.05632,-1.35 -7.69531,-3 -3.639,-1.65 -7.12759,-3 -7.75196,-3 -0.62432,0 -4.71155,3.59787 -9.08398,7.99609 l -7.94922,7.99609 3.69531,11.19141 3.69727,11.18945 -5.57227,5.84375 -5.57226,5.8418 0.68359,12.2207 0.68555,12.22071 -9.79883,5 -9.79687,5 -2.95899,11.5 c -1.62752,6.325 -3.01147,11.59196 -3.07617,11.70507 -0.0647,0.11316 -3.47653,0.73742 -7.58008,1.38672 -4.10354,0.64929 -8.016,1.69043 -8.69531,2.31446 -0.6793,0.62402 -2.2529,5.06987 -3.49609,9.8789 l -2.25972,8.74219 -8.50195,2.73633 -8.50391,2.73633 -3.06836,13.41015 c -3.42818,14.97816 -2.90599,14.34895 -12.93555,15.51172 -3.3,0.38258 -6.79927,0.92856 -7.77734,1.21289 -0.99761,0.29001 -3.7456,4.43544 -6.25977,9.44141 -2.46492,4.90799 -5.04067,8.88961 -5.72265,8.84961 -2.54497,-0.14939 -26.04512,-6.83505 -26.76758,-7.61524 -0.4134,-0.44644 -2.19867,-4.18554 -3.9668,-8.31054 -1.76814,-4.125 -3.72947,-8.00033 -4.35937,-8.61133 -1.26418,-1.22625 -17.47922,-6.38867 -20.06641,-6.38867 -0.89572,0 -4.75754,1.575 -8.58008,3.5 -3.82254,1.925 -7.65317,3.5 -8.51367,3.5 -1.37994,0 -15.47009,-12.27143 -16.3125,-14.20703 -0.17926,-0.41187 1.28324,-5.04478 3.25,-10.29493 1.96675,-5.25016 3.57617,-10.25955 3.57617,-11.13281 0,-0.87326 -1.69828,-3.67523 -3.77539,-6.22656 -2.0771,-2.55133 -4.32176,-5.3533 -4.98632,-6.22461 -0.98717,-1.29429 -0.47824,-3.09208 2.77734,-9.82422 2.19168,-4.5321 3.98437,-8.69291 3.98437,-9.24805 0,-0.55515 -2.7,-4.27417 -6,-8.26367 -3.3,-3.9895 -6,-7.6511 -6,-8.13672 0,-0.48561 2.29369,-4.62029 5.09571,-9.18945 l 5.09375,-8.30859 -1.84375,-7.72266 -1.84571,-7.7207 -6.5,-3.12891 c -3.575,-1.72084 -6.9833,-3.57883 -7.57421,-4.12695 -0.59091,-0.54811 -1.61866,-5.43397 -2.28516,-10.85742 -0.6665,-5.42346 -1.53319,-10.14292 -1.92578,-10.48829 -0.39258,-0.34538 -5.0161,-1.60102 -10.27344,-2.79101 -9.38218,-2.12366 -9.6686,-2.27593 -15.5,-8.20899 -3.26766,-3.32462 -6.6986,-6.46253 -7.625,-6.97265 -1.08382,-0.5968 -4.11443,-0.43192 -8.5,0.46094 -3.7486,0.76317 -8.50384,1.68313 -10.56641,2.04492 -3.27924,0.5752 -4.37181,1.57926 -8.69921,7.99219 -5.51461,8.17228 -5.02561,7.94626 -19.9043,9.16601 l -8.35352,0.68555 -4.44531,6.03906 c -2.44515,3.3215 -4.76121,6.59777 -5.14648,7.28125 -0.38526,0.68348 -6.32618,2.69531 -13.20118,4.46875 l -12.5,3.22266 -9.25,-4.28516 c -5.0875,-2.35776 -9.24809,-4.78537 -9.24609,-5.39453 0.002,-0.60916 1.82421,-3.80742 4.05078,-7.10742 7.81105,-11.57678 7.70479,-10.75216 2.23438,-17.1211 l -4.78907,-5.57422 -10.93945,0.34766 -10.93945,0.34766 -2.91992,-9.48047 -2.91797,-9.48047 4.07812,-8.22461 4.08008,-8.22461 -5.58789,-6.54492 c -3.07307,-3.59986 -5.91652,-6.54492 -6.32031,-6.54492 -0.40379,0 -4.59119,2.49745 -9.30469,5.55078 l -8.57031,5.55078 -8.82813,-2.39453 -8.83008,-2.39453 -0.875,-9.02149 -0.875,-9.02148 4.125,-6.94336 c 2.2687,-3.8188 4.11532,-7.59176 4.10352,-8.38477 -0.0118,-0.79301 -3.42807,-3.4664 -7.59375,-5.9414 l -7.57422,-4.5 0.65039,-9.17383 0.64844,-9.17383 -7.17578,-4.19141 -7.17578,-4.1914 0.45898,-7.92774 c 0.27459,-4.74343 0.0544,-8.17527 -0.54687,-8.54687 -0.55261,-0.34153 -5.26197,-0.98093 -10.46485,-1.41992 -5.20289,-0.43898 -9.64419,-0.98209 -9.86914,-1.20703 -0.22494,-0.22495 -0.91921,-3.27997 -1.54297,-6.78907 -0.62377,-3.5091 -1.50451,-6.87266 -1.95703,-7.47461 -0.45252,-0.60195 -5.71969,-2.12094 -11.70508,-3.37695 l -10.88281,-2.28516 -0.61523,-5.12109 c -0.33848,-2.81704 -0.61805,-6.59329 -0.62305,-8.39258 -0.0109,-4.10313 -1.42079,-4.73083 -13.982421,-6.21484 -5.21022,-0.61552 -9.777672,-1.61177 -10.150392,-2.21484 -0.372721,-0.60308 -1.925636,-4.19683 -3.451172,-7.98633 l -2.773438,-6.89063 4.673828,-4.74609 c 2.570974,-2.61022 4.673824,-5.4554 4.673824,-6.32227 0,-0.86686 -2.73741,-4.9006 -6.08398,-8.96484 -3.346573,-4.06423 -6.312055,-7.93096 -6.589844,-8.59375 -0.277789,-0.6628 2.260013,-5.20335 5.640625,-10.08985 l 6.146489,-8.88476 -1.644536,-11.90039 c -0.90508,-6.54477 -1.865052,-12.25425 -2.132812,-12.6875 -0.267761,-0.43324 -3.939245,-2.52358 -8.160156,-4.64649 l -7.675782,-3.85937 -2.855468,-13.24219 -2.855469,-13.24023 2.777344,-8.17969 2.777343,-8.17969 -6.833984,-13.14843 -6.833984,-13.1504 4.068359,-9.29296 4.066406,-9.29297 -2.289062,-13.5 -2.289063,-13.5 7.8125,-7.97461 7.814453,-7.97266 4.320313,2.41992 c 2.376231,1.33155 5.546214,3.12061 7.042969,3.97461 2.707689,1.54491 2.756709,1.51625 9.749999,-5.42187 4.14267,-4.10999 7.02267,-7.80539 7.01367,-9 -0.008,-1.11475 -1.08151,-5.45766 -2.38476,-9.65235 l -2.36914,-7.62695 6.468753,-8.12305 c 3.558658,-4.46781 6.769418,-8.10046 7.134758,-8.07226 0.36533,0.0282 5.38906,2.44806 11.16406,5.3789 l 10.5,5.33008 1,13.86914 1,13.8711 9,6.20507 c 4.95,3.41281 9.92525,6.22531 11.05469,6.25 1.12943,0.0247 6.72918,-1.53007 12.44531,-3.45507 5.71613,-1.925 11.22909,-3.5 12.25,-3.5 1.02091,0 6.44422,2.60769 12.05274,5.79492 l 10.19726,5.79492 10.20508,-0.38867 10.20313,-0.38672 4.62109,-7.41406 4.62109,-7.41406 -5.2207,-7.24415 c -2.87141,-3.98375 -5.361,-7.41276 -5.5332,-7.61914 -0.1722,-0.20638 -5.58321,-1.44506 -12.02539,-2.7539 -6.44217,-1.30884 -11.80867,-2.49021 -11.92578,-2.625 -0.11711,-0.13478 -2.27791,-4.3726 -4.80078,-9.41797 -2.52287,-5.04538 -5.1512,-9.54538 -5.8418,-10 -0.69061,-0.45463 -7.33571,-2.88773 -14.76563,-5.40625 l -13.50976,-4.57813 -1.5957,-9.67187 c -0.87809,-5.31857 -1.78411,-9.81016 -2.01368,-9.98242 -11.08639,-8.31859 -13.89048,-9.67927 -23.42773,-11.36914 -5.69617,-1.00928 -9.91283,-2.26064 -10.20703,-3.02735 -0.27574,-0.71857 0.90002,-4.79011 2.61328,-9.04883 1.71324,-4.25872 3.11237,-8.54134 3.10937,-9.51757 -0.003,-0.97623 -1.5722,-4.88461 -3.48632,-8.68555 -3.35174,-6.65566 -3.63785,-6.93288 -7.75,-7.5 -2.34838,-0.32387 -8.293588,-1.14049 -13.212901,-1.81445 l -8.94531,-1.22461 -2.8125,-9.5 c -1.54752,-5.225 -3.46319,-9.98464 -4.25781,-10.57617 -0.794618,-0.5916 -5.035117,-1.89653 -9.421875,-2.89844 -9.168186,-2.09396 -8.615774,-1.20337 -9.640625,-15.54102 l -0.679688,-9.51562 8.578126,-6.89844 c 6.814363,-5.48041 8.481606,-7.32995 8.107421,-8.98633 -0.259096,-1.14693 -1.326376,-6.03263 -2.373047,-10.85742 l -1.904296,-8.77149 5.378906,-8.22851 c 2.957938,-4.52521 5.386388,-8.82556 5.396488,-9.55664 0.0101,-0.73108 -2.735558,-5.58124 -6.101566,-10.77735 l -6.121094,-9.44726 5.251953,-8.22266 c 2.88807,-4.5229 5.723137,-8.96859 6.300777,-9.8789 0.81249,-1.28038 3.32999,-1.80216 11.11914,-2.30274 15.384991,-0.98874 14.752611,-0.69845 22.216801,-10.11719 3.67158,-4.63302 7.23692,-8.42383 7.92383,-8.42383 0.68691,0 5.92111,1.81064 11.63086,4.02344 l 10.38086,4.02149 3.49609,10.64062 3.49805,10.64063 9.0957,3.95508 9.09375,3.95703 1.75391,6.63086 c 3.30991,12.51985 3.19257,12.37544 13.7539,16.71875 l 9.50977,3.91211 3.61133,12 c 1.98653,6.6 3.60942,12.37616 3.60742,12.83789 -0.002,0.46173 -2.78749,4.36048 -6.18945,8.66211 l -6.18555,7.82031 0.26367,10.55469 c 0.37297,15.00397 0.5481,15.41474 8.64844,20.18359 l 6.89844,4.06055 2.36523,11.93945 c 1.30107,6.56717 2.68877,12.27898 3.08399,12.69336 0.39523,0.41437 4.48591,1.22545 9.08984,1.80273 8.20095,1.02831 8.53989,1.18208 16.71094,7.5625 l 8.33984,6.51368 9.43359,-7.84571 9.43555,-7.84765 10.30078,0.89453 c 5.6657,0.49193 10.39441,0.97371 10.50782,1.07031 0.11341,0.0966 1.34009,5.53213 2.72656,12.07813 1.38648,6.546 3.09481,12.41819 3.79492,13.04882 1.69792,1.52941 12.76426,5.59357 13.68359,5.02539 0.39874,-0.24644 2.43896,-4.29754 4.53516,-9.00195 2.09621,-4.70441 4.24418,-8.55273 4.77148,-8.55273 0.5273,0 4.57836,1.59132 9.00391,3.53711 l 8.04688,3.53711 7.92773,-5.20508 7.92969,-5.20508 11.62304,3.5957 11.6211,3.59571 8.81445,-6.03711 c 9.85382,-6.74901 9.94954,-6.96623 7.33399,-16.79493 l -1.45704,-5.47656 7.01954,-11.24609 c 3.86098,-6.18514 7.33413,-11.41436 7.71875,-11.61914 0.38461,-0.20477 4.50876,-0.1044 9.16406,0.22265 l 8.46484,0.59571 2.53516,13.2539 2.53515,13.25391 6.71875,4.49609 c 3.69523,2.47273 6.92684,4.4961 7.17969,4.4961 0.25285,0 3.14063,-1.59848 6.41797,-3.55078 4.9714,-2.96145 6.49893,-4.57707 9.22461,-9.75 1.79688,-3.4102 4.12455,-7.43049 5.17187,-8.93555 l 1.9043,-2.73828 8.9043,5.98828 c 4.89674,3.29256 9.77278,5.98633 10.83594,5.98633 1.06317,0 4.92801,-0.92561 8.58984,-2.05664 l 6.6582,-2.05664 6.88086,6.09961 6.88086,6.0996 8.9961,-4.51757 8.99609,-4.51953 2.72461,-8.36524 2.72461,-8.36719 -4.37695,-8.21484 -4.37696,-8.21484 -8.05664,-3.77539 c -6.97703,-3.26957 -8.20815,-4.23628 -9.17187,-7.19532 -0.61218,-1.87968 -2.82117,-8.28716 -4.90821,-14.24023 l -3.79492,-10.82422 4.43945,-8.49609 4.4375,-8.49414 9.48243,0.31835 9.48046,0.32032 5.51954,-8 5.51757,-8 v -9.36719 -9.36719 l 8.83399,-8.10547 8.83594,-8.10547 6.16406,2.34375 c 9.67968,3.6795 10.60428,3.66023 18.61719,-0.375 3.99388,-2.01129 7.7777,-3.45874 8.4082,-3.21679 0.6305,0.24194 2.43651,4.43446 4.01367,9.3164 1.57716,4.88194 3.28422,9.39682 3.79297,10.03321 0.50875,0.63639 3.71829,1.44769 7.13086,1.80273 11.78279,1.22588 11.16886,0.8309 17.22266,11.11719 4.35478,7.3994 6.02264,9.42287 7.76367,9.42187 3.62162,-0.002 19.02931,-2.90689 19.80859,-3.73437 0.39574,-0.42021 2.11046,-5.45046 3.81055,-11.17774 1.70009,-5.72727 3.20631,-10.5077 3.3457,-10.62304 0.13939,-0.11533 5.16013,-1.27855 11.1582,-2.58594 l 10.90625,-2.37695 2.93165,-9.5 c 1.61243,-5.22503 3.31666,-9.9036 3.78906,-10.39649 1.47297,-1.53687 21.32144,-4.51921 22.68359,-3.4082 0.66882,0.5455 4.46932,5.01688 8.44531,9.93555 l 7.22852,8.94336 7.99414,-0.28711 7.99609,-0.28711 2.71094,-10.81836 c 1.49116,-5.94978 2.86867,-10.96377 3.06055,-11.14259 0.19189,-0.17883 4.9732,-1.74745 10.625,-3.48437 z m -272.76369,772.19246 2.61118,-20.05204 -10.63882,-16.92703 14.86118,-11.05203 6.48617,-14.17704 21.17926,-3.66061 10.4629,17.7685 20.71323,0.11266 16.11705,-20.12827 15.44029,2.87699 3.49419,9.69485 3.14258,9.43164 -5.21485,5.99609 -5.21679,5.9961 0.70703,11.4707 0.70508,11.4707 -8.99219,4.51563 c -4.94609,2.48408 -9.32237,5.04839 -9.72461,5.69922 -0.40223,0.65084 -1.92551,5.94311 -3.38477,11.75976 l -2.65234,10.57617 -7.94141,1.32227 c -2.18407,0.3638 -6.13862,0.78808 -7.72955,1.17856 l -13.8529,-14.09019 -21.48418,6.18126 z m -3.24632,-0.2942 -16.81368,8.12948 -14.27968,-15.78842 -15.60289,14.35804 -14.8529,-9.03842 -16.6029,9.78481 c -0.73753,-0.95776 -3.48929,-4.27726 -6.11524,-7.37695 -2.62587,-3.09968 -4.77539,-6.12466 -4.77539,-6.72266 0,-0.598 2.09899,-4.48804 4.66407,-8.64453 l 4.66406,-7.55664 -1.72266,-8.2207 c -0.9472,-4.52084 -1.93286,-8.9526 -2.1914,-9.84766 -0.25854,-0.89506 -3.78626,-3.07244 -7.83985,-4.83984 l -7.37109,-3.21289 -1.2168,-9.9336 -1.34322,-10.52388 9.204,-9.77466 20.73738,11.29471 19.1464,-7.13557 6.90321,-15.27579 23.09972,1.78576 6.30593,13.90125 18.14998,-0.948 11.49453,12.50181 -5.87915,13.45859 -16.37914,12.08359 11.37086,18.20859 z m 122.43246,-159.84321 -6.62203,17.15976 -16.10783,4.08995 -3.91025,15.49205 -19.46658,4.00156 -14.76103,-10.45417 5.36208,-22.68089 -11.34332,-14.5492 4.83174,-19.76407 20.03455,-5.26842 1.91492,-22.85768 15.52674,-7.92005 1.82655,-19.32215 13.84736,0.21168 13.4938,15.06093 -10.90138,13.29315 9.33956,17.09385 -16.20469,18.15451 z m -6.2461,-83.29628 -3.05719,-1.52772 m -386.59581,55.5898 8.09876,24.17398 18.37431,1.57652 7.92707,19.6108 -8.63571,22.83195 -14.91128,0.29292 -2.15184,-2.20357 -6.50196,-7 c -3.57613,-3.85 -6.90096,-6.99905 -7.38867,-6.99805 -0.48771,10e-4 -4.85389,2.6441 -9.70312,5.87305 l -8.81641,5.86914 -6.68359,-1.68555 c -6.04517,-1.52542 -6.74307,-1.9669 -7.31445,-4.62305 -1.72847,-8.03505 -1.04018,-11.32587 4.13085,-19.72851 2.75,-4.4686 4.25,-6.98967 4.25,-7.4375 l 13.53802,-5.21357 0.89401,-24.29428 z m -109.10533,-67.776 c -0.73862,-0.613 -4.82059,-0.47372 -10.521491,-1.14257 -11.49848,-1.34908 -12.7193,-2.04725 -14.843747,-8.48438 l -1.375,-4.16211 5.134767,-5.9043 c 2.82421,-3.24706 5.13476,-6.17649 5.13476,-6.50976 0,-0.33327 -3.15,-4.46001 -7,-9.16992 -3.849998,-4.70991 -6.999996,-8.8234 -6.999996,-9.14258 0,-0.31918 2.137498,-3.73254 4.75,-7.58399 l 2.717066,-3.73158 2.85615,-3.25739 22.020831,0.95552 13.96231,-22.43077 15.65058,1.57424 12.95971,17.52075 -0.48228,14.98288 -15.89777,6.28786 -1.81109,22.31908 -15.26388,6.25753 z m 45.01299,-43.30953 16.64857,11.63137 3.90933,20.37628 21.06359,5.20456 4.71173,21.90995 -12.41907,15.14702 -16.66171,-3.32614 -12.46377,12.61638 c -0.41298,-0.66823 -4.25325,-0.43808 -10.25088,-0.89637 l -10.41602,-0.79492 -1.55273,-7.48828 -1.55274,-7.48829 -10.79101,-2.52148 c -6.12539,-1.43106 -11.20155,-3.16586 -11.73633,-4.01172 -0.51823,-0.81968 -0.94903,-4.47398 -0.95703,-8.11914 -0.005,-2.15522 0.124,-5.72205 -0.10493,-7.02818 l 10.37088,-10.68849 16.72964,-6.96729 1.46251,-22.50219 z m 48.00788,60.83611 14.04902,6.75209 6.48915,24.22537 -4.67747,16.34797 -17.62468,1.34555 -0.87468,24.84555 -11.12436,4.4411 c -1.55581,-1.16186 -4.95181,-3.52744 -7.31254,-5.06624 l -8.58398,-5.5957 0.66211,-9.13281 0.6621,-9.13086 -7.20507,-4 -7.20508,-4 0.56445,-8.04493 c 0.15518,-2.21192 0.21524,-6.65121 0.18718,-8.31921 l 12.24648,-11.892 16.50887,3.24639 z m 52.67639,97.37791 17.14889,1.37076 9.24539,18.40516 17.48436,-12.96122 16.56813,8.86552 4.57872,15.09175 -9.15238,16.00013 15.5976,10.28047 -4.35352,6.23243 -5.22656,7.60547 -9.2793,0.70117 c -5.10324,0.3856 -10.31306,0.94883 -11.57812,1.25195 -1.33627,0.32018 -4.53006,3.56638 -7.6211,7.74609 l -5.32031,7.19532 -10.47461,2.72461 -10.47266,2.7246 -7.13671,-3.29101 -7.13477,-3.28906 5.45898,-8.5 c 3.0029,-4.675 5.46937,-8.80819 5.48047,-9.1836 0.0111,-0.37542 -2.79297,-3.91025 -6.23047,-7.85547 l -6.25,-7.17382 -10.52929,0.52539 -10.5293,0.52539 -2.12695,-7.5918 -2.12696,-7.58984 3.56055,-7.19922 4.358,-8.43471 17.31779,-0.55355 z m 67.19668,32.25849 22.54618,-5.97687 17.69301,11.59361 23.50841,-8.47075 11.71459,7.64217 0.6455,22.95255 -9.74201,10.27599 -2.61509,-0.51945 -10.20707,-2.46483 c -5.61908,-1.39646 -7.5615,-2.52273 -13.15039,-7.63672 l -6.55664,-6 -2.31637,-2.50001 -3.54102,-0.0977 c -0.17489,0.22222 -5.38331,1.35429 -11.57421,2.51367 l -10.75293,1.80371 -13.7742,-9.10946 z m 543.15502,-262.3756 5.47077,9.2517 c 5.46654,9.72575 6.50505,11.01686 8.85156,11.00586 2.19827,-0.0103 14.44322,-3.03868 22.05664,-5.45508 1.44092,-0.45733 3.08083,0.94657 7.12891,6.09765 l 5.25976,6.69141 -2.27148,12.5293 -2.26953,12.52734 3.87695,3.48242 c 2.13272,1.91484 6.03948,5.46937 8.68164,7.90039 l 4.80469,4.41992 -2.53516,8.54493 -2.5332,8.54492 4.07422,4.86719 c 7.02129,8.38617 7.99017,8.86328 18.00781,8.86328 h 8.85156 l 3.46875,6.88476 3.4668,6.88672 -6.9375,3.10156 c -6.8601,3.0674 -6.98292,3.19086 -10.89062,10.96289 l -3.95118,7.86133 -12.3164,2.19727 c -6.7731,1.20828 -12.98946,2.55243 -13.81446,2.98633 -0.825,0.4339 -3.61891,4.5521 -6.20898,9.15234 l -4.70898,8.36328 -7.79122,2.85553 c -2.14247,0.78516 -6.69628,3.04763 -8.32001,3.78182 l -10.54704,-7.02905 -7.7186,-17.81243 -18.50198,1.633 -11.37231,-8.81782 1.03931,-17.48856 18.47077,-6.55665 -1.02922,-19.93166 -25.60182,-12.64029 -4.4432,-19.35742 17.31936,-14.9377 -14.67723,-17.2358 5.6521,-18.82679 11.29467,-9.36946 z m -635.24266,51.6768 -17.22298,0.87845 -8.3005,-15.91847 8.75218,-18.28365 -10.56153,-15.59418 11.97749,-16.2129 -4.77123,-15.10167 15.91164,-11.30098 -6.79895,-19.48291 10.37524,-15.01572 17.68144,6.65218 17.97498,-9.26647 18.54635,1.84227 10.79332,13.94518 23.13691,5.29614 16.02815,16.23807 20.66464,-0.92614 13.57408,12.59227 -10.25631,19.99363 -20.50936,-0.15892 -11.40862,13.47076 -18.92163,-2.70432 -13.088,12.23332 -17.94935,-1.99721 -13.70673,16.82952 -17.06547,-0.40622 z m 114.32733,74.47537 -2.16636,-1.92306 m 0,0 -24.617,-7.7567 -9.03523,-17.40609 -22.49429,-1.79168 -6.31924,-13.05362 3.84542,-11.81617 24.26313,-7.57354 3.93382,-20.30146 19.8379,-14.68815 18.57985,1.69956 9.99025,14.68897 21.96428,-3.88457 10.06951,16.23846 19.46902,-4.59086 12.58543,9.54424 3.49662,15.16709 -9.43659,14.81352 -23.44666,-0.57493 -12.06892,20.59138 -26.78244,-3.15032 z m 246.84132,4.55423 -2.16032,18.73287 -15.41032,8.47032 -2.16032,22.22032 -18.91032,5.09532 -6.30049,22.06498 11.36113,15.05243 -5.0416,21.91856 -18.39337,8.2897 -18.52793,-3.78866 -7.32875,-12.6467 8.42583,-16.10642 -20.1646,-18.46181 7.33541,-17.35458 -10.91276,-15.32672 19.49283,-13.02863 -7.24036,-19.71776 14.85674,-19.36423 14.49943,-4.02526 17.6814,14.5363 13.9691,-19.40483 15.29307,1.25774 z m -101.83296,38.58523 11.05838,15.46813 -7.57702,17.45081 20.17299,18.57581 -7.79554,15.78891 7.45903,13.34805 -13.3678,15.8456 -18.14077,1.17314 -9.74387,15.05011 -24.70853,-1.87704 -8.09152,16.62646 -15.64702,5.78949 -19.81225,-11.28692 -0.86758,-23.62418 -13.47851,-9.05066 7.47433,-23.95775 -12.52567,-15.20776 3.98717,-26.60388 20.96355,-12.28333 10.71354,-18.78333 26.71355,5.84167 21.85677,-8.45416 z m -102.90322,-201.43128 -13.98681,4.65358 -14.98779,-13.97869 -20.50148,1.06242 -15.78182,-16.49823 -22.67826,-4.83402 -11.23632,-14.23567 -19.97523,-1.70097 0.4279,-16.01556 10.81853,-8.1009 17.57892,-1.53872 9.21645,-17.11455 -8.70347,-11.66529 3.91852,-17.47249 20.50888,-3.60548 3.87931,-19.20105 15.06801,-8.99089 16.02381,9.54792 23.35378,-5.12455 8.76968,-15.64277 -8.73119,-12.99111 7.72152,-5.24828 c 14.19627,-9.59436 13.41239,-8.33464 11.04101,-17.77734 l -2,-7.96485 6.32618,-9.86523 6.32617,-9.86328 5.78125,0.34961 c 3.17922,0.1926 5.88808,0.45204 6.02148,0.57617 0.1334,0.12413 1.37209,5.81517 2.75196,12.64844 1.95724,9.69279 2.96506,12.73768 4.58398,13.84765 1.14132,0.78251 4.40261,3.0483 7.24805,5.03516 l 7.30669,4.57138 2.31977,21.00839 -11.5572,14.73282 -16.92722,1.62332 -17.75523,24.04971 7.61225,15.0341 -4.93892,17.42058 -20.93719,10.61468 10.35227,23.78456 -10.94933,21.57483 8.17918,19.15587 z m 60.94722,42.63254 -12.55926,-16.15429 5.25653,-14.0606 16.03204,-7.65529 0.23197,-25.57041 15.30584,-14.87878 17.36651,13.40917 18.40204,-11.44312 14.76663,17.03937 20.93085,-5.44961 2.98694,18.11866 -2.559,21.76142 -15.43965,8.12557 3.04499,20.67671 -14.53432,11.954 -16.56097,-8.64157 -18.73888,8.56479 -13.07835,-16.84507 z m 117.04171,95.8642 -12.41967,17.7971 -17.10424,-13.84594 -15.33648,4.0085 -11.51628,-22.76941 -16.88862,-1.36132 -18.6564,-16.56412 -19.54027,17.73056 -20.42415,-11.96793 -3.39285,-14.01929 19.74981,-16.6114 -10.74415,-20.23534 9.8307,-15.46516 19.09056,-0.85604 13.34435,16.96629 19.61021,-8.64571 17.39304,8.77468 15.27432,-12.50395 14.71721,2.47724 8.34032,15.53988 -9.30156,16.48283 12.23409,15.90929 -7.44247,16.64212 z m -6.50057,-73.86286 -8.83142,-15.60831 -14.91387,-2.492 -2.76629,-18.95849 15.15561,-7.6049 3.11339,-22.19942 16.1551,-5.83641 10.75865,-20.39707 16.03009,3.76714 15.3008,-6.96448 4.07276,-20.48804 17.55299,-6.30178 14.15334,7.57625 1.32489,16.88644 21.27175,5.67418 0.0977,20.16458 18.67538,9.79111 -1.28953,22.43236 24.25172,24.13874 -9.07069,13.09019 -25.42252,8.5824 -6.77259,25.1994 -17.39285,14.51072 -13.1873,-10.21208 -6.83816,-25.944 -29.35052,-0.69096 -14.95045,-11.94899 z m 128.12689,90.85086 -22.03337,15.73941 -15.8137,-2.51059 -5.93871,-20.13558 -23.94111,-4.40676 -11.25963,17.29031 -13.95669,-0.63357 -10.14311,-21.77221 -16.29059,-1.55356 -12.34257,-21.2734 7.90743,-17.89841 -12.2367,-14.8045 9.1398,-16.53633 24.77758,-3.20738 14.68508,11.85724 28.57205,0.56991 6.18728,25.11217 16.01437,11.66901 19.45188,-15.2643 11.42044,9.75505 -5.55012,25.48818 13.89531,8.87117 z m 141.29286,-96.10361 13.71955,16.0685 -16.77444,14.03555 4.86971,20.32677 -12.11593,8.48776 -22.35337,0.54843 -0.35337,23.04842 14.64663,8.04842 8.38184,20.67896 -1.91477,17.74287 -14.86365,3.15879 -14.40709,-14.72745 -16.96131,2.45819 -15.3363,-13.54181 -21.71131,2.45819 -10.96131,-9.91681 -19.9387,4.98941 -12.63663,-7.63364 3.15432,-14.94263 -13.99302,-9.10901 5.62919,-25.0189 -13.28591,-11.23033 6.0266,-22.97112 24.9417,-8.829 10.44602,-14.92779 23.62214,-9.22367 16.19752,6.59784 14.51814,-9.93079 13.36909,13.58052 18.05368,-4.45071 15.04846,13.6689 z m -199.43348,-121.08535 -19.07883,7.08376 -3.88947,20.13743 -12.6503,5.81905 -17.9936,-3.70976 -11.49359,21.29023 -13.1186,5.04024 -2.71215,-17.97226 -3.03451,-20.78402 13.67088,-13.35939 -7.89587,-28.03185 6.10006,-19.33217 19.62718,0.89892 14.51843,-15.18409 -4.70278,-17.79499 20.91766,-7.26047 21.71512,12.10234 -3.40987,23.22735 16.59012,9.72734 -3.27178,20.10364 z m 29.16776,-241.8393 c 0.67233,-2.00843 1.83366,-5.69016 2.82813,-9.1694 1.62158,-5.67325 3.29375,-10.62063 3.71679,-10.99414 0.42304,-0.37351 4.97647,-1.51149 10.11719,-2.5293 5.14072,-1.01781 9.96823,-2.3189 10.72852,-2.89062 0.76029,-0.57172 2.68657,-5.31497 4.28125,-10.53906 l 2.90039,-9.49805 6.87109,-1.18945 c 11.27398,-1.95276 10.58823,-2.2384 18.5625,7.7207 3.97856,4.96885 8.15082,9.26453 9.27148,9.54492 1.12068,0.28043 6.06458,0.37659 10.98633,0.21485 l 8.94922,-0.29297 2.85547,-11.5 2.85742,-11.5 8.19336,-2.40431 c 4.50723,-1.32155 8.46502,-2.44655 8.79297,-2.5 0.32794,-0.0534 3.76757,1.68017 7.64453,3.85157 l 7.04883,3.94727 2.09961,10.47461 2.09766,10.47265 -4.99414,9.26368 c -2.74672,5.09514 -4.99415,10.20364 -4.99415,11.35156 0,1.14792 1.80318,5.67181 4.00586,10.05273 l 4.00391,7.96485 -9.00391,4.52148 -9.00586,4.52344 v 9.1543 9.15624 l 8.98829,8.58399 8.98632,8.58594 -2.08007,11 -1.92244,9.12194 -21.68606,3.61437 -10.1072,-15.47751 -19.48372,-3.47123 -19.42566,4.0721 -16.9713,-11.23199 6.02528,-17.32826 -16.1176,-19.10144 -19.9183,2.28854 z m -109.96258,108.98094 -10.2757,-9.1601 0.60156,-2.76368 2.22656,-6.91211 c 1.2242,-3.80098 2.22461,-7.82088 2.22461,-8.93359 0,-1.1127 -2.1375,-5.96982 -4.75,-10.79492 l -4.75,-8.77344 -7.46679,-3.42578 c -4.10681,-1.88375 -7.79046,-3.94938 -8.1875,-4.5918 -0.39703,-0.64242 -2.56125,-6.43825 -4.8086,-12.8789 l -4.08593,-11.71094 3.6914,-6.7168 3.69336,-6.71679 8.64649,0.56054 c 4.75653,0.30817 9.12196,0.25693 9.70117,-0.11328 0.5792,-0.3702 3.85145,-4.94083 7.26953,-10.1582 l 6.21289,-9.48633 -0.69141,-7.83203 c -0.78827,-8.90494 -0.98758,-8.53888 9.64454,-17.78125 l 5.61914,-4.88477 7.50976,3.0586 7.50977,3.05859 7.33203,-3.69141 c 4.03261,-2.03078 7.49245,-3.30192 7.68945,-2.82421 0.197,0.47772 1.78933,4.91914 3.53906,9.86914 l 3.18164,9 9.32227,0.89257 9.32031,0.89258 5.67969,9.84571 c 3.12325,5.41415 5.06482,9.31533 5.88982,9.5705 l -6.43706,20.18448 -17.28777,8.71492 0.56228,23.87639 -12.31162,13.82785 -19.92756,-3.80481 -14.46167,15.97048 4.83742,19.21545 z m 209.49749,108.26801 0.10441,19.9823 -21.5722,6.7455 -20.59991,-7.30824 -17.85989,20.97601 -15.73855,-0.8559 -13.08692,16.11467 -19.29733,-5.43884 -1.63201,-16.93469 -15.43247,-7.9771 9.80815,-17.66275 3.18179,-22.59093 -16.63506,-9.87868 2.97477,-21.75108 14.69514,-8.31318 19.74467,13.04779 14.37466,-5.94534 15.73438,19.26911 18.43724,-6.17019 26.7192,15.28571 z m -109.46991,-57.93122 2.039,-20.5054 -16.14611,-7.57574 -1.7388,-15.17716 6.48131,-17.29847 -17.20677,-15.44231 -17.20676,4.79862 -7.30728,-13.67454 13.56653,-14.39974 -1.26833,-23.72642 15.42067,-7.58504 20.33347,19.16485 16.07828,-1.81918 13.05253,11.29515 -7.70567,15.14786 6.95628,24.67128 33.88865,1.025 21.61252,11.26299 21.63412,-11.06086 6.21308,13.96854 17.8823,8.20749 3.35686,15.65619 -12.73205,13.35428 17.58935,14.11488 -11.60306,12.22755 14.5326,14.99333 -5.42173,18.56885 -21.06666,5.42469 -15.84289,-19.71364 -28.53922,-16.00195 -17.42216,5.52139 -15.62805,-18.869 -15.85454,5.92665 z m -17.59618,8.63609 -23.33902,-12.79088 -24.33759,9.10913 4.53284,18.89366 -12.24382,12.95064 -18.24166,-1.09315 -20.84607,-17.91271 -1.88546,-15.03876 15.70381,-14.77362 -11.46095,-19.27503 11.49015,-16.40503 18.89793,-5.74071 -5.58008,-20.42286 12.37407,-13.38495 18.52372,3.64654 8.39641,15.81655 18.2959,-4.86632 14.5836,12.72296 -6.18767,16.34688 1.85567,17.58431 15.73264,7.41966 -1.85664,18.73337 z m 2.2669,-161.56895 c 6.38529,-0.65219 22.31452,-3.39847 23.06893,-4.15288 l 7.45968,18.41177 20.82777,-2.04254 13.77187,16.43322 -6.50078,18.25079 20.59632,13.3384 19.94758,-4.47256 17.68088,3.19579 10.19973,16.05562 23.01603,-4.18531 3.66697,10.66031 c 3.10435,9.2881 4.22843,11.5366 5.84375,11.69531 1.1,0.10808 4.94861,0.472 8.55274,0.80859 l 6.55469,0.61133 2.94531,6.64063 c 1.62087,3.65211 3.0613,6.88887 3.19922,7.19336 0.13793,0.30448 -2.44957,4.34045 -5.75,8.96679 -3.30042,4.62633 -6.00196,8.81953 -6.00196,9.32032 0,0.50072 2.56201,3.59796 5.69336,6.88085 l 5.69336,5.96875 -3.19336,6.47461 c -1.75635,3.56169 -3.19336,7.3571 -3.19336,8.4336 0,1.26807 2.65385,3.95493 7.53516,7.6289 4.14389,3.11894 7.40639,5.94593 7.25,6.28321 -0.15638,0.33729 -1.56015,2.91012 -3.11914,5.71679 l -2.83398,5.10352 -1.6875,2.92967 -25.53615,-0.03 -12.10589,-12.11694 11.88026,-13.13013 -17.48264,-13.89643 11.32044,-12.3991 -4.16325,-18.98978 -18.23955,-8.11357 -7.14842,-16.40711 -23.62041,12.43602 -20.83047,-10.51119 -32.25696,-1.02816 -6.01015,-21.60657 8.24471,-16.15366 -16.08058,-13.80126 -15.92721,2.19762 -18.83644,-18.49057 z m 181.20462,185.05977 c 0.005,1.25443 1.16262,7.18206 1.49327,9.9329 l 1.35156,5.00195 0.65428,2.34955 1.209,2.15436 5.26172,6.61328 5.26172,6.61328 9.97851,-0.70899 c 5.48753,-0.38905 10.48335,-0.90069 11.10352,-1.13867 0.62017,-0.23798 3.09832,-3.57329 5.50586,-7.41015 2.40754,-3.83686 4.69642,-6.97461 5.08789,-6.97461 0.523,0 11.77226,8.54541 13.70117,10.4082 0.12894,0.12452 0.96111,3.74079 1.84961,8.03711 1.17278,5.67095 1.31004,8.00281 0.49609,8.50586 -0.61664,0.38111 -4.50622,1.48969 -8.64258,2.46484 l -7.52148,1.77344 -4.58008,7.88477 c -2.51972,4.33657 -4.60687,8.69442 -5.24805,9.22656 l -0.49,2.96283 1.03125,2.74219 c 0.62062,-0.004 3.03767,2.46764 5.37109,5.49218 4.04575,5.24405 4.66561,5.63162 13.3711,8.34571 5.02097,1.56537 9.04446,3.25242 8.9414,3.75 -0.10305,0.49759 -1.61715,4.30763 -3.36523,8.46679 l -3.17773,7.5625 5.14648,9.13282 5.14844,9.13086 -5.87305,8.30664 c -2.67828,3.78765 -7.1625,10.68894 -8.52104,12.73325 l -21.66614,-3.96184 -12.8273,10.53385 -5.91814,19.65477 -13.79262,-0.53194 -15.46999,-14.09876 -18.12163,4.46279 -13.43704,-14.36392 -15.3816,10.56158 -15.82354,-6.67414 -24.30882,9.67768 -23.99661,-23.64888 1.09772,-22.76899 -18.42742,-9.76899 -0.28535,-18.54231 12.99383,-15.70121 16.1758,0.73903 17.0597,-20.65096 19.71134,7.01461 24.30754,-7.65787 0.2659,-21.44645 22.18622,-5.71332 6.07089,-19.71708 z m -367.5914,-2.62601 -0.0443,-21.25288 -9.46393,-14.14014 11.80881,-14.73641 -2.58363,-22.39904 8.39892,-4.65868 c 4.36319,-4.26403 5.48679,-5.16087 8.66016,-10.66406 2.09278,-3.87463 4.13621,-7.24711 4.53906,-7.49609 0.0252,-0.0156 0.064,-0.023 0.11719,-0.0195 v -7e-5 c 0.7976,0.0518 4.6572,2.19654 8.96094,4.99609 4.59065,2.98618 8.65681,5.42969 9.03515,5.42969 0.37834,0 3.89029,-1.09054 7.80469,-2.42188 l 7.11719,-2.41992 6.46094,5.91992 c 3.55376,3.25637 7.17288,5.92188 8.04297,5.92188 0.87009,0 5.35519,-1.87109 9.96679,-4.15821 l 9.75977,-4.78515 10.76927,10.00186 -11.15104,15.7471 10.84354,19.03144 -15.08246,13.40254 1.82793,17.8382 -14.72825,5.59083 -15.61161,-10.43475 -18.24164,3.65058 -2.16348,19.6842 -16.06476,5.09412 z m 88.23464,-7.78597 18.41673,15.70065 -6.24363,20.03166 7.54496,27.45628 -13.57986,13.22577 2.94876,20.29683 -19.25118,5.19851 -15.39044,-17.18234 -18.93853,11.60192 -17.78321,-13.44858 -16.36899,15.89634 -15.20291,-5.30078 -1.30659,-17.18932 9.3089,-20.97854 -14.26306,-17.22961 -3.03051,-20.23816 13.50265,-7.29195 19.65923,13.11283 19.82138,-6.43896 1.65035,-19.13571 14.72285,-2.81703 16.06137,10.80486 z m -100.98078,147.9603 -21.1936,-0.83689 -7.03185,-15.63913 -15.00987,-8.5492 1.77665,-19.26906 -8.24938,-18.33278 10.90578,-22.07129 -9.7771,-22.24804 19.56783,-9.78531 5.86763,-19.24286 -7.56738,-14.82344 16.20907,-21.62935 15.55635,-1.52858 9.03812,12.94937 -0.24266,20.81593 -15.0694,8.48624 3.44877,23.15966 13.48094,16.22668 -9.14909,19.70415 1.76818,20.13092 16.35225,6.07718 -0.46233,23.94614 -14.96062,7.34429 z m 84.71673,122.36384 -15.24808,20.27197 6.80371,18.8004 -18.42271,12.32751 -22.38233,-10.98468 -22.38233,8.51533 -27.76465,-6.21935 -12.01466,20.03064 -19.76466,11.78065 -14.02567,-11.95776 1.47433,-23.33275 -23.83726,-9.2108 -3.33639,-17.61954 12.71716,-12.97678 28.09139,3.52094 11.82793,-21.11352 22.92544,0.69866 10.54314,-16.2159 20.65363,11.9888 19.23941,-17.35613 17.64843,15.7011 16.41099,1.3822 z m -89.61535,-52.57944 -13.56098,-9.96085 -18.59565,4.2967 -9.78713,-16.13618 -22.10342,4.22861 -9.9964,-14.43576 -19.24241,-1.98817 -4.89965,-19.56408 11.01372,-13.01781 20.95741,0.19625 10.85348,-21.43786 14.65411,-4.68944 15.18444,8.48042 7.05271,15.99344 23.40456,0.96741 12.356,15.99343 -10.09462,16.2586 10.58824,19.88252 z m -81.28035,82.84736 -17.88291,7.42353 -23.88783,-9.54138 -19.6985,7.62283 -17.09467,-10.68294 0.27233,-20.46112 -12.66268,-15.60756 6.78768,-18.6615 -5.33058,-18.07203 -17.08058,1.42796 -4.18547,-14.80895 13.10022,-20.37877 17.16608,0.3041 13.01184,-16.3129 17.96158,1.89509 12.56988,-12.15865 16.90093,2.33703 5.01532,19.7216 -20.08697,14.68345 -3.93472,19.46744 -24.79437,7.88855 -4.11148,14.16412 7.20221,15.57835 22.34435,2.24686 9.14156,17.19965 26.47117,8.3624 z m 489.68802,-11.8955 -16.35033,3.13562 -8.49987,-21.42653 -14.60166,-7.30309 0.27334,-19.67809 20.77334,-0.5531 12.89834,-9.05309 24.63139,12.29255 0.62819,17.14628 -18.4968,6.27127 z m -604.33251,-209.53116 -7.8596,-17.00804 1.8125,-2.33399 4.54102,-7.02148 c 2.49784,-3.86126 4.54101,-7.82467 4.54101,-8.8086 0,-0.98393 -2.73961,-5.65106 -6.08789,-10.37109 l -5.04394,-7.29101 5.02026,-15.15284 15.39526,-11.02783 -5.35473,-21.90283 17.88513,-14.13891 -3.48987,-16.88892 c 1.16829,-0.0564 4.65008,-3.70857 9.54883,-7.875 8.3767,-7.12444 9.06341,-7.50074 13.72266,-7.49024 2.69767,0.006 6.54151,0.28259 8.54101,0.61328 l 3.63477,0.60157 1.67969,8.63672 c 3.37778,17.36745 1.99866,15.49527 14.89062,20.21289 l 8.33789,2.53711 2.16206,-2.77733 3.25977,-7.41797 c 1.79283,-4.07954 3.56687,-7.41601 3.9414,-7.41601 0.37453,0 3.65788,1.35 7.29688,3 3.63899,1.65 7.32168,3 8.18359,3 0.86192,0 4.89245,-2.24554 8.95703,-4.98828 l 7.39063,-4.98633 11.55859,3.59961 9.17406,2.8925 9.05381,13.23921 -7.56319,12.79728 -20.4313,4.32715 -16.35653,-10.16225 -18.20772,10.55095 -3.68169,18.58801 -20.37193,3.471 -4.76381,20.97189 8.13071,11.84276 -7.00555,13.15235 -17.11635,1.40945 -12.82192,9.38586 -1.10289,19.82824 -13.88427,7.08235 z m -28.03198,112.26865 -21.43744,0.46174 -10.32475,-13.5146 -16.0104,-4.8493 0.2396,-19.2243 -17.3854,-10.4743 1.7396,-26.0993 -16.3854,-13.34931 3.9896,-17.7243 24.8646,-7.2243 1.8542,-20.3236 c 2.14906,-0.42437 6.28538,-1.54895 11.71177,-3.33208 l 12.71093,-4.17773 10,5.81836 10,5.81836 10.77149,-0.27735 11.56698,-0.0122 7.65495,17.69165 -11.43692,16.01227 5.88719,18.22197 -15.07892,11.40559 4.36651,16.17856 -12.13565,16.75134 10.84531,16.04424 z m 181.80343,222.82005 -20.89296,7.48658 -17.77507,-11.71663 -22.74582,6.34036 -4.58572,-15.65597 -19.15117,-10.03441 -15.75204,11.92119 -8.80597,-17.44519 -18.19144,-1.20236 -8.75895,-21.49455 -18.3615,-1.4618 -7.7794,-23.45292 4.84561,-17.07792 -6.91718,-27.07266 -15.29999,-7.46789 -4.67194,-22.70102 -21.13991,-5.52335 -3.88768,-19.03535 -17.48433,-13.01346 0.0557,-14.95889 19.50495,-1.73306 5.19379,-14.23055 20.87312,-0.40114 9.12463,18.09477 19.62462,-1.15523 4.74963,16.21977 17.2485,-1.37091 3.6235,14.25409 -6.75149,19.50409 13.46969,16.36499 -0.51863,21.24673 20.54444,12.02554 19.5305,-7.40747 23.7805,9.48531 20.061,-8.02938 21.811,8.09561 -1.439,22.84562 15.186,13.34562 -4.189,27.22061 12.99299,15.32785 z m 413.70506,-98.11949 1.73894,16.39386 -9.95787,1.29102 c -6.1577,0.70926 -11.59708,1.79136 -12.08594,2.40625 -1.71556,2.15785 -8.13672,17.99378 -8.13672,20.0664 0,1.45363 2.56837,4.04481 8.38868,8.4668 l 8.38867,6.37305 -1.88477,6.60546 c -1.56007,5.46713 -2.61644,7.21083 -6.12304,10.10743 -2.33038,1.925 -5.56483,4.67238 -7.1875,6.10742 l -2.94922,2.60937 -7.3711,-3.35937 c -4.05417,-1.84754 -8.35937,-3.35769 -9.5664,-3.35742 -1.38103,3.1e-4 -3.70031,1.76121 -6.25586,4.75 -6.90536,8.076 -8.20118,10.4932 -9.91602,18.5 -0.91291,4.2625 -2.10311,7.75 -2.64453,7.75 -0.54141,0 -4.12811,-0.71587 -7.9707,-1.58985 -5.65408,-1.286 -6.89133,-1.90583 -6.49024,-3.25 2.02126,-6.77376 3.72357,-15.35083 3.27735,-16.51367 -0.29532,-0.76959 -3.83437,-3.7308 -7.86524,-6.58008 l -7.33008,-5.18164 -14.78711,0.3086 c -10.30398,0.21376 -15.01879,0.67779 -15.54492,1.53125 -0.41531,0.67368 -1.57647,4.5283 -2.58203,8.5664 l -1.82812,7.3418 -7.62891,1.63867 -7.62891,1.63867 -3.21626,0.6322 -11.8652,-12.43867 -3.64507,-23.57559 -19.55129,-5.98632 -12.31806,-16.56695 16.01646,-18.20646 -9.28532,-16.90469 10.96468,-13.65468 -14.3709,-16.55374 9.76664,-15.73956 20.19374,4.01954 5.56459,19.79818 19.68958,3.04818 21.56458,-15.45182 13.81458,7.79818 19.56458,-5.07682 11.06217,9.69244 21.43717,-2.30756 15.56218,13.44245 16.81217,-2.68256 z m -147.55395,96.03433 -1.60838,13.82448 -1.78515,11.35157 -8.76563,5.27148 -8.76563,5.27149 -7.62304,-3.52149 c -4.19196,-1.93681 -8.06861,-3.52148 -8.61524,-3.52148 -0.54663,0 -5.12232,4.05 -10.16992,9 h -0.002 c -5.0476,4.95 -8.62715,9.21339 -9.14297,9.15189 l -1.23961,1.0674 -16.81952,-2.75139 -15.8268,20.76559 -17.21957,-0.18245 -10.69467,-19.02619 -23.38599,4.21994 -12.24905,-13.10417 -17.89006,0.99689 -5.52377,-13.00352 8.53625,-13.2362 17.9938,-1.30376 14.36988,-16.50657 19.23124,3.55759 19.31962,-8.64 15.51893,10.62866 22.94355,-4.48574 4.02845,-15.71107 16.22603,-3.51348 6.39105,-17.38234 16.38764,4.52987 3.39457,22.51559 z m 233.03019,-89.43034 -6.89048,19.54964 c 0.0147,1.13062 2.03965,4.72213 4.5,7.98047 2.46034,3.25833 4.47265,6.33768 4.47265,6.84374 0,0.50605 -2.7,4.66123 -6,9.23243 -3.3,4.5712 -6,8.76247 -6,9.31445 0,0.55197 2.7,3.59586 6,6.76562 3.3,3.16978 6,6.14382 6,6.60743 0,0.46361 -1.66539,3.35187 -3.70117,6.41797 -3.16946,4.77355 -4.85502,6.15441 -11.73437,9.61328 -8.7452,4.39701 -7.68323,4.34347 -22.68555,1.15429 -2.36655,-0.50308 -3.6574,-0.0733 -8.2832,7.66211 l -7.56285,11.30858 -11.28286,-0.47264 c 0.0515,-0.59638 -2.67847,-3.35546 -6.25781,-6.58594 l -6.50781,-5.87304 1.13867,-5.95704 c 1.05083,-5.49482 1.72877,-6.5568 8.74219,-13.71289 l 7.60156,-7.75585 -4.73242,-8.85547 -4.73242,-8.85743 0.5625,-8.88671 0.56054,-8.88672 -7.85351,-8.40821 -6.75519,-7.73963 -1.80143,-16.79737 16.05302,-3.45072 2.26443,-18.56513 16.76012,-3.36233 12.51749,9.18881 17.55561,-1.3294 7.65613,16.52504 z M 85.677449,498.76919 c -0.18873,-0.16883 -0.98845,-7.91794 -1.7754,-13.8709 -0.78696,-5.95295 -1.62881,-11.73763 -1.87109,-12.85547 -0.29687,-1.36973 -3.039408,-3.29945 -8.410155,-5.91796 -5.363095,-2.61481 -8.137532,-4.56561 -8.484375,-5.9668 -0.283465,-1.14514 -1.474822,-6.53 -2.648438,-11.96485 l -2.134765,-9.88085 2.583984,-7.2754 c 1.420635,-4.00171 2.582031,-8.0626 2.582031,-9.02343 0,-0.96083 -2.895046,-7.29295 -6.433593,-14.07227 l -6.433594,-12.32617 3.933594,-9.06836 3.93164,-9.06641 -2.232422,-12.95703 -2.234375,-12.95898 6.091797,-6.0918 6.091797,-6.08984 7.097656,3.8457 7.097658,3.84766 8.11523,-8 c 4.46283,-4.4 8.550813,-8.79385 9.085943,-9.76367 0.697788,-1.26462 0.2128,-4.29762 -1.71484,-10.72071 l -2.687503,-8.95508 3.896483,-4.7207 c 2.142528,-2.59612 4.368238,-5.35062 4.947268,-6.12109 0.87255,-1.16105 2.51981,-0.67327 9.61523,2.8457 l 8.56055,4.24609 0.62891,8.09375 c 0.34664,4.45209 0.64064,10.34571 0.65234,13.09571 0.0117,2.75 0.35178,5.48762 0.75586,6.08398 0.76328,1.12648 17.01051,12.18876 21.02344,14.31445 l -1.82886,18.44434 -24.35193,7.28466 -5.22693,21.03467 16.89808,13.28467 -1.47693,25.90967 17.27307,10.90967 0.0231,19.78466 17.14807,5.28467 10.27307,13.53467 -4.72693,12.40967 -18.0804,1.80682 -13.17192,-18.07911 -18.76551,-1.97726 -13.89159,22.63131 z m 129.416011,-185.49785 -10.53711,-1.77148 -11.78711,-2.27149 -4.81445,-9.78516 -4.81445,-9.78515 -10.06055,-3.54688 c -5.53307,-1.95 -11.83493,-4.14134 -14.0039,-4.87109 -4.41149,-1.48418 -4.7952,-2.26454 -6.56055,-13.31445 l -1.14258,-7.14844 -8.22656,-5.47461 c -6.7228,-4.47393 -9.52909,-5.70681 -15.35547,-6.74805 -3.92139,-0.70079 -7.62962,-1.58353 -8.24024,-1.96094 -0.76169,-0.47073 -0.12318,-3.20864 2.03516,-8.72265 l 3.14648,-8.03711 -4.85546,-9.37891 -3.01082,-7.44465 15.4204,-9.19571 28.52396,4.2222 11.84718,-14.8207 c 5.25269,-2.49878 10.18405,-4.78783 15.59718,-6.9028 l 6.90921,3.34088 c 4.04612,2.23351 7.35546,4.19268 7.35546,4.35352 0,1.23128 5.0883,24.90194 5.49415,25.55859 0.28568,0.46226 4.00484,1.16104 8.26367,1.55469 10.05303,0.9292 11.06482,1.36473 19.7539,8.46484 l 8.04652,6.60564 3.127,15.40061 -17.74801,14.02561 5.004,21.92623 -14.24601,10.55123 z m 327.97307,529.97704 c -0.45912,0.45716 -0.70677,4.08655 -1.91839,8.96243 l -2.20312,8.86524 -8.6504,2.82226 -8.64843,2.82422 -1.5625,6.92578 c -0.85989,3.80958 -2.25654,9.99718 -3.10352,13.75 l -1.54101,6.82422 -8.48828,1.13477 c -4.66925,0.62474 -8.93946,1.41471 -9.48829,1.7539 -0.54883,0.3392 -2.89825,4.34049 -5.2207,8.89258 l -4.22266,8.27734 -11.42382,-3.1582 -11.42579,-3.15625 -3.3789,-7.94531 c -1.85877,-4.3701 -3.9186,-8.45429 -4.57617,-9.07617 -1.1186,-1.05789 -17.77823,-6.78119 -22.19532,-7.625 -1.1,-0.21014 -5.51673,1.35528 -9.8164,3.48047 l -7.81836,3.86523 -6.18164,-5.73437 c -3.40033,-3.15425 -6.04475,-6.2138 -5.87695,-6.79688 0.16777,-0.58308 1.87554,-5.29141 3.79687,-10.46289 1.92133,-5.17147 3.30468,-9.89827 3.07227,-10.50391 -0.2324,-0.60565 -2.56088,-3.72397 -5.17383,-6.93164 l -4.75196,-5.83203 4.24024,-8.92383 c 1.83606,-3.86356 2.85148,-6.15256 3.26272,-7.66418 l 15.98914,-8.88249 15.54091,9.31572 15.2909,-14.3396 13.63559,15.52911 18.62929,-9.20832 20.59897,17.10862 20.81863,-6.0441 z m 284.00223,-126.61137 3.02079,6.84529 c 2.14964,4.87121 4.12224,9.41132 4.76367,9.92968 1.57583,1.27349 20.19479,7.16407 22.64453,7.16407 1.2509,0 3.47423,-2.15813 6.18359,-6 2.32723,-3.3 4.67657,-6 5.22071,-6 0.54415,0 4.3946,0.95003 8.55468,2.11133 7.05417,1.96906 8.21737,2.71205 17.27344,11.05078 l 9.71094,8.9414 10.93555,-2.61523 10.9375,-2.61328 8.45507,4.3125 8.45704,4.3125 -2.02539,8.79883 -2.02344,8.80078 6.27344,6.33007 6.27539,6.33204 -5.35352,7.17578 c -4.92642,6.60306 -5.59273,7.13309 -8.35352,6.66601 -1.65,-0.27915 -7.14628,-0.81036 -12.21484,-1.18164 l -9.21484,-0.67578 -8.78321,7.62695 -8.78125,7.62696 h -10.67773 -10.67774 l -7.24804,10 c -3.9861,5.5 -7.9199,10 -8.74219,10 -0.82229,0 -4.32765,-0.49488 -7.78906,-1.09766 l -6.29297,-1.0957 -7.99024,5.63086 -7.99023,5.63086 -5.39648,-4.88868 -5.39844,-4.88671 -10.79688,-0.72461 -10.79687,-0.72266 -6.125,6.07031 -6.125,6.07032 -8.47852,-1.49219 c -4.66374,-0.82074 -8.61223,-1.49339 -8.77343,-1.49414 -0.1612,-7.5e-4 -2.35792,-4.27353 -4.88282,-9.4961 l -4.59179,-9.49609 3.53515,-9.25391 3.53321,-9.2539 -1.34766,-7.5 c -2.11788,-11.78511 -2.7054,-10.88057 7.27734,-11.21094 l 8.73242,-0.28906 4.37696,-7.76367 4.37695,-7.76368 -7.68164,-13.50781 -7.67969,-13.50781 3.19141,-5.22852 c 1.75567,-2.87567 3.25418,-5.30849 3.33008,-5.40429 0.0759,-0.0959 4.76037,1.12346 10.4082,2.70898 5.64783,1.58551 10.82123,2.66988 11.49805,2.41016 l 11.23331,-17.87055 z m -712.04952,-506.18598 -9.5,-0.61328 c -17.420881,-2.26834 -16.007891,-1.37002 -19.128901,-12.15625 -1.49497,-5.16661 -3.23459,-9.71376 -3.86524,-10.10352 -0.63065,-0.38976 -4.736736,-1.52705 -9.124998,-2.5293 -4.388262,-1.00226 -8.126937,-2.08205 -8.308594,-2.39843 -0.181657,-0.31638 -0.642359,-4.41713 -1.023438,-9.11329 l -0.693359,-8.53906 8.572266,-6.89453 c 4.714682,-3.79176 8.582703,-7.14886 8.595703,-7.46094 0.013,-0.31208 -0.966324,-5.5213 -2.177734,-11.57617 l -2.203126,-11.00781 5.67969,-8.48242 c 3.1234,-4.66524 5.67773,-9.02442 5.67773,-9.68555 0,-0.66113 -2.7,-5.37759 -6,-10.48047 -3.3,-5.10288 -5.999998,-9.64834 -5.999998,-10.10156 0,-0.45322 2.220712,-4.11249 4.933598,-8.13281 4.72378,-7.00033 5.13081,-7.3372 9.63867,-7.95508 2.58889,-0.35484 6.55763,-0.65025 8.818363,-0.65625 10.135578,-0.0271 11.385588,-0.67802 18.359368,-9.55078 l 6.49219,-8.25782 6.62891,2.52149 c 3.64629,1.38735 7.78177,2.93606 9.18945,3.4414 2.18483,0.78434 3.02595,2.44673 5.75391,11.35938 l 3.19531,10.44141 7.74609,3.51367 c 4.25972,1.93294 8.40984,3.90087 9.22266,4.37304 0.81282,0.47222 2.70225,5.08455 4.19922,10.24805 1.49697,5.1635 3.08322,9.52427 3.52344,9.69141 0.44021,0.16713 4.8207,1.97548 9.73632,4.01758 l 8.9375,3.71289 3.26368,10.4707 3.26171,10.4707 -2.33593,3.0293 c -1.28491,1.66565 -3.95933,5.0543 -5.94141,7.5293 -3.5736,4.46229 -3.60328,4.57873 -3.61328,14.10156 -0.005,5.28182 0.28089,12.37404 0.63477,15.75977 l -0.47875,4.30637 -15.41645,6.98932 -11.81177,14.15184 -27.60468,-4.03751 z m 314.03711,6.27343 c -3.86973,-2.52028 -7.15401,-4.63948 -7.29687,-4.70898 -0.14286,-0.0695 -1.36561,-5.8815 -2.7168,-12.91602 -1.35119,-7.03452 -2.58428,-12.90998 -2.74023,-13.05664 -0.15594,-0.14665 -3.65821,-0.56455 -7.78321,-0.92773 l -7.5,-0.66016 -6.80078,10.97071 -6.79883,10.9707 1.57032,6.33984 c 0.86405,3.48658 1.74871,7.14408 1.96679,8.12891 0.29554,1.33466 -2.06188,3.43533 -9.26171,8.25 -5.31265,3.55267 -10.36046,6.46094 -11.2168,6.46094 -0.85635,0 -5.80996,-1.34212 -11.00781,-2.98243 -5.19785,-1.64028 -10.15887,-2.99 -11.02344,-3 -0.86456,-0.01 -4.88616,2.26572 -8.9375,5.05665 l -7.36524,5.07421 -8.33789,-3.71484 c -4.58582,-2.04336 -8.54095,-3.51372 -8.78906,-3.26562 -0.2481,0.24811 -2.25874,4.36129 -4.4668,9.14062 -2.20806,4.77934 -4.21606,8.69141 -4.46289,8.69141 -1.50475,0 -15.26163,-5.40392 -16.16992,-6.35157 -0.6061,-0.63236 -2.14719,-6.2529 -3.42383,-12.49023 -2.66238,-13.00766 -2.19511,-12.5611 -14.02343,-13.42383 l -7.55079,-0.55078 -9.38476,7.9082 c -5.16179,4.34926 -9.63836,7.90344 -9.94922,7.89844 -0.31085,-0.005 -4.36225,-3.00377 -9.00195,-6.66406 l -8.43555,-6.6543 -8.87695,-1.01953 c -10.06422,-1.15512 -9.88991,-0.99522 -11.64844,-10.65234 -3.27798,-18.00131 -2.29609,-16.08632 -10.49414,-20.47657 l -7.34375,-3.93164 -0.62695,-8.04687 c -0.34457,-4.42533 -0.62982,-10.86473 -0.63282,-14.31055 -0.006,-6.15512 0.0995,-6.39863 5.99414,-13.8164 3.3,-4.1527 6,-8.12022 6,-8.81836 0,-0.69813 -1.5593,-6.18222 -3.46484,-12.18555 l -3.46289,-10.91406 -9.24609,-3.60938 c -5.08463,-1.98462 -9.58244,-4.23462 -9.9961,-5 -0.41366,-0.76537 -1.88233,-5.24134 -3.26367,-9.94726 l -2.51172,-8.55664 -8.96094,-3.94336 -8.95898,-3.94336 -3.33594,-10.4375 -3.33594,-10.43555 -9.4082,-3.81445 c -5.17524,-2.09739 -9.99521,-3.81251 -10.71094,-3.81251 -0.71572,0 -4.33939,3.79557 -8.05273,8.43555 l -6.75196,8.43555 -7.51953,0.59375 c -4.135478,0.32589 -9.840611,0.83064 -12.677731,1.12305 l -5.1582,0.5332 -5.705082,8.99805 -5.703125,8.99804 6.626953,9.95704 6.628904,9.95507 -6.00781,8.9375 -6.009766,8.93555 2.396485,11.08008 2.396484,11.08008 -8.535156,7.1289 -8.535157,7.12696 0.69336,10.07031 c 0.381422,5.53798 1.148297,10.34855 1.703125,10.6914 0.554828,0.3429 4.58473,1.44129 8.955078,2.43946 4.370349,0.99816 8.322147,2.30101 8.783207,2.89648 0.46106,0.59547 1.91697,5.07288 3.23437,9.94922 1.7923,6.63416 2.898,9.05252 4.39453,9.60742 1.1,0.40787 7.09038,1.31991 13.310551,2.02539 l 11.30859,1.28125 3.94141,7.83789 c 2.16733,4.3106 3.93945,8.4929 3.93945,9.29493 0,0.80204 -1.42057,5.09204 -3.1582,9.53125 -1.73762,4.4392 -2.91744,8.31304 -2.62109,8.60937 0.29632,0.29633 4.53135,1.25998 9.41015,2.14063 7.89151,1.42445 9.71603,2.19325 16.54297,6.96875 8.19779,5.73441 7.92703,5.25475 9.77344,17.21875 0.54175,3.51033 1.14771,6.54613 1.34766,6.74609 0.19996,0.19995 6.62878,2.5386 14.28515,5.19531 l 13.91992,4.83008 5,9.89844 5,9.89844 10.54297,2.08789 c 5.79808,1.14834 11.25418,2.36114 12.125,2.69531 0.87083,0.33416 4.14724,4.24618 7.28125,8.69336 l 5.69922,8.08594 -5.20898,8.49414 -5.20899,8.49414 h -11.28515 -11.28711 l -9.52539,-5.5 c -5.2394,-3.025 -10.58673,-5.5 -11.88282,-5.5 -1.29609,0 -7.03387,1.575 -12.75,3.5 -5.71613,1.925 -11.09088,3.46798 -11.94531,3.42968 -0.85444,-0.0383 -6.05469,-3.07584 -11.55469,-6.75 l -10,-6.67968 -0.62304,-11 c -0.34253,-6.05 -0.68,-12.23326 -0.75,-13.74024 -0.1119,-2.40995 -1.20706,-3.28318 -9.10547,-7.25 -4.93901,-2.48052 -9.64406,-4.50976 -10.45508,-4.50976 -2.00978,0 -11.568601,11.97739 -11.558601,14.48437 0.004,1.10796 0.90059,4.94063 1.99219,8.51563 1.0916,3.575 1.988193,7.29123 1.992193,8.25976 0.004,0.96853 -3.431713,5.36234 -7.636723,9.76367 l -7.64648,8.00196 -5.603519,-3.16211 c -3.0825,-1.73913 -6.434424,-3.65794 -7.447265,-4.26367 -1.58475,-0.94776 -2.7329,-0.12352 -8.240235,5.90039 -7.354323,8.04414 -7.297218,7.7528 -4.587891,23.57617 l 1.810547,10.57617 -4.015625,9.23438 -4.017578,9.23437 6.777344,12.94727 6.775391,12.94531 -2.748047,8.24219 -2.748047,8.24414 2.3125,11.5 c 1.272052,6.325 2.637707,12.12318 3.035156,12.88672 0.397449,0.76355 4.26326,3.23855 8.589844,5.5 l 7.867187,4.11328 1.708988,13.30468 1.70898,13.30469 -5.943358,8.3086 c -3.268868,4.56998 -5.94336,8.6422 -5.94336,9.04882 0,0.40661 2.848016,4.18112 6.328125,8.38672 3.480113,4.2056 6.683593,8.25292 7.119143,8.99414 0.5358,0.91182 -0.92023,3.08025 -4.50195,6.70703 l -5.292974,5.36133 2.763672,7.04492 c 3.104122,7.91186 1.934834,7.27919 17.083982,9.24219 l 10.000001,1.29688 0.5,8.39453 c 0.36015,6.04776 0.91956,8.62424 2,9.20898 0.825,0.44651 5.9199,1.77434 11.32032,2.95117 10.80994,2.35564 10.43434,2.00475 12.10351,11.35352 l 1.08789,6.0918 9.99414,0.77929 c 5.49695,0.42901 10.44818,0.93508 11.00196,1.125 0.61925,0.21237 0.80965,3.30536 0.49609,8.03516 -0.28041,4.22973 -0.16926,8.29883 0.24609,9.04102 0.41535,0.74219 3.66675,2.97712 7.22461,4.96679 l 6.46875,3.61719 -0.58398,9.2168 -0.58203,9.21875 8.0957,5 c 4.45326,2.75 8.10528,5.3199 8.11328,5.71289 0.008,0.39299 -2.06627,4.1432 -4.60937,8.33203 l -4.62305,7.61523 0.45703,7.92188 c 0.25219,4.35643 0.70255,8.16349 1,8.46094 0.29745,0.29745 3.98892,1.47749 8.20313,2.62304 l 7.66211,2.08399 8.45312,-5.625 c 4.64885,-3.09375 8.80563,-5.625 9.23828,-5.625 0.43264,0 3.83726,3.07408 7.56641,6.83203 l 6.78125,6.83203 -4.1582,9.07812 -4.16016,9.07813 2.47266,8.33984 2.47265,8.33985 10.48242,-0.32032 10.48047,-0.32031 5.64844,6.24024 c 3.10603,3.43227 5.64648,6.63412 5.64648,7.11523 0,0.48111 -2.25,4.20984 -5,8.28516 -2.75,4.07532 -5,8.1339 -5,9.01953 0,1.72234 13.35131,8.48047 16.75391,8.48047 1.03622,0 4.10212,-0.63447 6.81445,-1.41016 2.71232,-0.77569 7.43188,-2.11439 10.48633,-2.97266 5.16825,-1.45222 5.88217,-2.04038 10.28516,-8.48437 5.25091,-7.68499 4.90397,-7.52736 19.29492,-8.70508 4.47341,-0.36609 8.57279,-0.9498 9.11133,-1.29687 0.53855,-0.34707 2.99226,-3.78086 5.45312,-7.63086 l 4.47461,-7 12.02149,-2.17383 12.01953,-2.17578 7.39258,7.19922 7.39257,7.19922 10.5,2.4746 10.5,2.47657 1.16407,9 c 0.64022,4.95 1.2027,9.81616 1.25,10.81445 0.0592,1.24926 2.40409,2.88457 7.51953,5.24805 4.11011,1.89898 7.66496,4.21723 7.95312,5.18554 0.28659,0.96305 1.22153,4.83708 2.07813,8.60938 l 1.55664,6.85937 -5.00977,8.16993 c -2.75595,4.49404 -5.01172,8.53434 -5.01172,8.97656 0,0.44223 2.73864,4.11347 6.08594,8.16015 6.7933,8.21268 6.7525,7.79321 1.78516,17.69727 -1.57828,3.14682 -2.8711,6.49354 -2.8711,7.4375 0,0.94395 2.025,4.29308 4.5,7.44336 2.475,3.15028 4.5,6.29278 4.5,6.98242 0,0.68964 -1.65667,5.76467 -3.68164,11.27735 l -3.68164,10.02343 6.93164,6.29883 c 3.81247,3.46435 7.50584,6.30164 8.20703,6.30664 0.70124,0.005 4.40207,-1.56523 8.22461,-3.49023 3.82254,-1.925 7.75034,-3.48329 8.72657,-3.46289 0.97623,0.0204 6.22408,1.48874 11.66406,3.26367 l 9.89258,3.22656 3.70117,8.43555 c 2.03641,4.63961 4.33576,8.81858 5.10937,9.28515 0.77361,0.46657 6.13125,2.1415 11.90625,3.72266 5.775,1.58116 11.05826,2.90887 11.74024,2.95117 0.68198,0.0423 3.24708,-3.91827 5.69922,-8.80078 l 4.45703,-8.87695 9.30273,-1.37305 9.30078,-1.37109 2.76758,-11.5 c 1.52216,-6.325 2.99952,-12.53192 3.28125,-13.79297 0.28172,-1.26105 1.06131,-2.47822 1.73242,-2.70313 0.67111,-0.22491 4.47495,-1.37094 8.45508,-2.54883 7.11113,-2.10445 7.25547,-2.2142 8.3125,-6.29882 0.59162,-2.28617 1.76843,-6.69679 2.61524,-9.80078 l 1.54101,-5.64258 7.87305,-1.22656 c 4.33069,-0.67369 8.25926,-1.73315 8.73047,-2.35547 0.47121,-0.62233 2.1254,-6.00538 3.67578,-11.96289 l 2.81836,-10.83204 9.46875,-4.66796 9.4668,-4.66797 -0.6543,-12.00391 -0.65234,-12.00391 5.03515,-4.95898 c 5.99236,-5.90226 6.04766,-6.5477 1.70313,-19.43945 l -3.16993,-9.40625 9.45118,-9.25586 9.44921,-9.25586 6.7754,3.41211 c 3.72627,1.87652 7.49587,3.41211 8.37695,3.41211 2.75662,0 20.44537,-11.11828 20.48047,-12.87305 0.0179,-0.89473 0.82746,-6.98734 1.79883,-13.53906 l 1.76562,-11.91406 8.95117,-1.8379 c 4.92321,-1.01146 9.45678,-2.02764 10.07422,-2.25781 0.61744,-0.23018 1.87852,-3.50402 2.80274,-7.27539 0.92422,-3.77136 2.08067,-7.50201 2.56836,-8.29101 0.67381,-1.09026 4.00091,-1.53251 13.86523,-1.83985 l 12.98047,-0.4043 7.62695,5.27344 c 4.19513,2.90009 7.44051,5.76418 7.21289,6.36524 -0.97506,2.57482 -3.93738,17.66807 -3.54492,18.06054 0.95304,0.95303 18.41059,4.37991 19.00391,3.73047 0.35078,-0.38396 1.55721,-4.66323 2.68164,-9.50976 1.87243,-8.07061 2.52036,-9.35343 7.69726,-15.25 3.1088,-3.54097 6.00853,-6.4375 6.44336,-6.4375 0.43483,0 4.29197,1.59432 8.57227,3.54492 4.28031,1.9506 8.27414,3.34633 8.875,3.09961 0.60086,-0.24671 4.33773,-3.20117 8.30468,-6.56446 6.72196,-5.69893 7.35138,-6.57983 9.25,-12.95507 1.1205,-3.7624 2.03711,-7.33134 2.03711,-7.92969 0,-0.59835 -3.825,-4.07411 -8.5,-7.72461 -4.675,-3.65051 -8.5,-6.99755 -8.5,-7.4375 0,-0.43996 1.7762,-4.78913 3.94532,-9.66602 l 3.94336,-8.86718 10.16601,-1.20899 10.16797,-1.20898 7.375,7.70898 7.37695,7.70899 -0.58203,9 -0.58008,9 4.09375,7.38867 c 2.25151,4.06367 4.09375,7.85785 4.09375,8.43164 0,0.57378 -3.10871,3.99878 -6.9082,7.61133 -6.81691,6.4815 -6.92478,6.66549 -8.18164,13.93945 l -1.27344,7.37109 6.18164,5.97461 c 3.40001,3.28619 6.18164,6.4031 6.18164,6.92578 0,2.1537 -9.28785,16.85743 -10.64843,16.85743 -0.81087,0 -5.51974,-1.16522 -10.46485,-2.58789 -4.94511,-1.42267 -9.59075,-2.35689 -10.32226,-2.07618 -1.5349,0.589 -7.56446,10.74858 -7.56446,12.7461 0,0.73704 3.15,6.86743 7,13.62304 3.85,6.75565 7,12.71998 7,13.25391 0,0.53393 -1.61632,3.91192 -3.59179,7.50586 l -3.5918,6.53516 h -8.9082 c -10.30977,0 -9.68788,-1.00007 -7.74219,12.45703 1.14942,7.94978 1.12179,8.17448 -2,16.26953 -1.74103,4.51467 -3.16602,9.09171 -3.16602,10.17187 0,3.08014 8.86506,20.50783 10.78125,21.19532 0.94601,0.33941 5.46977,1.19274 10.05469,1.89648 l 8.33789,1.28125 6.16211,-6.10742 6.16406,-6.10547 9,0.58398 c 9.47545,0.61537 13.18054,2.19443 18.54102,7.9004 1.26924,1.35104 3.03178,2.45703 3.91602,2.45703 0.88425,0 5.03776,-2.47442 9.23046,-5.49805 l 7.62305,-5.49805 7.6875,1.58985 c 7.33035,1.51595 7.74659,1.5048 8.99219,-0.25196 0.71834,-1.01313 4.1643,-5.66679 7.6582,-10.34179 l 6.35156,-8.5 10.87305,-0.28516 10.87305,-0.28516 8.50195,-7.56445 8.50195,-7.56445 5.125,0.57617 c 2.81872,0.31745 8.328,0.86563 12.24219,1.2168 l 7.11719,0.63672 6.13281,-8.05664 c 3.37331,-4.43138 6.13281,-8.4661 6.13281,-8.9668 0,-0.50071 -2.89258,-3.64673 -6.42773,-6.99024 l -6.42578,-6.07812 1.48828,-6.32031 c 3.02869,-12.85863 3.744,-11.10768 -6.68164,-16.34375 -6.15766,-3.09257 -10.14074,-4.51275 -11.86133,-4.22852 -1.42482,0.23538 -6.53649,1.27157 -11.35937,2.30078 l -8.76954,1.8711 -8.36914,-8.32618 c -8.33165,-8.28837 -8.41119,-8.33732 -18.19336,-11.17187 -11.26715,-3.26487 -10.98917,-3.35109 -16.49218,5.22461 -1.71362,2.67044 -3.33786,4.85547 -3.60938,4.85547 -0.27152,0 -5.23185,-1.44482 -11.02148,-3.20899 -11.70291,-3.566 -11.02074,-2.85774 -15.86328,-16.49218 l -1.72461,-4.85157 5.72461,-8.60156 5.72265,-8.59961 8.09571,1.80469 8.09375,1.80469 8.83593,-4.4375 c 8.22511,-4.13089 9.15801,-4.92234 13.51368,-11.47266 l 4.67773,-7.03516 -6.17578,-6.23242 c -3.39713,-3.42759 -6.17774,-6.59923 -6.17774,-7.04883 0,-0.4496 2.7,-4.5577 6,-9.1289 3.3,-4.5712 6,-8.73794 6,-9.25782 0,-0.51987 -2.30323,-3.9873 -5.11914,-7.70703 l -5.11914,-6.76367 3.99024,-10.13672 3.99219,-10.13476 8.3789,-3.13282 8.37695,-3.13085 4.84571,-8.36915 c 2.66475,-4.60258 5.25169,-8.61976 5.75,-8.92773 0.49831,-0.30798 6.68364,-1.60053 13.74414,-2.87305 l 12.83789,-2.31445 4.05273,-8.17383 4.05469,-8.17383 7.10742,-2.86132 c 4.68456,-1.88586 7.09697,-3.40451 7.07617,-4.45313 -0.0174,-0.87506 -1.70489,-4.73984 -3.75,-8.58984 l -3.71875,-7 -9,-0.28907 -9,-0.2871 -5.75,-5.68165 c -3.1625,-3.12443 -5.75,-6.41106 -5.75,-7.30468 0,-0.89361 0.9,-4.24029 2,-7.4375 2.80078,-8.1406 2.58917,-9.78131 -1.75,-13.5586 -2.0625,-1.79543 -5.85361,-5.14265 -8.42578,-7.43945 l -4.67773,-4.17578 1.92383,-9.91406 c 1.05784,-5.45224 1.92573,-11.20929 1.92773,-12.79297 0.004,-3.3576 -9.56764,-16.62378 -11.98633,-16.61328 -0.83066,0.004 -6.41551,1.37509 -12.41015,3.04882 -5.99463,1.67373 -11.45362,2.83216 -12.13086,2.57227 -1.34855,-0.51749 -11.47071,-17.69226 -11.47071,-19.46289 0,-0.60339 3.15,-5.5657 7,-11.02734 3.85,-5.46165 7,-10.45118 7,-11.08789 0,-0.63676 -2.13365,-5.13179 -4.74218,-9.99024 l -4.74219,-8.83398 3.24219,-7.93164 c 1.78353,-4.36218 3.24218,-8.43509 3.24218,-9.05079 0,-0.61571 -3.9375,-2.34151 -8.75,-3.83593 -9.13929,-2.83803 -8.36806,-2.26434 -20.0039,-14.88477 -0.55202,-0.59872 1.06504,-4.34834 4.16992,-9.67187 l 5.07031,-8.69532 9.00782,-2.33984 c 10.27976,-2.66979 9.75024,-1.55115 6.89062,-14.58789 -1.58707,-7.23528 -1.63067,-7.30172 -8.91016,-13.25 -4.02389,-3.28804 -7.91379,-5.96748 -8.64453,-5.95508 -0.73073,0.0124 -3.69547,3.61239 -6.58593,8 -5.19557,7.88647 -5.30398,7.97733 -9.5,7.98633 -2.33449,0.005 -6.49415,0.24605 -9.24415,0.53711 l -5,0.5293 -3.83789,-4.94336 c -2.11074,-2.71891 -4.6933,-5.86258 -5.74023,-6.98633 -1.29746,-1.39266 -2.3633,-5.10371 -3.34766,-11.65625 l -1.44531,-9.61328 3.92774,-6.1875 c 2.15922,-3.4034 3.91651,-7.0875 3.90624,-8.1875 -0.0122,-1.325 -2.54002,-3.83954 -7.49023,-7.44727 -4.10961,-2.99509 -7.47266,-5.81731 -7.47266,-6.27344 0,-0.45614 1.59861,-4.00438 3.55274,-7.88476 l 3.55469,-7.05664 -4.80469,-5.50782 c -2.64163,-3.03014 -5.20798,-6.07629 -5.70313,-6.76953 -0.61517,-0.86126 0.88912,-3.68166 4.75,-8.90234 3.10765,-4.20217 5.65039,-8.26374 5.65039,-9.02539 0,-0.76165 -1.53295,-4.75027 -3.4082,-8.86524 -3.76276,-8.25681 -4.61418,-8.76757 -14.59375,-8.76757 -2.6139,0 -5.20092,-0.5625 -5.74805,-1.25 -0.54714,-0.6875 -2.39047,-5.78608 -4.09765,-11.33008 l -3.10352,-10.08008 2.16016,-11.04492 2.16016,-11.04297 -9.1836,-8.74023 -9.18555,-8.74024 v -7.67969 -7.68164 l 9,-4.41015 c 4.95,-2.42628 9,-4.93263 9,-5.56836 0,-0.63572 -1.84215,-5.08585 -4.09375,-9.89063 l -4.09375,-8.73633 5.23438,-10.15234 5.23242,-10.15234 -2.38867,-11.37305 -2.39063,-11.37305 -7.82617,-4.51172 -7.82622,-4.51174 -9.67383,2.96094 -9.67383,2.95898 -3,11.42579 -3,11.42383 -6.97851,0.30078 c -3.8385,0.1655 -7.99008,0.0462 -9.22461,-0.26367 -1.23453,-0.30984 -5.74255,-4.85156 -10.01953,-10.09375 -6.06063,-7.42837 -8.21861,-9.42861 -9.77735,-9.06641 -1.1,0.2556 -5.48318,1.01167 -9.74023,1.67969 -4.25706,0.66801 -8.13039,1.7152 -8.60742,2.32812 -0.47703,0.61292 -2.12329,5.26875 -3.66016,10.34571 -1.79203,5.91981 -3.36759,9.38603 -4.39258,9.66406 -0.87906,0.23845 -5.19961,1.17768 -9.59961,2.08789 -4.4,0.91021 -8.72953,1.86181 -9.62109,2.11328 -1.07771,0.30398 -2.54031,3.71508 -4.36523,10.17969 -1.50969,5.34795 -3.12015,10.43899 -3.57813,11.3125 -0.57116,1.08939 -3.97434,2.11012 -10.83789,3.25 -5.50342,0.91399 -10.88445,1.66211 -11.95703,1.66211 -1.39096,0 -3.3167,-2.36565 -6.71484,-8.25 -6.84419,-11.85167 -6.36224,-11.48218 -15.97852,-12.28321 -4.61679,-0.38457 -8.80261,-1.21034 -9.30078,-1.83398 -0.49816,-0.62365 -2.17788,-5.07031 -3.73242,-9.88281 -2.14872,-6.65191 -3.25223,-8.75 -4.60157,-8.75 -0.97623,0 -4.7278,1.45611 -8.33789,3.23633 l -6.56445,3.23632 -6.20508,-2.3457 c -10.11295,-3.82213 -9.4084,-3.97454 -18.58398,4.05273 l -8.36719,7.32032 0.54492,8.54883 0.54688,8.55078 -5.98047,9.19922 -5.98047,9.20117 h -9.73242 -9.73047 l -3.71094,6.86914 c -2.04074,3.77829 -3.71665,7.49079 -3.72265,8.25 -0.006,0.75922 2.04226,7.23086 4.55078,14.38086 l 4.56054,13 7.82618,3.41797 7.82617,3.41992 4.79297,9.0957 4.79492,9.0957 -3.02344,8.70704 -3.02148,8.70703 -10.26172,5.13671 -10.25977,5.13672 -6.36719,-6.3125 -6.36914,-6.31054 -6.83984,2.20312 c -3.76169,1.21154 -7.59846,2.20313 -8.52734,2.20313 -0.92888,0 -5.47686,-2.52194 -10.10743,-5.60352 -4.63057,-3.08158 -8.86158,-5.3303 -9.40234,-4.99609 -0.54077,0.3342 -2.8502,4.00858 -5.13086,8.16406 -1.64138,2.99067 -4.75954,7.14435 -7.59065,9.63197 -2.83111,2.48762 -5.37516,3.30918 -9.36831,5.63562 z\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"cccccscccsscccsccccccccccccccccccccccccsccccccccscccscccccsccccscccsssssscccsccccccccsccccsccccccccccscccscsccccssccccccscccccccccscccccscccccccccssccccccsccccscccsssccsscscssccccssscccccscccccccscsccccccccsssccsccccccsscsccccccscccscccsscccccscscccscccccscscccccccccccccccccccccccscccccccscccscscccccccccccccccccsccccsscccccccccccsscccscccccccccccsccccccccsssccccccsccccscsssccsscccccccccccccccccccscccccccccccccccsssscsccccsscccccccccssssssssccccccccccsccccccccccccccccccccccccccccccccsccccssccccccccsccccccccssssscccccccccccsccccssssscccccccccssccssccccscccscsssscccscccscccccccccccccccccccccccccccccsccscccscccccccsccccccccccsssscccsccccccccccccccscscccccccccccccscccccssccccssssccscccsssccccccsccsccsccccccccsscccccccccscccccccscccccccccccscccccccccsccccccccccccccccccccccscccccccccccsscccccccsscscccccsccsscccccsccccccccccccscsccccccccccscccccccscccccsccccsccccccccccccccccssscccscccccccccccccccccccccccccccssssccsccccscccccccccccccccccccccccccccccccccccccccccscccscccccccccccccccccccccccccccccccccccccccccccccscccsscccccccccssscccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccsccccccccccccccccccccccccccccccsccccccccccscccccccscccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccscccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccsssscccsccccccccccccscccccccccccccccccccccccsccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccsscccsscccccccccccccccccccccccccccccssssscccccccccccccccccccccccccccccccccccccccccccccccccsscccsccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccsscccccccccccccccscscccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccscccccccscssscccccccccccccccccccccccccccccccccccccscccccccccccccccccccccccccccsssscssssscccccccccccccccccccccccccccccscccccccccccscccccccsccccccccccccccccccccccccccccccccccccccsccccccccccccccccccccccccccccccsccccccccccccccscscsccccccccccccccccccccscccccccccccscccccccccccccccccccsscscccccccssssccccccccccscccccccccccccccsscccccccsscsccccsscscccscccsccccsscccccccccccsccccccccccccccccccssscccscccssccccccccccccccsssccccsscsccccsscccccccccccccccssccccccccsccccsscccccscccscccscccccccccsssssccsccccccccccccccccsscssscccssscccsssccccccscsccccccccccccccccscccccsccccccccssscccscsccccccccssscccssscscsccsscssccccccsccccccccccccccsccccccccccscccccccccccssscccccccccccccccccccssscccccscssscccscccccssscccccccccccsccccsscsccccccccccsccccccccccccccscccccscscssscccsccccccccccccscccccccccccccsscsscc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile17;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 249.8771,764.43497 -5.2738,-2.78458 4.92025,-7.69159 c 2.70614,-4.23037 4.91614,-8.51765 4.91112,-9.52729 -0.005,-1.00963 -2.60012,-4.85858 -5.76689,-8.55321 l -5.75776,-6.71752 h -10.93631 -10.93632 l -1.77637,-6.93101 c -1.65929,-6.47421 -1.54142,-7.39464 1.78836,-13.96566 4.02702,-7.94693 5.468,-8.80181 14.83621,-8.80181 h 6.66631 l 4.11373,-11.31371 4.11372,-11.31371 h 8.20939 8.20939 l 4.53297,9.19239 c 2.49314,5.05581 5.19188,9.1641 5.99721,9.12953 0.80532,-0.0346 4.89457,-2.77054 9.08721,-6.07993 l 7.62299,-6.01706 8.20101,4.30088 c 7.54732,3.95806 8.36301,4.86541 10.2333,11.38322 1.98954,6.93338 1.94673,7.2347 -2.03542,14.32557 -2.23723,3.98376 -4.0677,7.8949 -4.0677,8.6914 0,0.79651 3.20291,3.5035 7.11757,6.01553 7.89669,5.06729 7.84236,3.45042 0.49796,14.8194 -1.66398,2.57581 -3.52986,3.53554 -6.87369,3.53554 -2.52434,0 -7.62092,0.41547 -11.32572,0.92327 -6.02835,0.82628 -7.34271,1.70738 -12.51092,8.38688 -5.21044,6.73407 -6.63866,7.68057 -14.61166,9.68333 -11.10454,2.78936 -12.70575,2.73179 -19.18614,-0.68986 z\"\r\n" +
+	"     id=\"path4710\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"ccscccccscscccccccccssssssssscc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile18;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 379.28937,744.01359 c -0.77782,-0.28561 -4.0801,-1.20404 -7.33841,-2.04097 -3.5168,-0.90332 -9.46608,-4.48121 -14.64018,-8.80459 l -8.71599,-7.2829 -12.22988,2.04984 -12.22987,2.04984 -6.68991,-4.12226 -6.68992,-4.12227 3.77823,-6.7213 c 3.65828,-6.5079 4.13155,-6.81586 14.90711,-9.69991 l 11.12888,-2.97862 8.58681,5.83119 8.5868,5.83118 10.77316,-3.73173 c 13.00442,-4.50462 13.1002,-4.50719 19.26078,-0.51671 l 4.9124,3.18198 0.0175,11.38984 c 0.017,11.03691 -0.12699,11.5299 -4.64601,15.9099 -4.50137,4.3629 -5.73285,4.89324 -8.77151,3.77749 z\"\r\n" +
+	"     id=\"path4808\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"ccccccccsscccssccscc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile20;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 406.52543,739.41199 -9.53348,-5.26243 -0.0125,-11.65639 -0.0125,-11.65639 -6.8711,-4.54677 -6.87111,-4.54677 3.31435,-10.6669 c 1.82289,-5.86679 3.32389,-11.50731 3.33557,-12.5345 0.0117,-1.02718 -2.60867,-4.98648 -5.82297,-8.79846 l -5.84419,-6.93086 1.77305,-13.12185 1.77305,-13.12184 10.72668,-6.36396 c 8.79976,-5.22075 11.55298,-7.76123 15.32644,-14.14214 2.52987,-4.278 4.80671,-8.32017 5.05964,-8.9826 0.25294,-0.66243 6.40075,0.0834 13.66181,1.65733 l 13.20194,2.86176 11.02323,-4.20938 11.02324,-4.20938 11.08225,5.66166 c 8.94491,4.56975 11.97697,7.00225 15.72145,12.61271 l 4.6392,6.95105 -3.79544,8.75157 -3.79545,8.75156 10.06697,9.18794 10.06698,9.18793 -3.83599,7.82143 -3.83599,7.82143 3.79517,6.79746 3.79516,6.79746 -7.38857,7.93908 c -7.30602,7.85039 -7.47865,7.93908 -15.45165,7.93908 h -8.06309 l -5.04352,7.5961 -5.04353,7.5961 -12.53255,-0.71457 -12.53255,-0.71458 -4.21125,8.34244 c -3.76307,7.45461 -4.94184,8.60432 -11.07619,10.80314 -3.77572,1.35338 -7.18315,2.43916 -7.57205,2.41284 -0.38891,-0.0263 -4.99718,-2.41596 -10.2406,-5.3103 z\"\r\n" +
+	"     id=\"path4810\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"ccccccscccccsscccccsscccccccccsscccccssccc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile16;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 217.8204,688.0291 -8.7339,-8.79572 -9.28693,6.05068 c -7.96341,5.18837 -9.98733,5.91927 -14.2016,5.12867 -10.43415,-1.95746 -12.15876,-10.22881 -4.81061,-23.07202 3.63324,-6.35024 5.65796,-8.26332 11.16981,-10.55395 l 6.73217,-2.79776 0.50283,-11.07249 c 0.27656,-6.08987 0.90282,-11.47247 1.3917,-11.96135 0.48887,-0.48887 3.59921,-1.01679 6.91187,-1.17313 l 6.023,-0.28427 3.68935,10.86117 c 2.02915,5.97364 4.09086,11.25418 4.58158,11.73452 0.49072,0.48035 4.85504,1.32039 9.69848,1.86676 l 8.80625,0.99339 3.62514,9.0699 3.62514,9.06991 -3.23144,9.4917 c -4.25203,12.4895 -4.0508,12.25431 -11.39498,13.31799 -6.33253,0.91716 -6.40709,0.87828 -15.09786,-7.874 z\"\r\n" +
+	"     id=\"path4812\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"scsssccssccccccccsss\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile15;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 176.54469,650.11001 -7.58321,-5.0559 0.75403,-9.19549 0.75403,-9.19548 -6.9594,-3.88558 -6.95941,-3.88558 v -8.18621 c 0,-7.99381 0.15241,-8.31362 6.48439,-13.6065 l 6.48438,-5.42027 7.76995,1.56043 c 7.7688,1.56019 7.77051,1.55976 11.54684,-2.95924 2.07729,-2.48582 5.06838,-6.03476 6.64688,-7.88654 l 2.86999,-3.36687 6.58516,3.19932 c 6.47119,3.14395 6.63873,3.39713 9.68033,14.62889 2.86416,10.57654 2.94576,12.00634 1.09332,19.1577 -2.1692,8.37423 -3.82355,9.52645 -14.08318,9.80857 -4.56779,0.12561 -5.22286,0.62263 -6.01041,4.56038 -0.48614,2.43068 -0.88388,8.15825 -0.88388,12.72792 0,4.56968 -0.24048,8.30851 -0.5344,8.30851 -0.29392,0 -2.6804,0.84326 -5.3033,1.87392 -4.53596,1.78238 -5.13932,1.62695 -12.35211,-3.18198 z\"\r\n" +
+	"     id=\"path4814\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"scccccssccsccsssccsscs\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile14;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 144.53351,599.0982 -9.8959,-0.71314 -1.48166,-7.07107 c -1.41666,-6.7609 -1.758,-7.15286 -7.78177,-8.93576 -16.5426,-4.89623 -16.15829,-4.61003 -16.61636,-12.37423 -0.37014,-6.27395 0.10432,-7.65344 4.24265,-12.33542 2.90265,-3.28398 7.85862,-6.54923 13.14467,-8.6604 l 8.48528,-3.38889 1.05161,-10.38667 c 0.57838,-5.71266 1.30763,-11.06158 1.62055,-11.88647 0.31293,-0.8249 3.22778,-2.29642 6.47746,-3.27004 5.73746,-1.71899 6.14678,-1.61596 14.13914,3.55899 l 8.23064,5.32923 1.76435,10.31451 1.76436,10.31451 5.45691,1.41488 c 3.00131,0.77819 7.85987,2.03741 10.7968,2.79826 5.28444,1.36901 5.36207,1.49237 7.47846,11.88308 2.35414,11.55797 2.87306,9.84756 -6.29221,20.73985 l -3.68589,4.38045 -7.95189,-1.65081 -7.95189,-1.65081 -6.54971,6.15154 -6.5497,6.15154 z\"\r\n" +
+	"     id=\"path4816\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"cccsccscsccscccccsccccccc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile13;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 90.789799,558.20694 c -7.31759,-1.2072 -8.61553,-2.09435 -10.35201,-7.07562 -1.281292,-3.6755 -0.91089,-4.74139 3.39628,-9.77335 2.65892,-3.10636 4.83441,-6.28392 4.83441,-7.06124 0,-0.77733 -2.86378,-4.92892 -6.36396,-9.22575 -3.500179,-4.29684 -6.363961,-8.31951 -6.363961,-8.93927 0,-0.61976 1.962932,-4.00376 4.362071,-7.52001 l 4.36207,-6.39316 11.54783,0.4194 11.547831,0.4194 6.89727,-11.49749 c 5.85513,-9.7603 7.35088,-11.37575 9.8995,-10.69176 1.65122,0.44315 4.91141,0.83297 7.24486,0.86626 3.51778,0.0502 5.26953,1.47548 10.25305,8.34229 5.33263,7.34784 6.01041,9.15582 6.01041,16.03284 v 7.75109 l -7.42462,2.82087 c -6.82468,2.59293 -7.50374,3.26531 -8.40378,8.32112 -0.53854,3.02514 -1.01584,8.11847 -1.06066,11.31853 -0.0788,5.62625 -0.3032,5.90046 -6.79902,8.30864 -3.69463,1.36971 -9.60315,5.27454 -13.13005,8.67742 -6.75466,6.51713 -8.34496,6.89803 -20.457521,4.89979 z\"\r\n" +
+	"     id=\"path4818\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"sccscsccccsscsscssscss\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile0b;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 84.583489,485.99942 c -0.89464,-6.97598 -1.94054,-13.50168 -2.32424,-14.50157 -0.38369,-0.9999 -3.867624,-3.59994 -7.742076,-5.77787 -8.324975,-4.67971 -9.195089,-6.07121 -11.450544,-18.312 -1.508016,-8.1843 -1.4057,-10.32999 0.831786,-17.44354 l 2.551317,-8.1113 -6.507323,-13.09982 -6.507324,-13.09982 3.72142,-8.62483 c 3.692459,-8.5577 3.706958,-8.73015 1.863117,-22.15987 l -1.858303,-13.53505 5.53692,-5.53693 5.536921,-5.53692 7.469143,3.90468 7.469146,3.90468 8.40437,-8.87647 c 9.251521,-9.77121 9.985991,-12.44808 6.289833,-22.92413 -2.093743,-5.93427 -2.071693,-6.04737 2.240148,-11.49151 2.39509,-3.02404 4.95878,-5.49826 5.69709,-5.49826 0.73832,0 4.42089,1.55999 8.18349,3.46665 7.77244,3.93859 8.57378,5.73134 8.60191,19.24402 0.0109,5.25045 0.78644,10.25053 1.78708,11.52215 0.97227,1.23558 5.90434,5.03555 10.96016,8.44438 l 9.19239,6.19788 -0.71504,7.97945 c -0.39327,4.3887 -1.08302,8.34744 -1.53278,8.7972 -0.44977,0.44976 -5.53737,2.1962 -11.30578,3.88097 -5.76842,1.68476 -11.00157,3.50259 -11.62923,4.03961 -1.21719,1.04141 -5.08814,16.03634 -5.17239,20.03629 -0.0277,1.3155 3.50957,5.13388 7.86061,8.48528 l 7.91098,6.09346 -0.64703,13.16141 -0.64703,13.1614 7.93884,5.04091 c 4.36637,2.7725 8.25704,5.33304 8.64595,5.69009 0.38891,0.35706 1.02531,5.16264 1.41422,10.67907 l 0.7071,10.02987 7.56599,2.09822 c 6.21975,1.72488 8.52794,3.31516 12.97216,8.93752 l 5.40616,6.83931 -2.17887,5.54687 c -2.36329,6.01633 -4.60605,7.15339 -14.17077,7.18437 -5.68505,0.0184 -6.23889,-0.37379 -11.93602,-8.45231 -6.22667,-8.8294 -5.79705,-8.60709 -20.31106,-10.51056 l -5.68186,-0.74517 -6.86785,11.27789 -6.86785,11.27788 h -9.539141 -9.53914 z\"\r\n" +
+	"     id=\"path4820\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"cccsscccsscccccsccscccccsccccscccscscsscccsccccccc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile0c;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 171.42881,473.44657 c -4.22865,-5.54497 -6.68502,-7.31185 -12.88004,-9.26466 l -7.65489,-2.41299 v -9.54709 -9.54708 l -8.60733,-4.87634 -8.60733,-4.87634 0.58401,-13.43502 0.584,-13.43503 -8.10719,-6.71959 c -6.49823,-5.38601 -7.96362,-7.35068 -7.38377,-9.8995 2.52869,-11.11524 3.56321,-13.68672 5.75975,-14.31687 1.34383,-0.38552 7.05721,-2.04184 12.69638,-3.68071 l 10.25305,-2.97976 v -6.91912 c 0,-11.82599 1.0929,-13.1453 13.97228,-16.86686 l 11.44781,-3.3079 9.81106,5.82865 c 9.27039,5.50744 10.42608,5.82865 20.97085,5.82865 h 11.15978 l 3.08866,7.45195 c 1.69877,4.09858 3.08866,7.99079 3.08866,8.64936 0,0.65858 -2.22738,4.23172 -4.94974,7.94032 -5.72229,7.79532 -5.73311,7.87289 -2.51947,18.06773 l 2.43027,7.70973 -3.51624,3.02117 c -1.93394,1.66165 -5.33226,4.34247 -7.55183,5.95738 l -4.03557,2.9362 2.36887,8.14866 2.36888,8.14865 -5.73261,7.59279 c -3.15294,4.17604 -5.73261,8.26629 -5.73261,9.08944 0,0.82316 2.27246,4.91924 5.04992,9.10239 l 5.04993,7.60574 -3.56683,7.03879 c -3.92904,7.7536 -5.93066,8.76931 -17.34106,8.79961 -7.04218,0.0187 -7.43799,-0.19769 -12.49765,-6.83235 z\"\r\n" +
+	"     id=\"path4822\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"sscccccccsscscsscsscsssscsccccsssccss\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile0d;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 205.03113,489.49062 -3.81478,-7.77818 3.83014,-7.8095 c 2.10658,-4.29523 3.83014,-8.82384 3.83014,-10.06358 0,-1.23974 -2.1704,-5.21075 -4.82312,-8.82447 l -4.82312,-6.57041 5.53023,-7.74288 c 6.14673,-8.60605 6.40071,-9.90645 3.5261,-18.05397 l -2.00413,-5.6803 7.66098,-5.27632 c 4.21354,-2.90198 7.66743,-5.99432 7.6753,-6.87187 0.008,-0.87755 -1.35307,-5.48934 -3.02431,-10.24843 l -3.03862,-8.65287 4.50646,-6.54992 c 2.47855,-3.60246 5.40692,-6.54992 6.50749,-6.54992 1.10056,0 5.22582,1.27109 9.16724,2.82466 l 7.16621,2.82465 9.50315,-4.67114 c 8.18015,-4.02084 10.63706,-4.59263 17.64808,-4.10722 7.73951,0.53585 8.40823,0.89173 13.43502,7.14979 6.80944,8.47733 5.94816,7.98188 18.644,10.72498 10.24439,2.21343 11.40641,2.86161 19.13929,10.67603 l 8.23271,8.31953 9.5978,-0.80464 9.59778,-0.80465 6.98531,6.20787 6.9853,6.20787 -4.79047,9.54594 -4.79045,9.54594 h -10.41847 -10.41846 l -5.33448,6.51002 -5.33447,6.51003 -10.03868,-0.93431 -10.03868,-0.9343 -5.65685,5.8496 -5.65686,5.84961 -9.89949,-0.78219 -9.8995,-0.7822 -6.7634,8.44875 -6.7634,8.44875 h -8.3963 -8.3963 l -7.03134,10.42001 c -7.29529,10.81116 -9.37475,12.15359 -18.87961,12.1881 -5.04765,0.0183 -5.51306,-0.37704 -9.13344,-7.75886 z\"\r\n" +
+	"     id=\"path4824\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"scssccsscscsccsccsscsscccccccccccccccccccccscs\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile19;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 309.77071,591.24028 -11.53591,-4.76881 -8.88479,3.48192 c -4.88664,1.91506 -9.46397,3.48192 -10.17186,3.48192 -0.70789,0 -4.70574,-2.06828 -8.88412,-4.59619 l -7.59706,-4.5962 -0.0401,-10.34843 c -0.0393,-10.12933 -0.17382,-10.51257 -6.35537,-18.10107 l -6.31525,-7.75264 3.38127,-9.31531 3.38128,-9.31531 -2.75915,-8.93308 -2.75914,-8.93307 -8.7458,-0.4201 -8.74581,-0.42009 -1.799,-6.23235 c -1.77627,-6.1536 -1.72338,-6.35392 4.18588,-15.85219 l 5.98488,-9.61984 8.70715,-0.0577 8.70714,-0.0577 6.05217,-7.42462 c 3.3287,-4.08354 6.41084,-7.85762 6.84921,-8.38685 0.43837,-0.52923 4.75849,-0.48882 9.60027,0.0898 l 8.80324,1.05204 6.0437,-6.15808 c 6.03439,-6.1486 6.05568,-6.15663 13.82418,-5.21604 4.27926,0.51812 7.96,1.09492 8.17942,1.28178 0.21943,0.18686 1.59153,4.50995 3.04912,9.60687 l 2.65016,9.26713 -10.27632,7.34933 -10.27632,7.34933 -1.74309,9.75375 -1.74309,9.75374 -12.41913,3.7176 -12.41912,3.71761 -2.09792,7.53735 c -2.02176,7.26374 -1.97156,7.81663 1.38276,15.23054 3.61033,7.97978 5.59255,9.30506 13.9723,9.34167 10.62827,0.0465 12.65031,1.24966 16.84342,10.02267 l 3.97336,8.31323 13.71408,4.26064 13.71407,4.26063 0.96756,7.29388 c 0.53217,4.01164 0.45602,7.74155 -0.16922,8.28871 -0.99986,0.875 -11.42778,5.24149 -15.27893,6.39777 -0.77781,0.23353 -6.60537,-1.72135 -12.95011,-4.3442 z\"\r\n" +
+	"     id=\"path4826\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"ccsssccscccccccscccccsscsscscccccccsccscccccccc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile1a;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 324.13504,564.6438 c -9.73071,-3.03601 -9.97621,-3.23284 -14.3985,-11.54377 -4.94264,-9.28883 -6.50934,-10.10773 -19.91456,-10.40908 -7.37381,-0.16577 -7.4719,-0.2268 -10.55132,-6.56518 -2.90465,-5.97865 -2.97295,-6.78232 -1.04228,-12.26558 2.02225,-5.74335 2.32031,-5.94573 14.06199,-9.54774 l 11.99595,-3.68 1.96349,-10.21016 1.96349,-10.21016 9.76504,-7.23912 9.76504,-7.23913 9.07524,0.97188 c 9.01077,0.96497 9.10772,1.02249 13.64751,8.09714 5.05565,7.87854 3.43028,7.53063 21.75217,4.65608 5.64662,-0.88591 5.66531,-0.87325 10.33233,6.99668 2.57151,4.33631 5.1171,7.87479 5.65685,7.8633 0.53976,-0.0115 5.00818,-0.97022 9.92983,-2.13048 l 8.94845,-2.10957 6.19743,4.45065 c 5.29142,3.80002 6.44246,5.54557 7.87353,11.94029 1.63518,7.30678 1.56476,7.66316 -2.88466,14.59698 l -4.56077,7.10734 -12.18931,0.40696 -12.1893,0.40696 -5.06045,8.75978 c -2.78325,4.81788 -5.43947,9.13879 -5.90271,9.60203 -0.46324,0.46324 -6.69728,0.0914 -13.85343,-0.82636 l -13.01117,-1.66861 -6.66245,6.56227 c -3.66435,3.60925 -7.59518,6.5067 -8.73519,6.43877 -1.14001,-0.0679 -6.52752,-1.5134 -11.97224,-3.21217 z\"\r\n" +
+	"     id=\"path4828\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"sssssscccccsssscsccsscccssccscs\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile1f;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 372.41792,626.47097 -5.45723,-5.07159 -0.19962,-12.00012 -0.19963,-12.00013 -11.26849,-4.52017 -11.2685,-4.52016 -1.72379,-8.4219 -1.72379,-8.4219 6.13237,-6.20295 6.13237,-6.20295 13.55218,1.70583 c 7.45371,0.9382 14.16312,1.32824 14.90982,0.86676 0.74669,-0.46149 3.75235,-5.27272 6.67922,-10.69163 l 5.3216,-9.85257 11.37724,0.82603 11.37725,0.82603 4.94975,-7.90384 c 2.72236,-4.34711 5.26794,-7.89394 5.65685,-7.88185 0.38891,0.0121 5.13069,2.50654 10.53728,5.54321 l 9.83017,5.52122 9.58003,-8.49334 9.58002,-8.49333 8.54696,7.53902 c 7.75216,6.83797 9.30067,7.60478 16.65224,8.24613 l 8.1053,0.70711 5.2586,9.89949 c 2.89222,5.44472 5.2787,10.28189 5.3033,10.74925 0.0246,0.46737 -3.13728,5.05306 -7.02637,10.19043 -3.88908,5.13736 -7.07107,10.31661 -7.07107,11.50944 0,1.19283 1.25533,5.47252 2.78961,9.51043 3.21807,8.46928 3.03902,8.85339 -7.18589,15.41469 l -7.0073,4.49658 -11.47483,-5.24529 -11.47483,-5.24528 -11.08874,4.2129 -11.08874,4.2129 -11.68617,-2.64311 c -17.10611,-3.86895 -16.57225,-4.02987 -22.85868,6.89038 -4.64603,8.07068 -6.91649,10.40993 -14.40055,14.83685 -4.8867,2.89055 -9.72321,5.23832 -10.7478,5.21727 -1.0246,-0.021 -4.31866,-2.32047 -7.32014,-5.10984 z\"\r\n" +
+	"     id=\"path4830\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"ccccccccccccccccscsccccscccsssccccccssscc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile1b;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 537.68128,559.99836 c -6.44745,-5.72979 -7.56995,-6.2179 -12.72792,-5.53456 -3.11127,0.41219 -6.94363,1.11253 -8.51637,1.55631 -2.43052,0.68583 -3.69296,-0.90255 -8.41488,-10.58744 -5.39836,-11.07229 -5.69752,-11.39433 -10.58485,-11.39433 -8.61081,0 -14.06146,-2.41507 -22.1642,-9.82052 -4.30281,-3.93252 -8.42444,-7.15004 -9.1592,-7.15004 -0.73476,0 -5.41424,3.79405 -10.39885,8.43122 l -9.06292,8.43121 -9.85066,-5.7031 c -9.0723,-5.25248 -9.97809,-6.2092 -11.46343,-12.10801 -1.86132,-7.39199 -2.05634,-7.05394 9.64019,-16.71027 l 8.54237,-7.05235 -5.46706,-10.13557 -5.46705,-10.13557 4.37296,-7.11093 c 4.71377,-7.66514 6.65773,-8.66885 16.84706,-8.6985 6.44851,-0.0188 6.81825,0.21902 13.16414,8.46597 3.5911,4.6669 6.75095,8.48528 7.02189,8.48528 0.27094,0 4.71047,-1.89242 9.86562,-4.20538 l 9.373,-4.20538 8.9995,4.43785 8.99949,4.43785 7.57357,-6.4703 c 7.38312,-6.3076 7.73349,-6.43947 13.9327,-5.24381 3.49751,0.67457 6.69964,1.25421 7.11583,1.28809 0.41619,0.0339 2.5717,3.41685 4.79003,7.51772 l 4.03332,7.45613 -4.62816,8.20067 -4.62817,8.20066 3.02011,4.14219 c 1.66107,2.27821 4.33268,5.9433 5.93693,8.14466 l 2.91681,4.00246 -3.59636,8.13202 -3.59637,8.13201 6.51091,11.28799 6.51091,11.28799 -5.32402,7.73023 c -2.92821,4.25163 -5.71142,7.96678 -6.18491,8.25589 -0.47349,0.28911 -4.04287,-2.30214 -7.93196,-5.75834 z\"\r\n" +
+	"     id=\"path4832\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"sscsssssccsscccscssscccsccsccccscccccsss\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile00;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 468.40015,460.22486 -6.61709,-8.52092 -10.13415,0.93204 -10.13415,0.93203 -6.13384,-7.8998 -6.13385,-7.8998 2.4692,-6.86661 c 2.22453,-6.18622 3.28773,-7.25782 10.73004,-10.81492 l 8.26084,-3.94832 v -12.87461 -12.87461 l 7.0511,-6.94507 7.05109,-6.94507 4.61617,3.36046 c 2.53889,1.84825 6.55799,4.81743 8.93134,6.59818 l 4.31517,3.23773 9.11986,-5.64053 9.11985,-5.64053 3.3824,3.91926 c 1.86032,2.15559 5.14009,5.93986 7.28837,8.40948 l 3.90597,4.49022 10.33539,-2.65851 c 5.68447,-1.46218 10.55559,-2.43832 10.82472,-2.16918 1.21676,1.21676 1.79393,26.20133 0.73715,31.90969 -1.07919,5.82939 -1.81268,6.70534 -8.73203,10.42789 l -7.55387,4.06393 0.94517,7.24978 c 0.51985,3.98738 1.2641,8.55144 1.6539,10.14236 0.53368,2.17819 -1.05961,4.38364 -6.45133,8.93001 l -7.16003,6.03743 -8.12344,-4.44893 -8.12344,-4.44894 -9.41171,4.23839 -9.41171,4.23839 z\"\r\n" +
+	"     id=\"path4834\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"ccccccsscccccsccccsscscsccccscccccc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile10;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 543.43831,400.8773 c -0.39895,-1.82081 -1.76352,-10.22294 -3.03238,-18.67139 l -2.307,-15.36083 6.50869,-6.60806 c 3.57978,-3.63443 6.50869,-7.42104 6.50869,-8.41469 0,-0.99364 -1.60454,-7.38392 -3.56565,-14.20062 l -3.56566,-12.394 2.9757,-9.63417 2.97569,-9.63416 9.57549,0.80277 9.5755,0.80278 7.55561,-7.65065 7.55561,-7.65065 -2.19216,-9.16204 -2.19217,-9.16204 10.06638,-3.38453 10.06638,-3.38452 10.33325,5.77915 10.33325,5.77916 -1.06033,8.50727 c -1.90958,15.32113 -2.01988,14.9763 6.31294,19.73651 l 7.57468,4.32712 -0.92737,9.19239 c -0.6874,6.81357 -2.31216,11.71439 -6.27853,18.93811 -5.16205,9.40133 -5.6666,9.85994 -14.27813,12.9781 -4.90984,1.77781 -9.41412,4.02058 -10.0095,4.98394 -0.59539,0.96335 -1.49344,4.66915 -1.99567,8.2351 -1.45412,10.32449 -1.95411,11.11834 -8.60175,13.65709 -5.35149,2.04375 -7.51218,2.16435 -14.3118,0.79879 -4.40479,-0.88461 -8.42307,-1.60838 -8.92951,-1.60838 -0.50645,0 -3.42319,4.75999 -6.48165,10.57774 -3.77086,7.17285 -6.69891,10.99569 -9.09638,11.87617 -1.94455,0.71413 -4.62258,1.73958 -5.95119,2.27876 -1.78997,0.72642 -2.60354,0.12285 -3.14103,-2.33022 z\"\r\n" +
+	"     id=\"path4836\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"cccsssccccccccccccccsccssccssssccc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile0f;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 509.62197,381.17974 -7.87158,-8.69837 -9.36491,5.91448 -9.36492,5.91448 -7.31787,-5.48204 c -4.02483,-3.01513 -8.17658,-5.99573 -9.22611,-6.62356 -1.30614,-0.78133 -4.43844,1.3623 -9.92733,6.79391 l -8.01909,7.93542 -7.04323,-2.66867 -7.04323,-2.66868 -0.8938,-8.11451 c -0.7993,-7.25672 -0.38414,-9.23285 3.92742,-18.69395 4.02618,-8.83487 4.52544,-10.96077 3.02758,-12.89186 -0.9865,-1.27182 -4.17808,-5.20807 -7.09239,-8.74721 -3.70333,-4.49733 -5.72969,-8.70711 -6.72998,-13.98161 -2.25618,-11.8968 -2.05614,-12.55076 4.86816,-15.91485 l 6.29938,-3.06047 9.86035,6.55668 9.86034,6.55669 8.94257,-2.95609 c 4.91841,-1.62584 9.44752,-3.40945 10.06469,-3.96356 0.61717,-0.55411 1.57177,-5.07888 2.12132,-10.05504 l 0.99919,-9.04757 6.7733,-1.23648 c 6.40693,-1.1696 7.206,-0.9492 14.77261,4.07475 l 7.99931,5.31124 8.93681,-2.90557 8.9368,-2.90557 8.46394,7.0723 c 4.65517,3.88976 8.46395,7.91511 8.46395,8.94522 0,1.03011 -1.20059,5.73042 -2.66797,10.44513 l -2.66797,8.57219 3.34059,11.70635 c 1.83732,6.43849 3.3561,12.68753 3.37507,13.88676 0.019,1.19924 -2.85769,5.01761 -6.39259,8.48529 -6.96051,6.82815 -6.72162,5.50195 -3.96587,22.0166 0.70933,4.25087 0.50508,4.45413 -6.10385,6.07435 -3.76075,0.92198 -7.87979,1.98589 -9.15342,2.36426 -1.53307,0.45544 -4.976,-2.2518 -10.18727,-8.01044 z\"\r\n" +
+	"     id=\"path4838\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"scccccsccccscsscccccsscsscccssccscssccs\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile0e;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 412.82563,435.43679 -7.47592,-0.54477 -3.38114,-7.64534 c -2.83526,-6.41104 -4.55891,-8.28244 -10.67639,-11.59157 l -7.29526,-3.94624 0.76096,-9.7253 c 0.67659,-8.6471 0.30239,-10.73665 -3.37529,-18.84759 l -4.13624,-9.12229 5.583,-11.10404 5.583,-11.10404 -4.87941,-10.70717 -4.87942,-10.70718 9.61202,-4.84053 9.61201,-4.84053 3.0302,-10.07934 3.03019,-10.07933 -3.68287,-7.50923 -3.68287,-7.50922 7.36835,-9.7441 c 8.12386,-10.7432 9.46278,-11.62129 17.94085,-11.76603 5.2658,-0.0899 6.17847,0.43855 9.54594,5.52714 3.18666,4.81537 3.72346,7.14826 3.72346,16.18187 v 10.55535 l -7.06963,3.97066 c -7.83829,4.40239 -7.87742,4.53458 -5.56353,18.79665 1.0101,6.22596 2.70896,10.7092 5.29262,13.96706 10.11306,12.75206 9.60749,10.45187 4.70647,21.41279 -4.07402,9.11137 -4.30243,10.47902 -3.34418,20.02431 l 1.0285,10.24503 7.77818,2.96173 7.77817,2.96172 0.40558,11.28135 0.40557,11.28134 -7.60842,3.73151 c -6.87121,3.36996 -7.87083,4.46295 -10.3167,11.28028 -2.95588,8.23887 -2.83033,8.18144 -15.8178,7.23505 z\"\r\n" +
+	"     id=\"path4840\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"sccccsscccccccccccssssccssssccccccss\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile06;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 358.78327,408.0335 -7.77817,-7.08846 -9.75433,0.89188 -9.75433,0.89189 -8.63045,-8.54246 c -8.10733,-8.02468 -9.27334,-8.67511 -19.23705,-10.73078 -10.74719,-2.21733 -11.1657,-2.4933 -19.07882,-12.58128 -2.8481,-3.63088 -4.26519,-4.14206 -13.26812,-4.78622 l -10.07947,-0.72118 0.0994,-5.8204 c 0.13429,-7.86349 1.187,-10.03955 6.89287,-14.2483 3.31143,-2.44255 6.70732,-3.59907 10.6066,-3.61219 9.772,-0.033 11.57483,-1.14966 16.3652,-10.13731 l 4.51916,-8.47883 -4.38857,-6.11098 c -4.38001,-6.09905 -4.38494,-6.127 -2.52762,-14.30501 l 1.86096,-8.19402 9.43572,-1.74148 c 10.67215,-1.96967 12.29061,-2.69053 12.29061,-5.47415 0,-1.08253 0.68373,-5.15634 1.51939,-9.05291 1.38417,-6.45415 2.15577,-7.45208 8.66988,-11.21298 l 7.15049,-4.1283 7.94713,4.71768 7.94713,4.71769 11.77339,-2.63714 c 6.47537,-1.45042 11.96031,-2.85454 12.18877,-3.12026 0.22845,-0.26572 2.36471,-3.93168 4.74723,-8.14659 l 4.33186,-7.66346 -4.14626,-6.02637 c -2.28044,-3.31451 -4.14625,-6.31426 -4.14625,-6.66612 0,-0.35186 4.08404,-3.3006 9.07564,-6.55275 4.99159,-3.25216 9.41758,-6.80412 9.83553,-7.89326 0.41794,-1.08914 0.0512,-5.35895 -0.81497,-9.48846 -1.56971,-7.48362 -1.55519,-7.53937 4.43337,-17.02159 5.94962,-9.42056 7.4334,-10.35211 13.77816,-8.65033 2.32904,0.62469 3.47499,2.59006 4.73012,8.11241 4.01881,17.68214 3.86155,17.38365 12.00688,22.79021 7.97922,5.29631 8.38398,6.05235 9.79945,18.30443 0.74868,6.48042 0.42773,7.4209 -4.88043,14.30126 -5.53284,7.17159 -5.90663,7.38507 -14.25684,8.14224 -4.71627,0.42766 -8.75769,0.99244 -8.98094,1.25509 -0.22326,0.26264 -4.2723,5.78965 -8.99788,12.28225 l -8.59196,11.80472 3.73724,7.62009 c 3.66005,7.4627 3.69407,7.78848 1.64704,15.77245 l -2.0902,8.15234 -10.83424,5.67238 -10.83424,5.67237 4.86178,11.18065 c 2.67397,6.14935 4.86177,11.8321 4.86177,12.62833 0,0.79625 -2.3159,5.94654 -5.14646,11.44512 l -5.14645,9.9974 4.09883,9.88564 c 3.35167,8.08361 4.00996,11.48014 3.61126,18.63277 l -0.48758,8.74712 -6.4949,2.21303 c -3.5722,1.21717 -6.54063,2.18549 -6.59653,2.15181 -0.0559,-0.0337 -3.6018,-3.25103 -7.8798,-7.14968 z\"\r\n" +
+	"     id=\"path4842\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"ccccscsscsccccsscssccccccccccscccsscsssscscsscccssccsscscc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile05;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 232.91826,370.13833 c -5.47717,-2.17025 -6.911,-3.65229 -10.28977,-10.63568 l -3.92581,-8.11406 4.98647,-8.20862 c 2.74256,-4.51474 4.98647,-9.06742 4.98647,-10.11706 0,-1.04964 -2.3175,-5.66308 -5.15,-10.25209 l -5.15,-8.34366 2.29227,-7.27191 c 1.56803,-4.97438 3.53026,-8.02425 6.21066,-9.65318 2.15512,-1.3097 5.66287,-3.84118 7.79501,-5.62552 l 3.87662,-3.24426 -2.76166,-10.59019 c -1.51891,-5.8246 -2.3088,-10.95624 -1.7553,-11.40363 5.36512,-4.33665 16.61209,-13.23962 16.79312,-13.29326 0.12673,-0.0375 -0.54158,-3.88663 -1.48513,-8.55354 l -1.71554,-8.48528 9.10575,-7.48935 c 8.84326,-7.27345 9.33174,-7.47729 16.94525,-7.07107 l 7.8395,0.41828 2.30647,10.84995 c 1.26857,5.96747 3.10286,11.80952 4.07621,12.98233 2.00984,2.42172 18.32934,8.2961 20.0658,7.22291 0.62621,-0.38702 2.73543,-4.19169 4.68715,-8.45481 1.95172,-4.26312 4.13929,-7.75113 4.86126,-7.75113 0.72198,0 4.27289,1.30944 7.89092,2.90986 l 6.57823,2.90987 8.48958,-5.15715 8.48958,-5.15715 9.81022,2.97976 c 8.68299,2.63738 10.31543,3.71408 14.20715,9.3705 l 4.39694,6.39074 -3.69896,6.31181 c -2.03443,3.4715 -4.46764,6.33448 -5.40712,6.36219 -0.93949,0.0277 -5.27223,0.90277 -9.62832,1.94458 l -7.92017,1.8942 -8.22965,-5.03345 -8.22965,-5.03346 -9.11541,4.85665 c -9.587,5.1079 -10.77757,6.77216 -12.35108,17.26507 -1.09148,7.27846 -1.3269,7.44475 -12.52034,8.84327 -9.14035,1.14201 -9.54519,1.62219 -12.18381,14.45111 -1.78676,8.68723 -1.75192,8.93064 2.11461,14.77336 l 3.93131,5.94061 -3.13987,5.91871 c -3.19399,6.02072 -6.68831,7.60512 -16.87729,7.65251 -1.84034,0.009 -6.45421,2.29716 -10.25305,5.08576 l -6.90699,5.0702 v 9.49724 9.49725 l -6.40179,3.11022 c -7.60245,3.69356 -9.30029,3.73501 -17.63984,0.43057 z\"\r\n" +
+	"     id=\"path4844\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"cccssscccscsccscssccccssccccsscscscccssssscsccccccc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile04;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 200.67285,310.33462 c -7.48695,-1.3931 -7.50254,-1.40713 -12.02081,-10.82151 -2.48828,-5.18463 -5.04365,-9.80845 -5.6786,-10.27514 -0.63495,-0.4667 -6.36252,-2.61276 -12.72792,-4.76902 -6.36541,-2.15626 -12.61087,-5.09179 -13.87881,-6.52339 -1.26793,-1.4316 -2.72587,-5.96876 -3.23986,-10.08258 -0.90176,-7.21746 -1.21756,-7.66221 -9.00837,-12.68671 -4.44061,-2.86388 -11.25583,-5.97222 -15.14491,-6.90744 -3.88909,-0.93522 -7.57769,-2.15231 -8.1969,-2.70465 -0.6192,-0.55234 0.17576,-4.3064 1.76659,-8.34235 l 2.8924,-7.3381 -3.63071,-7.16488 c -1.9969,-3.94068 -3.32486,-7.96193 -2.95104,-8.93611 0.37383,-0.97418 3.56359,-3.46131 7.08837,-5.52697 l 6.40868,-3.75573 14.42491,2.16659 c 7.93371,1.19162 14.52307,1.99309 14.64302,1.78104 3.30431,-5.84104 13.33783,-15.32995 19.02782,-17.995 7.1351,-3.3419 7.1768,-3.34421 12.99772,-0.71934 7.23762,3.26371 8.96494,5.99266 11.15384,17.62168 2.38695,12.68124 3.36946,14.13022 9.63142,14.20421 7.81156,0.0923 14.88641,3.10193 22.73086,9.66968 6.24199,5.22609 7.12182,6.66662 8.00828,13.11196 0.96559,7.02071 0.85143,7.3532 -3.75516,10.93689 -2.61307,2.03283 -6.48397,5.28705 -8.602,7.23159 l -3.85097,3.53553 2.05669,8.48529 c 2.94601,12.15432 2.81232,12.67188 -4.69704,18.18426 -5.35141,3.9283 -7.24536,6.43655 -8.90072,11.78771 -2.38892,7.7225 -3.77103,8.20967 -16.54678,5.83249 z\"\r\n" +
+	"     id=\"path4846\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"sscscscscccccccscsssssssscssss\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile01;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 101.52858,208.65137 c -4.983111,-0.64538 -9.756081,-1.8645 -10.606601,-2.70917 -0.85051,-0.84468 -2.86388,-5.4578 -4.47415,-10.25139 l -2.92775,-8.71562 -7.668983,-1.94298 c -4.217939,-1.06863 -8.468915,-2.60686 -9.446613,-3.41828 -1.012527,-0.84032 -1.777634,-4.65727 -1.777634,-8.86823 0,-7.25561 0.154338,-7.52716 8.309861,-14.62062 l 8.309859,-7.22769 -2.102577,-11.40907 -2.102579,-11.40907 5.764416,-8.7716 5.76443,-8.77161 -4.39913,-7.8055 c -2.41951,-4.29303 -5.248275,-9.10598 -6.286137,-10.69544 -1.674393,-2.5643 -1.343136,-3.69755 2.939857,-10.05744 5.2547,-7.80277 7.97894,-8.97294 20.889751,-8.97294 7.3925,0 10.78308,-2.34562 19.16435,-13.25806 l 3.12264,-4.06567 8.45326,3.16131 c 8.01657,2.998 8.59322,3.5368 11.16241,10.42964 1.49002,3.99757 2.94132,8.54111 3.22511,10.09675 0.33356,1.82846 3.76585,4.47425 9.70836,7.4837 9.10776,4.61242 9.22214,4.744 12.42392,14.2908 l 3.23153,9.63551 9.71098,3.90933 9.71097,3.90933 2.41176,8.59544 c 1.32647,4.72749 2.68621,9.31066 3.02166,10.18481 0.33544,0.87416 -1.9601,4.81716 -5.10122,8.76224 l -5.71111,7.17286 v 14.96102 14.96103 l -7.12826,3.06649 c -4.51135,1.94073 -9.57337,5.80361 -13.78858,10.52221 l -6.66033,7.45572 -13.6177,-2.05336 -13.6177,-2.05337 -8.54057,5.00549 c -4.69731,2.75302 -9.38808,4.9248 -10.42393,4.82618 -1.03585,-0.0986 -5.96045,-0.70738 -10.94357,-1.35275 z\"\r\n" +
+	"     id=\"path4848\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"ccccccssccccccccsccscssscccscscccsscccscc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile07;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 448.23228,301.91161 -8.83884,-5.82694 v -10.65345 c 0,-9.49829 -0.4747,-11.3932 -4.37796,-17.47591 l -4.37796,-6.82246 5.81599,-7.30457 c 4.71512,-5.92194 5.68941,-8.13394 5.14727,-11.68613 -2.63884,-17.29021 -2.61936,-17.52954 1.60639,-19.73635 4.80008,-2.50675 10.18603,-8.24993 14.49056,-15.45167 l 3.1981,-5.35063 4.4516,2.9511 c 10.92827,7.24468 13.02649,7.7804 20.772,5.30348 l 7.10791,-2.27302 6.40308,5.54183 c 3.5217,3.04801 7.33295,5.54183 8.46944,5.54183 1.1365,0 6.12911,-1.84214 11.0947,-4.09364 l 9.02833,-4.09364 4.56993,4.71497 4.56993,4.71497 -5.3863,7.49734 -5.3863,7.49734 5.25019,9.79614 5.25019,9.79614 -7.2576,6.34877 -7.2576,6.34877 0.48191,9.09694 0.48191,9.09694 -6.63327,2.53327 -6.63328,2.53328 -7.33472,-4.93949 c -6.69869,-4.51117 -7.93713,-4.85958 -14.28174,-4.01794 -12.40496,1.64558 -13.90736,2.71234 -13.90736,9.87477 0,10.12406 -1.71932,12.94704 -9.00222,14.7809 -8.47602,2.13429 -7.47165,2.3776 -17.51428,-4.24294 z\"\r\n" +
+	"     id=\"path4850\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"scsscsscccsscssscccccccccccccsssss\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile08;fill-opacity:1;stroke:#ffffff;stroke-width:1.41421354;stroke-opacity:1;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 555.92537,302.24256 c -6.288,-0.76792 -8.48901,-2.01311 -17.18337,-9.72124 -9.25196,-8.20248 -10.01989,-9.32381 -10.64044,-15.53715 -0.66035,-6.61172 -0.56796,-6.79019 7.28388,-14.0719 l 7.9527,-7.37524 -3.43919,-6.05979 c -1.89155,-3.33288 -4.42479,-7.76809 -5.62941,-9.85603 -2.11082,-3.6586 -1.98611,-4.08912 3.43918,-11.87331 5.184,-7.43797 6.35675,-8.29602 14.82181,-10.84442 5.05581,-1.52205 9.30395,-2.86673 9.44032,-2.98817 0.13636,-0.12144 -1.0399,-4.91732 -2.61391,-10.65753 l -2.86184,-10.43673 6.14944,-6.14847 6.14944,-6.14847 8.62808,1.60506 c 8.56178,1.59272 8.66261,1.66787 13.12183,9.77918 l 4.49374,8.17412 9.06687,-2.51417 9.06686,-2.51417 7.02299,6.14355 7.023,6.14354 -2.69518,7.09313 c -2.78129,7.31977 -3.36462,14.67573 -1.78866,22.55554 0.74773,3.73863 2.24017,5.18666 8.52023,8.2667 l 7.61372,3.73411 -0.92767,8.52709 c -0.51022,4.6899 -1.11036,8.84528 -1.33364,9.23419 -0.22329,0.38891 -3.35557,2.2981 -6.96065,4.24264 l -6.55467,3.53554 -11.70859,-6.20645 -11.70859,-6.20644 -12.25785,4.62641 c -6.74182,2.54453 -12.2503,5.33743 -12.24107,6.20645 0.009,0.86901 0.93422,5.02724 2.05553,9.24051 l 2.03875,7.66048 -5.72275,6.83521 c -3.14752,3.75936 -6.77719,6.7456 -8.06595,6.63609 -1.28875,-0.10952 -5.58847,-0.59545 -9.55494,-1.07986 z\"\r\n" +
+	"     id=\"path4852\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"cscscsccscscccsscccccscccscccccscscccc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile02;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 535.90895,211.25061 -4.51186,-4.65506 2.08143,-6.9161 c 2.92593,-9.72212 2.65341,-12.48524 -2.17472,-22.04972 -3.72865,-7.3864 -5.27564,-8.94605 -12.48144,-12.58353 l -8.22527,-4.1521 -4.11262,-12.11283 -4.11262,-12.11282 3.51258,-6.51963 3.51258,-6.51963 7.88073,0.69385 c 4.3344,0.38162 8.86305,0.14411 10.06367,-0.52779 1.20062,-0.6719 4.90026,-5.39232 8.22142,-10.48983 5.65276,-8.67619 5.98397,-9.72832 5.18533,-16.47162 -0.84923,-7.17035 -0.81923,-7.2387 6.53567,-14.8923 l 7.38881,-7.68889 7.9337,3.03606 7.9337,3.03605 5.9816,-3.05158 c 3.28987,-1.67837 6.75364,-2.75532 7.69725,-2.39322 0.94362,0.3621 2.87486,4.4869 4.29164,9.16624 l 2.57598,8.50787 9.60743,0.96484 9.60743,0.96484 5.45727,9.42604 5.45728,9.42604 -2.76395,9.66503 -2.76395,9.66502 -8.95133,4.4141 -8.95133,4.4141 v 12.83705 12.83704 l -6.09848,5.98521 -6.09848,5.98521 -9.49224,-1.98974 -9.49225,-1.98975 -7.03669,7.895 c -7.62651,8.55675 -7.98028,10.02435 -5.06015,20.99176 l 1.94298,7.29742 -6.02032,1.51593 c -3.31117,0.83377 -6.91775,1.86032 -8.01462,2.28122 -1.12434,0.43144 -3.96247,-1.26536 -6.50616,-3.88978 z\"\r\n" +
+	"     id=\"path4854\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"scccscccccssscscccccccccccccccccccccssccss\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile09;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 744.31317,312.07905 c -6.66937,-8.25456 -10.19772,-11.08254 -22.30633,-17.87861 l -14.38378,-8.07304 -8.64465,2.81059 -8.64464,2.81058 -7.6538,-9.51518 -7.65381,-9.51518 -8.21638,2.9563 -8.21638,2.9563 -8.13015,-5.7794 -8.13016,-5.7794 0.462,-10.47408 0.462,-10.47408 -7.33392,-3.43503 c -10.65804,-4.99195 -12.55689,-12.77996 -6.89922,-28.29667 l 2.95432,-8.10249 -7.34416,-6.89195 c -4.03928,-3.79057 -8.12037,-7.33811 -9.06909,-7.88342 -0.94871,-0.54531 -5.40561,-0.0303 -9.90422,1.14443 -4.49862,1.17475 -8.3509,1.93912 -8.56064,1.69859 -0.20974,-0.24053 -1.71821,-3.08892 -3.35216,-6.32976 l -2.97082,-5.89243 6.33252,-7.04328 6.33252,-7.04328 v -11.92484 -11.92483 l 7.02117,-3.28854 7.02117,-3.28854 9.84388,9.28622 9.84388,9.28622 8.44879,-0.76611 c 8.29781,-0.75242 8.55535,-0.66671 14.41222,4.79652 l 5.96344,5.56263 -3.7339,7.3685 c -4.04473,7.98188 -4.05089,7.90313 2.20636,28.18881 l 1.41773,4.5962 h 16.133 c 16.09058,0 16.16429,0.0155 28.03754,5.90405 l 11.90455,5.90405 9.88979,-5.19694 c 5.43939,-2.85832 10.19568,-5.19695 10.56951,-5.19695 0.37383,0 1.9818,3.02288 3.57325,6.71752 2.612,6.06389 3.77656,7.10135 11.96862,10.6624 l 9.07506,3.94488 1.3908,7.67306 1.39079,7.67306 -6.11419,6.00062 c -3.36281,3.30034 -6.11419,6.46229 -6.11419,7.02655 0,0.56426 3.84118,4.04373 8.53594,7.73214 l 8.53593,6.70621 -6.00734,6.12103 -6.00733,6.12102 7.53603,7.63083 7.53602,7.63082 -2.65531,8.8922 c -2.91413,9.75885 -1.19509,8.58274 -19.09116,13.06162 -3.34271,0.83659 -4.57741,-0.10253 -11.6611,-8.86992 z\"\r\n" +
+	"     id=\"path4856\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"csccccccccccccscsccsscccccccccsscsscsccsssscccssscccccccc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile0a;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 775.26546,289.37559 -5.52749,-5.66064 6.21293,-6.33051 c 3.41711,-3.48178 5.93442,-6.53367 5.59402,-6.78198 -0.3404,-0.24831 -4.11908,-3.25381 -8.39708,-6.67891 -4.27799,-3.42509 -8.05667,-6.43059 -8.39708,-6.6789 -0.3404,-0.24831 1.95486,-3.14281 5.10055,-6.43222 l 5.71946,-5.98076 -2.15757,-9.743 -2.15755,-9.74299 -9.06027,-4.10813 c -8.42683,-3.8209 -9.31465,-4.67226 -12.69881,-12.17731 -2.0012,-4.43805 -3.77087,-8.24293 -3.93261,-8.45529 -0.16175,-0.21235 -5.58892,2.44299 -12.06038,5.90078 l -11.76631,6.28687 -11.03288,-5.54722 c -10.81322,-5.43679 -11.33896,-5.54722 -26.40796,-5.54722 h -15.37508 l -2.8285,-10.25305 -2.8285,-10.25305 4.03006,-8.0914 c 2.21653,-4.45027 4.03006,-8.3323 4.03006,-8.62675 0,-0.29444 -3.6834,-3.73906 -8.18532,-7.65471 l -8.18531,-7.11937 -7.71947,1.22028 -7.71948,1.22029 -9.40299,-9.33094 -9.403,-9.33095 2.68037,-9.37274 c 1.4742,-5.15501 2.68036,-9.44522 2.68036,-9.53381 0,-0.0886 3.02288,-0.53533 6.71752,-0.99277 3.69463,-0.45744 8.69235,-1.24334 11.10604,-1.74644 4.67522,-0.9745 4.44741,-1.28546 10.47817,14.30238 1.06135,2.7433 1.77984,2.85436 11.51741,1.7804 11.51939,-1.27049 10.15383,-2.00926 20.42831,11.05174 l 3.49609,4.44427 -2.87968,7.57655 c -1.58382,4.1671 -2.88,8.27868 -2.88039,9.13683 -3.9e-4,0.85816 4.52033,4.56657 10.04605,8.24093 l 10.04676,6.68064 10.39899,-2.31355 c 8.72498,-1.94113 11.77951,-2.04196 18.9748,-0.62637 8.30107,1.63313 8.72207,1.93281 13.14182,9.35459 l 4.56601,7.66741 7.07107,-0.78726 c 3.88909,-0.43299 9.31302,-1.16482 12.0532,-1.6263 l 4.98212,-0.83904 3.22878,10.02503 c 3.60945,11.20697 5.52134,12.86823 14.80971,12.86823 6.1687,0 6.3141,0.10998 9.14255,6.91601 l 2.87418,6.91603 -5.50955,8.28677 c -3.03025,4.55773 -5.51157,8.85763 -5.51405,9.55534 -0.003,0.69771 2.28895,4.0674 5.09205,7.48822 l 5.09654,6.21965 -3.03265,7.04959 c -1.66797,3.87728 -2.55745,7.81848 -1.97665,8.75824 0.99592,1.61144 6.73337,6.76783 11.67771,10.49506 2.00143,1.50875 1.88357,2.34352 -1.14811,8.13173 l -3.3785,6.45038 h -12.9515 -12.95149 z\"\r\n" +
+	"     id=\"path4858\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"ccssscsccccscccsscccssscccccssscscccssscccccsccsscccccccscscccc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile03;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 749.05566,177.28476 -4.58638,-7.66793 -10.76301,-1.72578 c -9.02868,-1.44768 -12.21874,-1.38251 -19.79707,0.40444 l -9.03406,2.13021 -8.25739,-5.60749 -8.25739,-5.60749 2.79576,-7.09291 c 1.53767,-3.90109 2.79576,-7.88557 2.79576,-8.85439 0,-0.96881 -3.58317,-6.07834 -7.9626,-11.3545 -7.63399,-9.19713 -8.17384,-9.56166 -13.08147,-8.83321 -14.81483,2.19902 -14.26134,2.41642 -18.06752,-7.09664 l -3.45865,-8.64444 3.2539,-9.67739 3.2539,-9.67739 9.71576,-2.4248 c 5.34367,-1.33363 10.24021,-2.95295 10.8812,-3.59847 0.64099,-0.64552 2.63578,-5.30474 4.43287,-10.35381 l 3.26744,-9.18013 7.77167,-1.23804 7.77168,-1.23804 8.48528,9.35813 8.48528,9.35812 10.90565,-6.2e-4 10.90564,-6.1e-4 1.38238,-5.3033 c 0.76031,-2.91682 1.806,-7.66711 2.32377,-10.55622 1.14183,-6.3714 2.15767,-7.24889 11.51551,-9.94724 7.2314,-2.08519 7.43206,-2.05917 14.2794,1.85151 6.94104,3.96419 6.94591,3.97222 8.68814,14.31439 l 1.7432,10.34797 -5.02763,9.58191 -5.02764,9.58191 2.96748,7.38865 c 4.73503,11.78964 4.8714,11.25421 -4.04286,15.87393 l -8.18663,4.24264 -0.42736,9.08252 -0.42737,9.08252 9.28433,9.35602 9.28433,9.35602 -1.83151,9.04908 c -2.0251,10.00559 -2.10526,10.0645 -16.36287,12.02542 l -7.00453,0.96337 z\"\r\n" +
+	"     id=\"path4860\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"cccsccccsscsccccsscccccccscssscccsscccccsscc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile12;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 799.51272,460.43772 -7.80418,-7.13257 -8.78702,2.27694 -8.78703,2.27695 -7.24493,-7.15493 -7.24493,-7.15494 -7.5952,5.11491 -7.5952,5.1149 -7.65731,-3.17378 -7.6573,-3.17378 -11.8872,4.59568 -11.8872,4.59567 -8.18154,-7.1213 c -16.65093,-14.49314 -16.47892,-14.19399 -15.59311,-27.11764 l 0.77912,-11.36712 -8.70459,-4.60367 -8.7046,-4.60366 -0.87692,-9.1819 -0.87693,-9.18189 6.35202,-7.43512 c 6.31976,-7.39736 6.39397,-7.43511 14.61395,-7.43511 h 8.26194 l 7.2813,-8.83884 c 4.00472,-4.86136 7.77778,-9.3749 8.38459,-10.03008 0.6068,-0.65519 5.59365,0.25497 11.08188,2.02257 l 9.97861,3.21383 11.7404,-3.62261 11.74041,-3.62262 0.41263,-10.752 0.41264,-10.752 7.36554,-2.08403 c 4.05104,-1.14621 9.09987,-2.49409 11.21961,-2.99528 3.28227,-0.77606 4.31216,-2.33352 6.94154,-10.49749 l 3.08745,-9.58623 11.44324,-0.69436 c 6.29379,-0.3819 11.65023,-0.51601 11.90321,-0.29803 0.25298,0.21798 1.23714,4.16762 2.18701,8.77696 1.21499,5.89589 3.38475,10.5286 7.31821,15.6254 l 5.59117,7.24478 8.74677,0.0602 c 11.40949,0.0786 14.58045,-1.27202 19.43576,-8.27823 2.21207,-3.19201 4.7841,-5.80366 5.71561,-5.80366 0.93153,0 4.26759,2.38648 7.41349,5.3033 4.26093,3.95065 5.9736,6.82307 6.71482,11.26181 0.54724,3.27718 0.65385,6.51049 0.23689,7.18513 -0.41695,0.67464 -4.18106,1.99265 -8.3647,2.92891 -7.39266,1.65441 -7.75248,1.96472 -12.79248,11.03248 -2.85222,5.1316 -5.18174,10.04181 -5.17672,10.91157 0.006,0.86976 2.5587,4.55581 5.67484,8.19122 4.21638,4.91897 7.5604,7.2245 13.07234,9.01267 4.07366,1.32156 7.93459,2.88438 8.57985,3.47293 0.64528,0.58856 -0.27121,4.42781 -2.03665,8.53168 l -3.20988,7.46157 5.09547,9.27277 5.09547,9.27277 -7.00025,10.12621 -7.00024,10.12619 -10.72808,-2.02566 -10.72807,-2.02567 -6.5437,5.53849 c -5.44882,4.61181 -7.01693,7.10896 -9.37212,14.92465 l -2.82842,9.38618 -6.60355,0.008 c -5.96272,0.008 -7.36089,-0.68344 -14.40773,-7.12386 z\"\r\n" +
+	"     id=\"path4862\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"ccccccccccccsscccccsscscsccccccsscssssccsssccssccccsccccccccccccc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile11;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 651.65886,377.24949 c -8.38049,-2.58962 -9.77395,-4.50902 -9.93348,-13.68283 -0.0956,-5.49837 -0.3976,-5.86205 -7.93863,-9.56052 l -7.83924,-3.84472 4.85132,-8.82425 c 3.47023,-6.31216 5.31134,-12.04167 6.46724,-20.12607 1.79887,-12.58126 2.45176,-11.24275 -8.71591,-17.86868 l -6.26085,-3.71464 1.07981,-7.59907 c 0.5939,-4.17948 1.13544,-8.87185 1.20343,-10.42749 0.082,-1.87716 2.55208,-4.16818 7.34427,-6.81199 l 7.22064,-3.98356 9.87827,6.56861 9.87827,6.5686 6.99282,-3.00823 6.99282,-3.00822 2.75096,3.25161 c 1.51303,1.78839 4.82239,5.93662 7.35414,9.21829 2.53175,3.28167 5.29905,5.98636 6.14955,6.01041 0.85051,0.0241 5.22806,-1.22296 9.7279,-2.77113 l 8.18154,-2.81486 13.02672,7.41105 c 10.09189,5.74139 14.84419,9.55479 21.09382,16.92636 l 8.06709,9.51531 -0.41613,10.12862 -0.41613,10.12862 -9.65504,2.87316 -9.65505,2.87316 -9.53717,-3.07165 c -5.24544,-1.68941 -10.22921,-3.07165 -11.07503,-3.07165 -0.84582,0 -5.25544,4.44276 -9.79916,9.8728 l -8.2613,9.87281 -8.48528,0.0936 -8.48528,0.0936 -6.16961,7.71126 c -3.39329,4.24119 -6.57527,7.64887 -7.07107,7.57262 -0.4958,-0.0763 -4.34161,-1.20168 -8.54625,-2.50093 z\"\r\n" +
+	"     id=\"path4864\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"ccscccscccscccccsscscsscccccssccccscc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile1d;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 787.98149,594.7976 -7.05547,-7.19928 -9.19239,0.87362 -9.19239,0.87362 -7.48076,-6.59918 -7.48075,-6.59918 -10.60929,1.30475 -10.60928,1.30475 -5.74696,-4.91918 -5.74696,-4.91918 -9.84557,2.36293 -9.84557,2.36294 -6.11065,-3.58108 c -5.9819,-3.50563 -6.0849,-3.69194 -4.88849,-8.84278 2.01293,-8.66615 1.56572,-9.99445 -4.72077,-14.02164 -3.30572,-2.11768 -6.24291,-4.00324 -6.52708,-4.19013 -0.28417,-0.18689 0.65437,-6.13315 2.08565,-13.21392 1.82998,-9.05319 2.14663,-13.16708 1.06692,-13.86116 -0.84448,-0.54287 -3.82096,-3.00923 -6.61439,-5.48079 l -5.07897,-4.49376 3.12692,-10.91456 3.12691,-10.91456 12.40173,-4.46858 c 11.59198,-4.17681 12.72456,-4.94797 17.34621,-11.81073 4.96666,-7.37506 6.67038,-8.46836 21.34212,-13.69552 7.22776,-2.57507 7.32478,-2.57111 15.18673,0.61983 l 7.91251,3.21145 7.18116,-4.60815 7.18117,-4.60814 6.63397,6.53423 6.63398,6.53422 7.76768,-2.06703 c 4.27222,-1.13687 8.49434,-2.07458 9.38249,-2.08381 0.88815,-0.009 4.70652,2.74792 8.48528,6.12701 5.80774,5.19347 8.07359,6.27766 14.64864,7.00924 7.17784,0.79864 8.29125,1.44093 14.42569,8.32164 l 6.64751,7.4562 -5.19818,4.27289 c -2.85901,2.35009 -6.63714,5.54568 -8.39582,7.10132 l -3.19764,2.82843 2.54361,9.89187 2.5436,9.89188 -5.36505,3.88642 c -4.46735,3.23614 -7.38082,4.04137 -17.41231,4.81245 l -12.04725,0.92602 v 11.78836 11.78836 l 7.14831,3.62232 c 7.29374,3.69602 8.73321,5.80392 14.04815,20.57151 2.44994,6.80716 0.81183,20.70227 -2.5517,21.64456 -10.02617,2.80887 -10.62418,2.64796 -17.95325,-4.83048 z\"\r\n" +
+	"     id=\"path4866\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"ccccccccccccssssscscccsssccccccscscscccccccscccsscc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile1c;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 654.3539,581.25986 c -1.16672,-0.20027 -4.43352,-0.7396 -7.25954,-1.19852 -5.01826,-0.81491 -5.19824,-1.06579 -7.70874,-10.74514 -2.00302,-7.72272 -3.21029,-10.04265 -5.46838,-10.50823 -11.78236,-2.4293 -18.22572,-3.55468 -20.29159,-3.54405 -1.3817,0.007 -4.72833,3.61801 -7.86425,8.48528 -5.10407,7.92205 -5.81007,8.47291 -10.85914,8.47291 -6.65762,0 -8.18767,-1.48382 -13.45004,-13.04366 l -4.17296,-9.16672 -8.44066,-1.26918 -8.44067,-1.26918 -5.87049,-9.99063 -5.87048,-9.99064 4.07888,-8.98559 4.07888,-8.9856 -5.67763,-6.73333 c -3.1227,-3.70333 -5.67763,-7.47996 -5.67763,-8.39251 0,-0.91254 1.72644,-4.73043 3.83653,-8.48419 4.10127,-7.29599 4.19371,-7.33801 20.22208,-9.19431 7.55482,-0.87494 8.01887,-0.72465 15.0517,4.87497 l 7.25655,5.77776 h 13.53636 c 15.60156,0 14.70762,-0.73607 18.56428,15.28574 2.36823,9.83844 2.85121,10.6043 10.03313,15.9099 4.14981,3.06565 8.2444,5.57391 9.09907,5.57391 0.85467,0 5.44349,-3.14718 10.19738,-6.99372 9.31512,-7.53722 10.29666,-7.59647 16.47164,-0.99418 l 3.35346,3.58552 -2.2928,10.15284 c -3.21165,14.22163 -3.11246,14.6873 4.09461,19.22197 6.36142,4.00259 6.38371,4.04485 5.47565,10.38291 -0.50147,3.50018 -0.98932,6.43048 -1.08411,6.51179 -1.37871,1.18256 -20.35733,14.43475 -21.38555,14.93288 -0.77782,0.37682 -2.36881,0.52127 -3.53554,0.321 z\"\r\n" +
+	"     id=\"path4898\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"ccsccssscccccccssscscscsscscsscccc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile22;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 716.78504,706.47364 c -2.11699,-0.8479 -3.03698,-2.09672 -2.52587,-3.42866 0.44216,-1.15224 1.21732,-5.21128 1.72257,-9.02007 l 0.91865,-6.92508 -8.07547,-5.9657 -8.07547,-5.9657 -15.27394,0.99779 -15.27394,0.99779 -2.30985,8.23224 -2.30985,8.23224 -7.0978,1.52221 c -10.03526,2.15217 -9.99573,2.163 -16.39587,-4.48821 -5.28764,-5.49505 -5.93281,-7.00312 -7.52449,-17.5882 l -1.74095,-11.57781 -9.72096,-2.95265 c -8.75208,-2.65836 -10.28672,-3.70199 -15.39738,-10.47101 -3.12203,-4.1351 -5.67642,-7.89726 -5.67642,-8.36036 0,-0.46309 3.45057,-4.79847 7.66794,-9.63418 l 7.66794,-8.79219 -4.1324,-7.32222 c -2.27283,-4.02723 -4.13241,-8.11826 -4.13241,-9.09119 0,-0.97293 2.328,-4.60998 5.17333,-8.08233 l 5.17333,-6.31337 -4.86607,-5.23835 c -10.8115,-11.63862 -10.44982,-10.37896 -5.35666,-18.65641 4.22706,-6.86984 4.82069,-7.30104 8.91977,-6.47912 2.41523,0.48428 6.75983,1.26025 9.65467,1.72437 3.11743,0.49981 5.53528,1.7818 5.93034,3.14438 0.36685,1.26528 1.67753,5.87292 2.91262,10.23919 l 2.24561,7.93867 10.20882,1.41456 10.20882,1.41456 9.19239,-6.60514 c 5.05581,-3.63283 9.91791,-7.0406 10.80465,-7.57283 0.88675,-0.53222 4.62945,0.71866 8.31712,2.77974 l 6.70486,3.74742 9.70627,-2.51748 9.70627,-2.51749 5.63306,5.08913 c 3.09819,2.79903 6.44279,4.83537 7.43245,4.52522 0.98967,-0.31016 5.6983,-0.95476 10.46363,-1.43244 l 8.66422,-0.86851 7.59924,6.50922 7.59922,6.50923 9.19238,-0.89197 9.19239,-0.89197 6.55986,6.80758 c 5.74729,5.96432 6.64127,7.72729 7.21703,14.23221 0.36144,4.08354 0.59148,7.46456 0.51121,7.51338 -0.0803,0.0488 -4.91893,0.84431 -10.75256,1.76776 l -10.6066,1.67901 -3.35988,7.04046 c -1.84792,3.87226 -3.64607,9.28162 -3.99587,12.02082 -0.59338,4.64641 -0.10734,5.38894 7.24894,11.07381 4.33675,3.35141 7.88498,6.67961 7.88498,7.39601 0,4.36526 -5.24827,12.91513 -10.7517,17.51543 l -6.4077,5.35617 -7.16524,-2.97774 c -9.80332,-4.07407 -11.34755,-3.82808 -17.29501,2.75505 -5.64782,6.25145 -7.0064,8.87494 -9.29826,17.95529 -1.32726,5.2586 -1.99666,5.9992 -5.35159,5.92076 -2.10902,-0.0494 -5.33298,-0.68979 -7.16437,-1.42332 z\"\r\n" +
+	"     id=\"path4900\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"ccccccccccccscsssscssscsccssscccscscccscscccccsscscccssscscscc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile1e;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 875.38595,605.71629 c -2.98722,-2.03446 -5.9306,-6.24302 -8.62412,-12.33111 l -4.08911,-9.24254 -9.34442,0.78411 c -5.44507,0.45691 -10.14372,0.18276 -11.25987,-0.65697 -9.49315,-7.14207 -9.26643,-6.71822 -8.30499,-15.52612 l 0.90269,-8.26965 8.48528,-3.15589 8.48528,-3.15589 0.41645,-7.68314 c 0.66112,-12.19721 -0.67357,-14.08847 -14.26091,-20.20793 l -11.9641,-5.38837 -1.77172,-7.91791 c -0.97444,-4.35485 -2.03266,-8.59822 -2.35159,-9.42972 -0.31894,-0.83149 3.22902,-4.64986 7.88434,-8.48528 4.65532,-3.83541 8.49758,-7.52346 8.53836,-8.19566 0.0408,-0.6722 -3.07809,-4.86085 -6.93081,-9.30811 l -7.00496,-8.08592 2.80319,-9.34377 c 2.25785,-7.52598 3.87907,-10.19586 8.33341,-13.72376 l 5.5302,-4.38001 9.46635,1.74425 9.46634,1.74424 6.20121,9.67451 c 3.41066,5.32099 7.09546,10.01767 8.18843,10.43709 1.66075,0.63728 12.97748,-1.65339 22.21998,-4.49765 1.55464,-0.47842 4.07575,1.40157 7.52598,5.61212 l 5.18822,6.33154 -2.35332,12.45857 -2.35331,12.45858 8.68568,7.90216 8.68568,7.90216 -2.38023,8.56122 -2.38023,8.56122 3.95611,5.18674 c 5.2403,6.87037 10.25197,9.26265 19.40467,9.26265 7.56491,0 7.56729,0.001 10.6528,6.35232 l 3.08619,6.35232 -6.74468,3.41779 c -5.44215,2.75774 -7.39945,4.81573 -10.13511,10.65647 -2.14687,4.58366 -4.46227,7.46359 -6.31275,7.85189 -1.60728,0.33727 -6.74071,1.31849 -11.40762,2.18048 -13.12493,2.42425 -13.17156,2.45392 -18.54637,11.79899 -4.48675,7.80101 -5.8977,9.11923 -12.35139,11.53964 -4.0073,1.50291 -7.6042,2.8609 -7.99311,3.01775 -0.38891,0.15685 -2.74786,-1.10469 -5.24212,-2.80341 z\"\r\n" +
+	"     id=\"path4902\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"cccscscccscccccccccccccccssccccccccscccsccsssc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile23;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 817.01796,712.59884 c -1.15051,-0.45173 -4.73518,-3.29888 -7.96595,-6.327 -5.28492,-4.95342 -5.77109,-5.96255 -4.84686,-10.06045 1.45698,-6.46004 3.28177,-9.37725 9.84293,-15.7354 3.17285,-3.07467 5.76881,-6.30274 5.76881,-7.17348 0,-0.87075 -1.9823,-5.24309 -4.40511,-9.71633 -3.914,-7.22644 -4.33321,-9.10697 -3.7603,-16.86815 l 0.64481,-8.73499 -7.55341,-8.37273 c -6.1245,-6.78883 -7.598,-9.39726 -7.78911,-13.78858 -0.12964,-2.97872 -0.28874,-6.52505 -0.35355,-7.88074 -0.0861,-1.80089 1.59646,-2.84853 6.24611,-3.88908 8.49294,-1.90066 9.89949,-2.99396 9.89949,-7.69486 0,-2.19032 0.39178,-5.94129 0.87062,-8.3355 0.79092,-3.95457 1.58153,-4.50068 8.63594,-5.96517 7.59795,-1.57733 7.89959,-1.51565 13.99465,2.86149 5.58119,4.00811 7.13168,4.43674 14.90207,4.11961 l 8.67274,-0.35396 4.01682,7.94375 c 2.20925,4.36905 6.22216,9.62583 8.91758,11.68173 l 4.90075,3.73799 -3.55939,9.86746 -3.55939,9.86746 4.33783,6.75991 c 2.3858,3.71795 4.33782,7.21259 4.33782,7.76586 0,0.55327 -2.64068,4.75042 -5.86818,9.32701 l -5.86818,8.32107 6.03128,7.2037 6.03128,7.2037 -3.49753,5.28512 c -2.0145,3.04413 -6.67476,7.01439 -10.99066,9.3634 -7.1862,3.91122 -7.8421,4.01337 -16.01239,2.49395 -9.98044,-1.85606 -10.00081,-1.8447 -17.46116,9.7352 -5.44252,8.44782 -8.21561,9.84919 -14.56036,7.35801 z\"\r\n" +
+	"     id=\"path4904\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"csscssccscccscssscssccccssccccsscc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile28;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 782.34023,840.21974 c -0.77782,-0.2638 -3.82292,-0.88296 -6.76689,-1.3759 -4.90162,-0.82075 -5.73342,-1.66839 -9.87111,-10.05919 l -4.51844,-9.16292 3.21255,-8.95759 c 2.67408,-7.45621 3.05141,-10.53034 2.25119,-18.3409 l -0.96135,-9.38331 h 8.35415 8.35414 l 4.52126,-8.22417 4.52126,-8.22417 -7.56787,-13.34259 -7.56787,-13.34258 2.82422,-5.12157 2.82421,-5.12157 10.09477,2.62246 c 5.55212,1.44235 10.49732,2.63336 10.98932,2.64669 0.492,0.0133 3.58963,-4.11234 6.88362,-9.16815 4.88168,-7.49268 6.67747,-9.18838 9.71206,-9.17073 5.42031,0.0315 7.22998,1.63991 11.34576,10.08377 3.41604,7.0083 4.32286,7.80296 12.1762,10.67016 13.01809,4.75281 16.53756,4.35615 21.21321,-2.39081 2.15611,-3.11127 4.35454,-5.65686 4.8854,-5.65686 0.53086,0 4.18783,0.95703 8.12661,2.12672 5.24502,1.5576 9.79235,4.56255 16.993,11.22925 l 9.83159,9.10255 10.82398,-2.77285 10.82398,-2.77284 8.32839,4.20415 8.32837,4.20415 -2.02655,8.77216 -2.02657,8.77216 5.73585,5.84442 c 3.15473,3.21443 5.73587,6.36514 5.73587,7.00159 0,0.63644 -2.02789,3.71759 -4.50642,6.84698 l -4.50641,5.68981 -12.32754,-0.9306 -12.32755,-0.93061 -8.96504,7.81867 -8.96502,7.81868 H 887.67293 877.0193 l -7.38799,9.87244 -7.38799,9.87245 -6.71753,-0.87929 c -3.69464,-0.48361 -6.95884,-1.08398 -7.25378,-1.33416 -0.29494,-0.25019 -4.05261,2.06312 -8.35037,5.14068 -8.40346,6.01759 -8.01727,6.03993 -15.86211,-0.91754 -2.87826,-2.55267 -5.56884,-3.21629 -14.47281,-3.56961 l -10.93727,-0.43401 -6.08682,5.97376 c -5.82724,5.71901 -7.04683,6.34785 -10.2224,5.27082 z\"\r\n" +
+	"     id=\"path4906\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"ccsccscccccccccscscssssssccccccccsccccccccccscscscsc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile21;fill-opacity:1;stroke:#ffffff;stroke-width:1.20000005;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 517.46564,693.44927 c -6.77404,-1.30078 -7.83513,-2.03061 -11.13243,-7.65704 l -3.6447,-6.2192 4.38023,-8.06708 4.38024,-8.06707 -10.15112,-9.31073 -10.15111,-9.31074 3.11472,-7.49486 c 1.7131,-4.12218 3.11473,-8.36312 3.11473,-9.42432 0,-1.0612 -2.23147,-5.0355 -4.95882,-8.83178 l -4.95882,-6.90231 9.20146,-5.99499 c 5.0608,-3.29725 9.2079,-6.71574 9.21577,-7.59665 0.008,-0.88091 -1.36769,-5.53688 -3.05681,-10.34661 l -3.07114,-8.74496 6.94591,-9.21581 c 5.93884,-7.87965 7.9622,-9.52355 13.95551,-11.33833 5.47815,-1.6588 7.4865,-1.75416 9.19239,-0.43648 1.20053,0.92733 5.08752,4.07815 8.63776,7.00182 3.55023,2.92367 6.85916,5.31577 7.35316,5.31577 0.49401,0 3.84999,-4.13658 7.45775,-9.19239 l 6.55955,-9.19239 h 7.357 7.35701 l 4.7059,10.94373 c 4.15983,9.67384 4.60562,11.90319 3.84167,19.21219 -0.47532,4.54765 -1.23156,8.86282 -1.68052,9.58926 -0.44896,0.72644 -3.86441,2.86539 -7.58988,4.75323 -7.23971,3.66864 -8.52897,6.59493 -8.56704,19.445 -0.0176,5.95037 -1.17298,6.86043 -12.23834,9.63998 l -7.27304,1.82694 -2.98001,10.25603 c -1.63901,5.64081 -2.98001,11.02119 -2.98001,11.95639 0,0.9352 2.31967,4.86039 5.15483,8.72265 l 5.15483,7.02229 -1.82713,9.68347 c -1.00493,5.3259 -2.21091,10.23119 -2.67996,10.90064 -1.04758,1.49513 -17.69953,8.95547 -19.41445,8.69798 -0.68055,-0.10224 -4.60684,-0.83281 -8.72509,-1.62363 z\"\r\n" +
+	"     id=\"path4908\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"cccccccssccccccccccsccccscsscsccssccccc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile25;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 533.17345,756.77698 c -2.90784,-5.05581 -6.14255,-9.19239 -7.18823,-9.19239 -1.04568,0 -6.35594,0.73568 -11.80057,1.63484 l -9.89935,1.63484 -6.63653,-6.50089 -6.63653,-6.50089 -8.58063,0.79642 -8.58064,0.79641 -2.47899,-6.49114 -2.47899,-6.49115 4.41005,-6.40978 c 4.35104,-6.32402 4.51672,-6.40978 12.38242,-6.40978 7.93545,0 8.009,-0.0396 15.88441,-8.55006 l 7.91204,-8.55006 9.85705,1.59308 c 9.55218,1.54382 10.14014,1.46332 19.01039,-2.60276 l 9.15335,-4.19585 7.81721,5.19329 7.81722,5.19328 11.67149,-2.03678 11.67149,-2.03677 1.49416,-5.79227 c 0.82179,-3.18575 1.776,-6.77564 2.12048,-7.97754 0.36834,-1.2852 3.96056,-3.03267 8.72149,-4.24264 7.8776,-2.00207 8.16511,-2.24743 10.6966,-9.12844 1.43079,-3.88909 3.11216,-7.59201 3.73639,-8.22871 0.62424,-0.63671 4.2415,-0.2558 8.03835,0.84645 l 6.90337,2.00411 1.8454,11.17436 c 1.70791,10.34199 2.33041,11.66903 8.35674,17.81534 l 6.51135,6.64099 -1.7323,12.4509 c -0.95277,6.84799 -2.16772,12.62525 -2.69989,12.83835 -0.53218,0.2131 -4.436,2.54578 -8.67518,5.18373 l -7.70759,4.79627 -6.74109,-3.41596 c -3.70759,-1.87878 -7.57611,-3.41597 -8.59671,-3.41597 -1.02061,0 -5.97383,4.17516 -11.00717,9.27813 l -9.15154,9.27813 -9.27949,-0.90962 -9.27949,-0.90962 -7.61477,10.01627 -7.61476,10.01627 h -8.17201 -8.172 z\"\r\n" +
+	"     id=\"path4910\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"csscccccccsssccscccccscccscccscscsccsscccccccc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile24;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 404.79383,817.96876 c -2.7491,-3.2117 -4.99837,-6.44294 -4.99837,-7.18052 0,-0.73759 1.94497,-4.74052 4.32216,-8.8954 4.19827,-7.33782 4.27522,-7.79291 2.68463,-15.8777 -0.90063,-4.57784 -2.05011,-8.96825 -2.55438,-9.75645 -0.50428,-0.78821 -4.25795,-3.11828 -8.34149,-5.17793 -5.77938,-2.915 -7.42463,-4.47926 -7.42463,-7.05915 0,-1.82288 -0.34061,-6.10784 -0.75692,-9.52212 -0.66947,-5.49049 -0.25995,-6.74256 3.54407,-10.83586 l 4.30101,-4.62807 10.2445,5.56844 10.24451,5.56844 9.66088,-3.61828 c 9.17958,-3.43801 9.84891,-4.00311 13.43503,-11.34279 l 3.77415,-7.72452 7.07106,0.84419 c 3.88909,0.4643 8.80347,0.84972 10.92085,0.85648 3.27781,0.0105 4.31126,1.08506 6.956,7.23293 l 3.10624,7.22063 8.94143,-0.56935 c 8.90799,-0.56722 8.96314,-0.54722 14.74785,5.34696 l 5.80641,5.91631 -2.8157,6.36536 c -1.93017,4.36348 -5.30902,8.24783 -10.7437,12.35105 -4.3604,3.29214 -7.928,6.53328 -7.928,7.20254 0,0.66927 2.30584,4.82145 5.12408,9.22707 4.95951,7.75295 5.0934,8.31027 4.16868,17.35158 -0.52547,5.13774 -1.39942,9.75272 -1.9421,10.25551 -0.54268,0.50279 -4.25732,2.30094 -8.25475,3.99588 l -7.26805,3.08173 -6.71406,-7.17787 c -3.69272,-3.94782 -7.11519,-7.17786 -7.60548,-7.17786 -0.49028,0 -4.29076,2.97365 -8.4455,6.60812 -5.90714,5.16742 -8.06014,6.30577 -9.87521,5.22126 -1.27662,-0.76277 -4.58338,-2.75696 -7.34836,-4.43153 l -5.02724,-3.04467 -8.00562,4.82253 -8.00562,4.82252 z\"\r\n" +
+	"     id=\"path4912\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"sscccsssccccsscccccsccscssssccssscscccs\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile26;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 538.9593,833.74909 -7.17513,-7.17513 -10.76943,3.12919 -10.76944,3.1292 -9.26298,-7.51547 c -9.88971,-8.02397 -9.96455,-8.21767 -7.90374,-20.45664 1.12495,-6.68095 0.89592,-7.54146 -4.15139,-15.59728 l -5.34203,-8.52622 7.50415,-5.43828 c 5.41363,-3.92327 8.31121,-7.31435 10.40119,-12.17263 3.08608,-7.17378 3.84289,-7.59799 16.49809,-9.2476 l 7.17736,-0.93557 4.90826,8.28112 4.90825,8.28112 11.24891,0.33529 11.2489,0.3353 7.71391,-9.98436 c 7.49509,-9.70112 7.85614,-9.95923 12.72792,-9.09872 9.23349,1.63091 9.69898,2.04631 12.8273,11.44691 l 3.05044,9.16659 -5.23114,5.79026 -5.23115,5.79025 0.93668,11.49402 0.93667,11.49401 -9.60015,4.94975 -9.60015,4.94975 -2.82394,10.9685 c -1.76096,6.83975 -3.63262,11.18504 -4.97227,11.54377 -10.56331,2.82864 -11.80863,2.50933 -19.25509,-4.93713 z\"\r\n" +
+	"     id=\"path4914\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"scccssscssscccccscscccccccsss\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:\r\n" +
+	"*tile27;fill-opacity:1;stroke:#ffffff;stroke-width:1.5;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5339)\"\r\n" +
+	"     d=\"m 474.93122,910.12344 c -4.95547,-1.43194 -9.09204,-2.7917 -9.19238,-3.02168 -0.10035,-0.22998 -2.09163,-4.1943 -4.42509,-8.80959 -3.96826,-7.84876 -4.7914,-8.59859 -12.72792,-11.5943 -10.59918,-4.00076 -16.26472,-4.1271 -23.33452,-0.52035 -7.39493,3.77261 -8.58177,3.61553 -13.86472,-1.83509 -5.31095,-5.47952 -5.40977,-6.4269 -1.69092,-16.2113 3.75869,-9.88921 3.5955,-11.4019 -1.93539,-17.93976 l -4.72103,-5.58055 3.68141,-8.14348 c 3.18665,-7.04906 4.73154,-8.71072 11.49538,-12.36424 l 7.81397,-4.22076 6.67754,4.15621 c 3.67265,2.28592 7.45306,4.15621 8.40091,4.15621 0.94785,0 4.78983,-3.02035 8.53773,-6.7119 l 6.81437,-6.7119 6.87044,7.77256 6.87045,7.77256 9.45738,-4.63853 9.45738,-4.63854 8.01908,6.79424 c 4.4105,3.73684 9.03545,7.57141 10.27767,8.52129 1.81411,1.38717 4.26085,1.12509 12.43317,-1.33179 l 10.17458,-3.05883 6.13853,6.02451 c 4.51148,4.42768 5.95572,6.83321 5.44879,9.07558 -3.08204,13.63371 -2.86836,13.31608 -10.67051,15.86118 -4.06911,1.32735 -8.10866,2.97278 -8.97679,3.65649 -0.86814,0.68371 -2.74362,6.98281 -4.16775,13.998 -2.16251,10.65247 -3.06788,12.84673 -5.49264,13.31205 -1.59683,0.30643 -5.76711,1.08257 -9.26729,1.72477 -5.93214,1.08839 -6.65184,1.71911 -10.6066,9.29532 -2.45421,4.70157 -5.13686,8.06182 -6.36396,7.97142 -1.16673,-0.0859 -6.1758,-1.32785 -11.13127,-2.7598 z\"\r\n" +
+	"     id=\"path4916\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"cccsscsscsscssccccccscscsccccccscc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:#323232;fill-opacity:1;stroke:#ffffff;stroke-width:4;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5373)\"\r\n" +
+	"     d=\"m 349.36615,703.63291 -8.71003,-5.74228 -9.82723,2.92667 c -5.40497,1.60967 -10.43788,2.92667 -11.18425,2.92667 -0.74636,0 -2.41837,-3.34108 -3.71556,-7.42462 -2.22317,-6.99852 -2.91805,-7.72837 -12.10785,-12.71717 l -9.74932,-5.29254 -7.09121,5.64609 c -3.90016,3.10536 -7.46823,5.6461 -7.92904,5.6461 -0.46082,0 -2.76536,-3.81837 -5.1212,-8.48528 l -4.28336,-8.48528 -8.81848,-3e-5 -8.81847,-2e-5 -4.45202,-10.96013 -4.45201,-10.96013 -6.15459,-0.37295 c -11.85789,-0.71857 -11.97489,-0.81338 -16.02996,-12.98964 -3.71793,-11.16393 -3.72797,-11.30784 -1.37398,-19.69738 2.30694,-8.22186 2.28181,-8.82252 -0.92563,-22.12714 l -3.29693,-13.67585 -7.97411,-4.02532 -7.97411,-4.02532 -1.69466,-8.91935 c -0.93207,-4.90565 -1.99629,-9.96001 -2.36494,-11.23192 -0.43354,-1.49577 -4.20565,-3.22952 -10.67981,-4.9087 -10.94918,-2.83985 -11.52571,-3.49868 -13.2761,-15.17113 -0.91129,-6.0769 -1.67401,-7.06828 -10.01979,-13.0237 -8.96013,-6.39381 -9.03342,-6.5014 -9.03342,-13.26069 0,-6.77597 0.03,-6.81954 5.3033,-7.69116 2.91682,-0.48211 7.30768,-0.88358 9.75747,-0.89214 4.05872,-0.0142 4.6799,-0.64334 6.99688,-7.08663 2.49956,-6.95105 2.643,-7.07107 8.45053,-7.07107 3.2493,0 7.73746,-0.36558 9.97368,-0.81239 3.84444,-0.76815 4.31965,-0.29754 8.72578,8.64127 l 4.65991,9.45366 9.84864,-0.5565 9.84863,-0.5565 2.30036,8.19841 2.30035,8.19841 8.71918,-0.8933 8.71918,-0.89331 1.656,7.17418 c 1.50436,6.51725 1.33195,8.03513 -1.88291,16.57622 l -3.53892,9.40203 7.19876,8.69255 7.19876,8.69255 -0.90321,10.15333 -0.9032,10.15333 10.88492,6.37179 10.88493,6.37178 9.78332,-3.62633 9.78332,-3.62634 11.99705,4.63814 11.99705,4.63815 9.95757,-3.90672 9.95757,-3.90672 10.6722,3.89236 10.6722,3.89237 -0.97356,11.68734 c -0.79115,9.49768 -0.58656,11.91135 1.09199,12.88301 1.13604,0.65764 4.64029,3.53715 7.7872,6.39894 l 5.72166,5.20323 -2.13865,13.51791 -2.13868,13.51792 6.47991,7.58482 6.47992,7.58483 -3.04874,10.46356 c -1.6768,5.75498 -3.74145,11.15596 -4.58812,12.00219 -1.43773,1.437 -16.92609,7.66924 -18.98933,7.64097 -0.51415,-0.007 -4.85433,-2.59683 -9.64484,-5.7551 z\"\r\n" +
+	"     id=\"path4918\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"ccssssccscccccccssscccccsssscccscscccccccssccccccccccccccccccccccccccc\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:#323232;fill-opacity:1;stroke:#ffffff;stroke-width:4;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5373)\"\r\n" +
+	"     d=\"m 636.27336,514.58909 c -5.35042,-4.67915 -6.43764,-6.70558 -9.28821,-17.31188 l -3.22848,-12.0124 -5.56103,-0.98758 c -3.05857,-0.54316 -9.92749,-1.02425 -15.26427,-1.06907 -9.24993,-0.0777 -10.02863,-0.35395 -16.66888,-5.91348 l -6.96566,-5.83198 -13.56516,1.79542 c -11.48079,1.51954 -13.74882,1.49212 -14.76044,-0.17843 -0.65741,-1.08562 -2.6612,-4.51943 -4.45288,-7.6307 -2.90931,-5.05209 -4.04425,-5.79102 -10.61588,-6.91182 -7.04908,-1.20221 -7.39319,-1.46966 -8.18836,-6.36396 -0.45653,-2.80995 -1.15238,-6.84294 -1.54633,-8.96221 -0.65082,-3.50106 -0.006,-4.20155 7.0554,-7.66479 l 7.77167,-3.81159 0.99632,-8.59866 c 1.43911,-12.42017 2.10341,-13.47492 10.61667,-16.85678 6.90624,-2.74347 8.17078,-3.93451 12.25493,-11.54264 2.50474,-4.66593 4.77458,-9.08818 5.0441,-9.82722 0.2981,-0.81742 3.63932,-0.63893 8.53071,0.45571 7.57361,1.6949 8.48414,1.5964 15.67499,-1.69568 8.00172,-3.66331 8.18966,-3.95576 10.70385,-16.65523 l 1.569,-7.92519 8.59939,-2.60369 8.59939,-2.60369 6.54602,3.45782 c 6.49689,3.43187 6.55132,3.52149 7.25312,11.94309 l 0.70711,8.48529 10.10897,2.63838 c 11.20066,2.92332 11.81134,3.81568 11.81134,17.25937 0,6.05253 0.082,6.15251 8.83883,10.78338 l 8.83884,4.6742 -0.51776,11.12077 -0.51774,11.12077 12.12386,12.21376 12.12387,12.21375 -4.53504,6.20563 c -4.01682,5.49649 -5.9805,6.70854 -17.18411,10.6066 l -12.64904,4.40098 -3.21278,12.02081 c -3.0327,11.34704 -3.67126,12.43505 -11.39258,19.41113 -4.4989,4.06466 -8.49801,7.40401 -8.88692,7.42079 -0.3889,0.0168 -3.43398,-2.35427 -6.76683,-5.26898 z\"\r\n" +
+	"     id=\"path4920\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"sscccscssccscscsssccsscccssccscccccccscsccs\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:#00aad4;fill-opacity:1;stroke:#ffffff;stroke-width:4;stroke-linejoin:round;stroke-miterlimit:4;stroke-dasharray:none;stroke-dashoffset:0;stroke-opacity:1;filter:url(#filter5397)\"\r\n" +
+	"     d=\"m 414.72428,500.64502 c -7.04277,-5.37755 -7.85648,-5.41792 -22.31737,-1.10711 -1.781,0.53092 -3.79531,-1.39356 -7.46807,-7.13501 -5.50715,-8.60906 -5.68501,-8.66598 -18.48795,-5.91704 l -8.59584,1.84562 -5.13676,-7.35066 c -5.07272,-7.25903 -5.24528,-7.36051 -13.84159,-8.14 -4.78766,-0.43413 -9.21872,-1.32029 -9.84681,-1.96926 -0.62808,-0.64896 -1.94464,-5.10919 -2.92567,-9.91161 l -1.78371,-8.73167 4.85701,-5.64431 4.85701,-5.64431 10.94437,-0.1197 10.94438,-0.11971 5.53644,-11.01145 c 5.43844,-10.81651 5.66297,-11.04387 12.6837,-12.84323 6.86668,-1.75988 7.42565,-1.67529 14.23904,2.1547 5.97347,3.35785 7.64912,5.2716 10.62618,12.13612 l 3.5344,8.14965 h 11.33345 11.33346 l 6.3259,8.05984 6.32589,8.05985 -3.03727,5.02163 c -1.6705,2.76189 -3.90848,6.39832 -4.97328,8.08094 -1.73002,2.73381 -1.4393,4.01282 2.7323,12.02082 2.56758,4.92882 4.85685,9.35429 5.08728,9.83436 0.23043,0.48008 -3.34277,4.13171 -7.94044,8.11475 l -8.35941,7.24188 z\"\r\n" +
+	"     id=\"path4922\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"sssscssscccccccsssccccccsccccs\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:#00aad4;fill-opacity:1;stroke:#ffffff;stroke-width:4;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5397)\"\r\n" +
+	"     d=\"m 560.2109,694.70514 c -2.3411,-1.64009 -5.61293,-3.96122 -7.27074,-5.15805 l -3.01419,-2.17607 2.61692,-11.25739 2.61692,-11.25739 -5.64858,-7.32162 -5.64859,-7.32161 2.56618,-9.58018 2.56617,-9.58019 8.48749,-2.19165 c 11.5208,-2.97492 12.01861,-3.44259 12.01861,-11.29073 0,-12.92307 0.93691,-14.82636 9.32306,-18.93932 6.49053,-3.18325 7.64986,-4.34138 7.66307,-7.65514 0.009,-2.14744 0.41002,-6.29093 0.89214,-9.20775 0.86752,-5.24846 0.94413,-5.30264 7.40906,-5.23962 6.03655,0.0588 6.99973,0.59807 12.68721,7.10284 l 6.15473,7.03916 -5.38551,6.57231 -5.38552,6.57231 4.59297,8.42228 c 5.19236,9.52141 5.62778,8.03732 -5.98648,20.40432 l -5.6088,5.97231 6.59687,9.58404 6.59688,9.58403 -3.00384,8.13095 c -2.78305,7.53333 -3.43094,8.24115 -8.81468,9.63012 -3.19597,0.82454 -6.88574,1.81005 -8.1995,2.19003 -1.5486,0.4479 -2.99549,3.40245 -4.11414,8.40111 -0.94901,4.24063 -2.4197,7.73291 -3.2682,7.76061 -0.84849,0.0277 -5.06177,0.8923 -9.36284,1.92132 -7.31872,1.75099 -8.09303,1.67976 -12.07667,-1.11103 z\"\r\n" +
+	"     id=\"path4924\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"sccccccccssccscscccssccccscssss\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:#00aad4;fill-opacity:1;stroke:#ffffff;stroke-width:4;stroke-miterlimit:4;stroke-dasharray:none;filter:url(#filter5397)\"\r\n" +
+	"     d=\"m 809.53136,568.92886 c -3.66207,-9.8474 -4.20702,-10.52953 -10.92652,-13.67677 l -7.07222,-3.31245 v -9.65776 l -0.35355,-8.24354 12.15624,-0.35355 c 8.81291,-0.25632 8.06377,0.22745 13.82433,-3.83297 l 6.97139,-6.30784 11.98507,5.94398 11.98506,5.94398 v 7.08138 c 0,3.89475 0.0475,7.54176 0.1055,8.10445 0.058,0.56269 -3.91945,2.3638 -8.83883,4.00247 l -8.94434,2.97941 -0.70711,9.76842 -0.7071,9.76842 -5.65686,0.99685 c -9.81982,1.73043 -9.7331,1.78818 -13.82106,-9.20448 z\"\r\n" +
+	"     id=\"path4926\"\r\n" +
+	"     inkscape:connector-curvature=\"0\"\r\n" +
+	"     sodipodi:nodetypes=\"sscccsccccsssccccs\" />\r\n" +
+	"  <g\r\n" +
+	"     inkscape:groupmode=\"layer\"\r\n" +
+	"     id=\"layer2\"\r\n" +
+	"     inkscape:label=\"background\"\r\n" +
+	"     style=\"display:inline\"\r\n" +
+	"     transform=\"translate(0,-59)\">\r\n" +
+	"    <path\r\n" +
+	"       style=\"fill:#ffeeaa;fill-opacity:1;stroke:none;stroke-width:0.1;stroke-miterlimit:4;stroke-dasharray:none\"\r\n" +
+	"       d=\"M 0.85899918,528.19113 V 59.190995 H 491.85933 1001.859 V 528.19113 1029.191 H 491.85933 0.85899918 Z m 495.37423082,442.5 c 4.11432,-7.48282 5.8104,-8.72014 14.1732,-10.33956 9.23096,-1.78754 9.5838,-2.12304 12.47626,-11.863 1.63716,-5.5129 2.97664,-11.5851 2.97664,-13.49378 0,-2.3052 2.8538,-4.4211 8.5,-6.30218 7.28536,-2.4272 8.96356,-4.08518 11.74394,-11.60258 3.40484,-9.20572 5.32554,-10.94144 14.15624,-12.79282 4.60056,-0.96454 5.88966,-2.87664 8.7058,-12.91328 3.05718,-10.89568 4.05458,-12.18874 13.27126,-17.2051 l 9.96568,-5.42398 -0.65022,-11.28764 c -0.56172,-9.75172 0.0872,-12.2544 4.76912,-18.39272 l 5.41932,-7.10508 -3.3429,-10.45348 -3.34288,-10.45348 7.28508,-7.2851 7.28508,-7.28508 7.79556,3.06218 c 7.6335,2.99852 8.01824,2.93142 18.5104,-3.22894 11.03228,-6.47748 11.75076,-7.71686 14.80496,-25.53866 1.1589,-6.7624 2.0712,-7.63088 10.52394,-10.01826 7.80776,-2.20522 9.61434,-3.68112 11.55272,-9.43814 2.5067,-7.44498 6.43788,-9.78318 16.81084,-9.99874 8.59658,-0.1786 20.72174,7.02948 19.45476,11.56542 -3.78968,13.56754 -3.5961,16.24952 1.30736,18.1138 2.66168,1.01198 8.97388,2.45996 14.0271,3.21774 l 9.18768,1.37778 1.39688,-7.70766 c 1.64586,-9.08156 5.37242,-16.98142 9.70786,-20.5795 2.55528,-2.1207 4.51214,-2.01922 10.98184,0.56944 l 7.8725,3.14994 9.89892,-7.32402 c 8.4492,-6.25138 10.23688,-8.78808 12.20644,-17.32066 l 2.3075,-9.99664 -8.16566,-7.10312 c -7.77942,-6.76714 -8.05914,-7.40336 -5.9136,-13.45142 1.88988,-5.32746 3.57832,-6.5724 10.4996,-7.74176 7.69088,-1.29936 8.69536,-0.9048 14.88136,5.84528 5.81152,6.34148 6.48368,8.17728 5.42292,14.81096 -0.91058,5.69436 -0.16,9.35316 3.02622,14.75354 l 4.23708,7.18132 -6.8091,8.14996 c -3.745,4.48246 -7.43046,11.4621 -8.1899,15.51028 -1.21716,6.48808 -0.6312,8.13374 4.9445,13.8864 6.2639,6.46272 6.291,6.57844 2.79136,11.91956 -2.8901,4.41084 -4.56366,5.17844 -9.18576,4.21314 -18.32004,-3.82608 -16.63174,-4.21676 -22.1789,5.13238 l -5.15446,8.68726 7.71402,14.01658 c 7.40034,13.44664 7.57686,14.19462 4.34078,18.39472 -2.4082,3.1256 -5.948,4.55128 -12.37324,4.9834 l -9,0.6053 0.65346,12.53132 c 0.43312,8.30612 -0.4767,15.49056 -2.69836,21.30792 -3.30308,8.64896 -3.27372,8.95058 2.01804,20.7422 l 5.36984,11.96562 11.8967,2.34052 c 11.7764,2.31688 11.97474,2.27872 19.6163,-3.77352 9.82738,-7.78342 17.02634,-7.82732 27.44238,-0.1672 l 8.08636,5.94678 9.11726,-5.782 c 7.41212,-4.70062 10.60906,-5.58192 17.09368,-4.71214 7.70058,1.03286 8.24066,0.71592 15.6173,-9.16478 7.50854,-10.0574 7.82386,-10.23464 18.20818,-10.23464 9.3841,0 11.48812,-0.83188 18.79074,-7.42936 7.9558,-7.18758 8.65818,-7.4116 21.58336,-6.88392 l 13.35996,0.54544 6.4224,-8.94304 c 3.53232,-4.91866 6.4224,-10.10306 6.4224,-11.52086 0,-1.4178 -2.69184,-5.3551 -5.98186,-8.74954 -5.43242,-5.60484 -5.79982,-6.8064 -4,-13.082 3.09328,-10.78564 2.69272,-11.526 -9.51814,-17.5921 -10.74614,-5.33846 -12.15552,-5.56354 -21.5,-3.43348 l -10,2.27948 -9.5756,-8.4688 c -7.01496,-6.20412 -12.54246,-9.13274 -20.67034,-10.95168 l -11.09474,-2.4829 -4.8691,6.22154 -4.86908,6.22156 -8.85572,-3.01746 c -7.19788,-2.45256 -9.27768,-4.29596 -11.10968,-9.847 -1.9684,-5.96432 -1.69874,-7.6093 2.12844,-12.9841 3.9572,-5.55736 5.11562,-6.03066 11.9388,-4.8779 10.13528,1.71234 25.91716,-6.18474 32.90196,-16.4638 6.32368,-9.30612 6.3081,-10.0342 -0.36384,-16.9982 -4.9378,-5.15394 -5.18148,-6.08536 -2.64484,-10.10944 9.44044,-14.97614 9.34938,-14.39554 3.46744,-22.10716 -5.34692,-7.01016 -5.38204,-7.24846 -2.2299,-15.12646 2.58446,-6.4592 4.87296,-8.64202 11.85664,-11.3091 6.63708,-2.53472 9.70948,-5.29458 13.18264,-11.84154 4.37538,-8.24768 4.9944,-8.61978 18.29288,-10.99636 13.32802,-2.38184 13.892,-2.72278 17.79564,-10.75764 2.92392,-6.01832 6.1861,-9.21802 11.8749,-11.64748 9.0738,-3.87506 9.35594,-5.4604 3.14054,-17.6467 -4.40342,-8.6336 -4.99144,-9.02552 -14.44366,-9.62692 -12.6278,-0.80342 -19.49034,-6.43734 -16.71924,-13.7259 3.73632,-9.82726 2.24036,-15.65482 -5.89302,-22.9563 l -7.75888,-6.96528 1.94988,-11.31768 c 1.72174,-9.99342 1.46708,-12.0989 -2.17654,-17.99438 -7.7505,-12.54058 -10.0764,-13.6779 -21.1541,-10.344 -13.38726,4.02896 -14.20092,3.85974 -19.0327,-3.95824 l -4.25744,-6.8887 7.5252,-11.33376 7.5252,-11.33376 -5.09038,-9.5462 -5.09038,-9.5462 3.80362,-9.6833 c 2.092,-5.32582 3.06378,-9.95914 2.15952,-10.29626 -18.42,-6.86744 -23.71472,-9.955 -25.42804,-14.82812 -2.81416,-8.00416 2.65188,-15.06056 13.85054,-17.8804 4.95076,-1.24662 9.42634,-2.9542 9.94576,-3.79464 0.51942,-0.84044 -0.0828,-6.6431 -1.33838,-12.8948 -2.00538,-9.98552 -3.37436,-12.20026 -11.26598,-18.22596 -4.94078,-3.77256 -10.0814,-6.8592 -11.42362,-6.8592 -1.34222,0 -4.96588,3.64426 -8.05258,8.09838 -5.1801,7.47488 -6.23536,8.0523 -13.7067,7.5 -6.65252,-0.49178 -8.90784,-1.80904 -12.65984,-7.39414 -5.13652,-7.64612 -6.00356,-19.18726 -1.8976,-25.25922 5.63036,-8.32632 5.36688,-10.6605 -1.98648,-17.59798 -7.00572,-6.6095 -7.10054,-6.90338 -4.52508,-14.02568 2.42452,-6.70492 2.26388,-7.77074 -2.05098,-13.6069 -4.9775,-6.7324 -5.14014,-5.72392 3.01838,-18.71446 2.11244,-3.36354 1.76358,-5.67068 -2.1925,-14.5 -4.5332,-10.11742 -4.9944,-10.5 -12.6571,-10.5 -7.72174,0 -8.0449,-0.2783 -11.13866,-9.59274 -2.53494,-7.63202 -2.77624,-11.7544 -1.18054,-20.1685 2.28376,-12.04222 1.47912,-14.25106 -8.79056,-24.13109 -10.31416,-9.92282 -9.8019,-16.1525 1.83584,-22.32631 4.95,-2.62597 9,-5.93689 9,-7.3576 0,-1.42071 -1.81672,-6.14418 -4.03716,-10.4966 l -4.03716,-7.91348 5.11908,-10.10201 c 4.7954,-9.46328 4.97458,-10.83648 2.8338,-21.71784 -2.73622,-13.90801 -2.927,-14.21981 -12.11462,-19.79941 -8.57872,-5.2098 -10.0013,-5.29621 -23.10916,-1.40359 -9.79524,2.90887 -10.1479,3.27879 -12.87022,13.5 -2.48864,9.34382 -3.44604,10.58301 -8.63396,11.17503 -4.84622,0.55304 -7.40842,-1.04565 -15.1208,-9.43462 -8.83652,-9.61172 -9.6668,-10.04103 -17.15784,-8.87184 -14.10178,2.20098 -16.26642,3.78535 -19.82992,14.514 -2.24876,6.77042 -4.47022,10.1303 -6.70278,10.13775 -1.8366,0.006 -6.6501,0.93073 -10.69668,2.05464 -6.54472,1.81777 -7.73432,3.19727 -10.76964,12.48885 -1.87672,5.74495 -3.58984,10.66933 -3.80696,10.94307 -0.2171,0.27373 -4.49152,1.26306 -9.4987,2.19851 l -9.10398,1.70081 -5.73166,-9.56472 c -5.18012,-8.64438 -6.605,-9.68627 -14.80818,-10.82795 -8.73184,-1.21526 -9.20966,-1.63807 -12.58224,-11.13379 -3.81392,-10.73836 -6.02156,-11.68995 -15.6131,-6.72997 -5.18596,2.68176 -7.49734,2.77561 -15.82078,0.64238 l -9.74754,-2.49822 -9.8015,8.85762 c -9.25468,8.36343 -9.74842,9.31485 -8.84984,17.05284 0.76454,6.58364 -0.129,9.82833 -4.54416,16.5 -5.19014,7.84274 -6.03544,8.3047 -15.19638,8.3047 -9.56918,0 -9.76518,0.12864 -14.47464,9.5 -3.37132,6.70862 -4.27484,10.67532 -3.07498,13.5 0.93452,2.2 3.53396,9.04565 5.77656,15.21256 3.4433,9.46874 5.27484,11.7525 11.77666,14.6844 4.81204,2.16994 9.2068,6.21518 11.71952,10.78746 5.51256,10.03096 2.37548,19.5108 -8.48622,25.6443 l -7.66564,4.32872 -7.1696,-5.7503 c -6.6759,-5.35436 -7.74724,-5.60318 -15.5587,-3.61358 -7.25694,1.84834 -9.14638,1.6061 -14,-1.79486 -13.45758,-9.4298 -13.7066,-9.41074 -20.46022,1.5653 -7.75374,12.60142 -13.62818,15.681 -20.86594,10.93864 -4.8774,-3.1958 -9.24752,-13.32878 -9.27356,-21.50264 -0.02,-6.05516 -7.36596,-11.5 -15.51684,-11.5 -7.01708,0 -8.00008,0.7909 -15.10016,12.14936 -6.6138,10.58052 -7.44344,13.22764 -6.42492,20.5 1.11536,7.96362 0.80502,8.60476 -6.69602,13.83384 -7.66068,5.34036 -8.08582,5.40548 -16.32084,2.5 -11.28784,-3.98258 -15.20684,-3.7702 -23.35506,1.26568 -6.5624,4.05578 -7.24464,4.1036 -15.012,1.0525 -4.47546,-1.758 -8.92587,-2.70894 -9.88983,-2.11318 -0.96394,0.59576 -3.56358,4.63294 -5.77696,8.9715 -3.97152,7.78484 -4.09846,7.84998 -9.67668,4.96536 -4.64442,-2.40172 -6.10384,-5.05034 -8.18404,-14.8527 -2.78034,-13.10154 -4.25386,-14.19492 -19.1978,-14.24506 -6.46426,-0.0216 -9.85692,1.44232 -16.47994,7.11138 l -8.33994,7.13868 -8.52006,-5.97554 c -4.68604,-3.28652 -12.26974,-6.56532 -16.85268,-7.28618 -8.28154,-1.30264 -8.34128,-1.37368 -9.74652,-11.59102 -1.06572,-7.7488 -2.8828,-11.6525 -7.3789,-15.85248 -3.28074,-3.06468 -6.65356,-5.57214 -7.49514,-5.57214 -0.84158,0 -2.14496,-5.43418 -2.8964,-12.07594 -1.29156,-11.41588 -1.03236,-12.4975 4.74168,-19.78782 7.04494,-8.89496 7.24028,-10.62538 2.88908,-25.59293 -3.02166,-10.3942 -3.8217,-11.32703 -13.06296,-15.23136 -8.84802,-3.73817 -10.10363,-5.07751 -12.4081,-13.23549 -2.21121,-7.82784 -3.84762,-9.72194 -11.89538,-13.76858 -8.24921,-4.14793 -9.71668,-5.91417 -12.6529,-15.22891 -3.28314,-10.41534 -3.47086,-10.58955 -16.28535,-15.11345 L 121.3719,106.58 l -6.68119,7.80556 c -3.67465,4.29307 -6.71498,8.48057 -6.75628,9.30557 -0.0413,0.825 -5.14382,1.5 -11.338941,1.5 -10.32391,0 -11.82569,0.59573 -17.99678,7.13899 -9.697688,10.28254 -10.450926,15.6162 -3.860974,27.33943 l 5.490504,9.76735 -5.395036,8.15245 c -5.049654,7.63054 -5.25815,8.807 -3.25684,18.37711 l 2.138192,10.22467 -7.92761,7.05454 c -7.423314,6.60578 -7.925662,7.7508 -7.896984,18 0.03814,13.63384 1.155,15.3838 11.785018,18.46542 7.814786,2.26548 8.84169,3.3452 11.86964,12.48004 l 3.31472,10 12.890163,2 c 11.423388,1.77242 13.311448,2.72178 16.592428,8.34302 3.42929,5.87536 3.47425,6.88874 0.60983,13.74426 -5.07967,12.15736 -4.29982,13.94702 7.23789,16.61024 16.70545,3.85608 23.22018,8.93934 24.77901,19.33434 1.5781,10.52352 2.29844,11.18874 18.41112,17.00188 11.3701,4.1021 13.0239,5.4507 18,14.67818 5.28026,9.79148 5.91162,10.24694 17.35846,12.52228 11.35058,2.25618 14.46502,4.39822 19.36712,13.32018 1.53092,2.78634 0.66816,4.83912 -3.9927,9.5 -7.81322,7.81322 -15.38104,7.7111 -30.62384,-0.41324 l -11.93044,-6.35884 -12.64341,4.11066 -12.6434,4.11068 -9.20612,-5.9885 c -8.83838,-5.7493 -9.20611,-6.3733 -9.20611,-15.62162 0,-14.63566 -2.36466,-18.6542 -14.33275,-24.35736 -5.86699,-2.7958 -11.68051,-4.51016 -12.91893,-3.80972 -1.23843,0.70046 -4.772028,4.29196 -7.852451,7.98112 -5.34714,6.40384 -5.50361,7.17364 -3.45539,17 1.82118,8.7372 1.65116,10.8493 -1.12519,13.97768 -5.94839,6.70266 -9.81296,7.67724 -16.575604,4.18014 l -6.296434,-3.256 -8.944616,8.94462 -8.944616,8.9446 2.327484,13.7989 c 2.098342,12.44038 1.940522,14.6632 -1.603056,22.57804 -4.210628,9.40474 -3.97496,11.03806 3.788318,26.25534 3.38056,6.62644 3.902516,9.91454 2.63004,16.56812 -1.802576,9.4254 -1.530388,14.29292 1.558502,27.87064 1.848992,8.12756 3.311332,10.1483 9.70397,13.40958 8.046706,4.10514 9.96954,8.14852 10.013408,21.05652 0.018,5.31024 -1.668544,10.5314 -4.97269,15.39388 -2.75,4.047 -5,8.17088 -5,9.1642 0,0.99332 2.77149,5.63196 6.158866,10.3081 l 6.158868,8.50206 -4.403474,4.68728 c -3.362456,3.57918 -4.077218,6.01372 -3.023422,10.29802 2.740848,11.14316 6.280974,14.21942 18.056676,15.6907 l 11.052491,1.38092 v 7.65152 c 0,8.65482 0.91916,9.5161 13.61119,12.75386 7.42175,1.8933 9.47405,3.42564 11.02281,8.23008 2.97483,9.22834 5.21975,10.90364 14.61089,10.90364 h 8.75511 l 0.0248,8.5 c 0.0215,7.39496 0.87665,9.05142 6.5775,12.74144 5.92836,3.83728 6.47911,5.0137 5.77987,12.34592 -0.71414,7.48846 -0.17728,8.53596 7.06312,13.78086 l 7.836,5.67638 -3.67908,5.9777 c -2.6394,4.28844 -3.52692,9.0606 -3.14063,16.88708 l 0.53846,10.90936 9.62498,2.98058 c 9.24864,2.86404 9.96914,2.79258 18.42624,-1.8273 l 8.80124,-4.80788 4.987,4.68504 c 4.67534,4.39226 4.80494,5.03712 2.07378,10.31862 -3.43324,6.63912 -3.67766,13.9993 -0.80808,24.3322 l 2.08286,7.5 h 11.59602 c 9.1612,0 12.37734,0.86338 15.3172,4.11188 2.80418,3.09858 3.21392,4.94682 1.6627,7.5 -9.48706,15.61488 -9.81168,16.50296 -6.74512,18.4536 1.63964,1.04296 6.84076,3.39038 11.55802,5.21648 12.76388,4.941 28.72196,1.04446 38.37846,-9.37102 6.00504,-6.47702 8.68914,-7.79646 18.1874,-8.94048 10.16221,-1.22398 11.55645,-2.01156 15.84499,-8.95056 3.62068,-5.85838 6.34812,-7.88078 11.8573,-8.7922 11.76722,-1.9467 13.7103,-1.49594 20.9491,4.8598 3.81332,3.34814 10.81962,7.2092 15.5696,8.58014 8.1148,2.34212 8.78408,3.09166 11.08374,12.4125 2.39264,9.69774 8.25576,16.91986 13.73602,16.91986 2.4743,0 3.92056,3.38792 5.12272,12 0.23032,1.65 -1.71288,7.25274 -4.3182,12.45052 l -4.73696,9.45052 6.1384,7.63844 6.13842,7.63842 -3.68008,6.91106 c -4.80674,9.02682 -4.65832,11.7439 1.02084,18.6889 l 4.72476,5.77784 -3.71722,10.80434 -3.71724,10.80432 7.15328,7.91782 c 7.9156,8.76164 11.27014,9.48288 21.47396,4.61702 6.0159,-2.86878 8.13386,-2.96146 16.18208,-0.7081 8.05092,2.25412 9.88366,3.82298 14.03412,12.01354 4.2747,8.4357 5.88356,9.7477 15.38578,12.54676 16.78114,4.9432 18.71424,4.5361 23.98578,-5.0514 z\"\r\n" +
+	"       id=\"path4942\"\r\n" +
+	"       inkscape:connector-curvature=\"0\"\r\n" +
+	"       sodipodi:nodetypes=\"ccccccccccssscccscccccccccssssccscscccsccscscccccccscscccssccccsccccccccsssccsssssccsccccscssssscscccsscssccccccccccccccscssccccsccccscssssscscssscssccccccccsssscccssccccsscccssscsscsssccccscscccccscsccsscscssssscsscsscscscssssscccccccsssccccccccssssscccssccsscsscsccssccsscsscccccsscccssscscsscscccccccccsscscc\" />\r\n" +
+	"    <flowRoot\r\n" +
+	"       xml:space=\"preserve\"\r\n" +
+	"       id=\"flowRoot1819\"\r\n" +
+	"       style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#000000;fill-opacity:1;stroke:none\"><flowRegion\r\n" +
+	"         id=\"flowRegion1821\"><rect\r\n" +
+	"           id=\"rect1823\"\r\n" +
+	"           width=\"465.27628\"\r\n" +
+	"           height=\"838.62866\"\r\n" +
+	"           x=\"991.36371\"\r\n" +
+	"           y=\"89.204834\" /></flowRegion><flowPara\r\n" +
+	"         id=\"flowPara1825\" /></flowRoot>  </g>\r\n" +
+	"  <g\r\n" +
+	"     id=\"g5496\"\r\n" +
+	"     transform=\"translate(-10.971001,-34.922005)\">\r\n" +
+	"    <circle\r\n" +
+	"       r=\"15.372373\"\r\n" +
+	"       cy=\"472.10315\"\r\n" +
+	"       cx=\"508.05621\"\r\n" +
+	"       id=\"path5490\"\r\n" +
+	"       style=\"fill:#ffffff;fill-opacity:1;stroke:#000000;stroke-width:4;stroke-miterlimit:4;stroke-dasharray:none\" />\r\n" +
+	"    <path\r\n" +
+	"       transform=\"translate(204.94414,196.96064)\"\r\n" +
+	"       inkscape:transform-center-y=\"-3.7418414\"\r\n" +
+	"       inkscape:transform-center-x=\"2.8336012\"\r\n" +
+	"       d=\"m 321.64603,292.47694 -20.26901,-10.09064 -22.62129,0.3053 18.90417,-12.53259 11.03468,-19.73577 1.37247,22.58031 z\"\r\n" +
+	"       inkscape:randomized=\"0.001\"\r\n" +
+	"       inkscape:rounded=\"0\"\r\n" +
+	"       inkscape:flatsided=\"false\"\r\n" +
+	"       sodipodi:arg2=\"1.7955756\"\r\n" +
+	"       sodipodi:arg1=\"0.74837805\"\r\n" +
+	"       sodipodi:r2=\"7.3637938\"\r\n" +
+	"       sodipodi:r1=\"25.392393\"\r\n" +
+	"       sodipodi:cy=\"275.19196\"\r\n" +
+	"       sodipodi:cx=\"303.03448\"\r\n" +
+	"       sodipodi:sides=\"3\"\r\n" +
+	"       id=\"path5492\"\r\n" +
+	"       style=\"fill:#ffffff;fill-opacity:1;stroke:#000000;stroke-width:2;stroke-miterlimit:4;stroke-dasharray:none\"\r\n" +
+	"       sodipodi:type=\"star\" />\r\n" +
+	"  </g>\r\n" +
+	"  <g\r\n" +
+	"     id=\"g5040\"\r\n" +
+	"     transform=\"matrix(0.65026137,0,0,0.65026137,217.82946,578.78133)\">\r\n" +
+	"    <g\r\n" +
+	"       id=\"g5065\"\r\n" +
+	"       transform=\"translate(210.71782,20.506097)\">\r\n" +
+	"      <circle\r\n" +
+	"         style=\"fill:#ec731b;fill-opacity:1;stroke:#000000;stroke-width:5.5999999;stroke-miterlimit:4;stroke-dasharray:none\"\r\n" +
+	"         id=\"path5034\"\r\n" +
+	"         cx=\"192.50983\"\r\n" +
+	"         cy=\"448.9454\"\r\n" +
+	"         r=\"23.157747\" />\r\n" +
+	"      <circle\r\n" +
+	"         style=\"fill:#ec731b;fill-opacity:1;stroke:#000000;stroke-width:2.29999995;stroke-miterlimit:4;stroke-dasharray:none\"\r\n" +
+	"         id=\"path5036\"\r\n" +
+	"         cx=\"192.50983\"\r\n" +
+	"         cy=\"448.94543\"\r\n" +
+	"         r=\"9.0156116\" />\r\n" +
+	"    </g>\r\n" +
+	"  </g>\r\n" +
+	"  <g\r\n" +
+	"     id=\"g5040-8\"\r\n" +
+	"     transform=\"matrix(0.65026137,0,0,0.65026137,-180.81218,65.36081)\">\r\n" +
+	"    <g\r\n" +
+	"       id=\"g5065-5\"\r\n" +
+	"       transform=\"translate(210.71782,20.506097)\">\r\n" +
+	"      <circle\r\n" +
+	"         style=\"fill:#7851a9;fill-opacity:1;stroke:#000000;stroke-width:5.5999999;stroke-miterlimit:4;stroke-dasharray:none\"\r\n" +
+	"         id=\"path5034-8\"\r\n" +
+	"         cx=\"192.50983\"\r\n" +
+	"         cy=\"448.9454\"\r\n" +
+	"         r=\"23.157747\" />\r\n" +
+	"      <circle\r\n" +
+	"         style=\"fill:#7851a9;fill-opacity:1;stroke:#000000;stroke-width:2.29999995;stroke-miterlimit:4;stroke-dasharray:none\"\r\n" +
+	"         id=\"path5036-0\"\r\n" +
+	"         cx=\"192.50983\"\r\n" +
+	"         cy=\"448.94543\"\r\n" +
+	"         r=\"9.0156116\" />\r\n" +
+	"    </g>\r\n" +
+	"  </g>\r\n" +
+	"  <g\r\n" +
+	"     id=\"g5040-5\"\r\n" +
+	"     transform=\"matrix(0.65026137,0,0,0.65026137,485.03649,-201.95768)\">\r\n" +
+	"    <g\r\n" +
+	"       id=\"g5065-3\"\r\n" +
+	"       transform=\"translate(210.71782,20.506097)\">\r\n" +
+	"      <circle\r\n" +
+	"         style=\"fill:#065526;fill-opacity:1;stroke:#000000;stroke-width:5.5999999;stroke-miterlimit:4;stroke-dasharray:none\"\r\n" +
+	"         id=\"path5034-4\"\r\n" +
+	"         cx=\"192.50983\"\r\n" +
+	"         cy=\"448.9454\"\r\n" +
+	"         r=\"23.157747\" />\r\n" +
+	"      <circle\r\n" +
+	"         style=\"fill:#065526;fill-opacity:1;stroke:#000000;stroke-width:2.29999995;stroke-miterlimit:4;stroke-dasharray:none\"\r\n" +
+	"         id=\"path5036-8\"\r\n" +
+	"         cx=\"192.50983\"\r\n" +
+	"         cy=\"448.94543\"\r\n" +
+	"         r=\"9.0156116\" />\r\n" +
+	"    </g>\r\n" +
+	"  </g>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:40px;line-height:1.25;font-family:monospace;-inkscape-font-specification:monospace;letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"385.58167\"\r\n" +
+	"     y=\"362.24689\"\r\n" +
+	"     id=\"text1617\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1615\"\r\n" +
+	"       x=\"385.58167\"\r\n" +
+	"       y=\"362.24689\"\r\n" +
+	"       style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting'\">14</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:40px;line-height:1.25;font-family:monospace;-inkscape-font-specification:monospace;letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"117.52899\"\r\n" +
+	"     y=\"150.828\"\r\n" +
+	"     id=\"text1621\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1619\"\r\n" +
+	"       x=\"117.52899\"\r\n" +
+	"       y=\"150.828\"\r\n" +
+	"       style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting'\">1</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"551.52899\"\r\n" +
+	"     y=\"145.828\"\r\n" +
+	"     id=\"text1649\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1647\"\r\n" +
+	"       x=\"551.52899\"\r\n" +
+	"       y=\"145.828\">2</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"710.02899\"\r\n" +
+	"     y=\"145.828\"\r\n" +
+	"     id=\"text1653\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1651\"\r\n" +
+	"       x=\"710.02899\"\r\n" +
+	"       y=\"145.828\">3</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"174.02899\"\r\n" +
+	"     y=\"257.328\"\r\n" +
+	"     id=\"text1657\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1655\"\r\n" +
+	"       x=\"174.02899\"\r\n" +
+	"       y=\"257.328\">4</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"252.99348\"\r\n" +
+	"     y=\"300.34882\"\r\n" +
+	"     id=\"text1661\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1659\"\r\n" +
+	"       x=\"252.99348\"\r\n" +
+	"       y=\"300.34882\">5</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"328.52902\"\r\n" +
+	"     y=\"330.828\"\r\n" +
+	"     id=\"text1665\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1663\"\r\n" +
+	"       x=\"328.52902\"\r\n" +
+	"       y=\"330.828\">6</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"471.02902\"\r\n" +
+	"     y=\"258.828\"\r\n" +
+	"     id=\"text1669\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1667\"\r\n" +
+	"       x=\"471.02902\"\r\n" +
+	"       y=\"258.828\">7</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"575.02899\"\r\n" +
+	"     y=\"245.328\"\r\n" +
+	"     id=\"text1673\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1671\"\r\n" +
+	"       x=\"575.02899\"\r\n" +
+	"       y=\"245.328\">8</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"691.52899\"\r\n" +
+	"     y=\"259.328\"\r\n" +
+	"     id=\"text1677\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1675\"\r\n" +
+	"       x=\"691.52899\"\r\n" +
+	"       y=\"259.328\">9</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"853.89294\"\r\n" +
+	"     y=\"203.5498\"\r\n" +
+	"     id=\"text1681\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1679\"\r\n" +
+	"       x=\"853.89294\"\r\n" +
+	"       y=\"203.5498\"\r\n" +
+	"       style=\"fill:#000000\">10</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"76.028999\"\r\n" +
+	"     y=\"431.828\"\r\n" +
+	"     id=\"text1685\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1683\"\r\n" +
+	"       x=\"76.028999\"\r\n" +
+	"       y=\"431.828\">11</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#000000;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"147.52899\"\r\n" +
+	"     y=\"411.328\"\r\n" +
+	"     id=\"text1689\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1687\"\r\n" +
+	"       x=\"147.52899\"\r\n" +
+	"       y=\"411.328\"\r\n" +
+	"       style=\"fill:#ffffff\">12</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"240.52899\"\r\n" +
+	"     y=\"435.328\"\r\n" +
+	"     id=\"text1693\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1691\"\r\n" +
+	"       x=\"240.52899\"\r\n" +
+	"       y=\"435.328\">13</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"469.52902\"\r\n" +
+	"     y=\"350.828\"\r\n" +
+	"     id=\"text1697\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1695\"\r\n" +
+	"       x=\"469.52902\"\r\n" +
+	"       y=\"350.828\">15</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"567.02899\"\r\n" +
+	"     y=\"339.328\"\r\n" +
+	"     id=\"text1701\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1699\"\r\n" +
+	"       x=\"567.02899\"\r\n" +
+	"       y=\"339.328\">16</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"655.02899\"\r\n" +
+	"     y=\"335.828\"\r\n" +
+	"     id=\"text1705\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1703\"\r\n" +
+	"       x=\"655.02899\"\r\n" +
+	"       y=\"335.828\">17</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"753.52899\"\r\n" +
+	"     y=\"404.328\"\r\n" +
+	"     id=\"text1709\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1707\"\r\n" +
+	"       x=\"753.52899\"\r\n" +
+	"       y=\"404.328\">18</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"88.528999\"\r\n" +
+	"     y=\"529.828\"\r\n" +
+	"     id=\"text1713\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1711\"\r\n" +
+	"       x=\"88.528999\"\r\n" +
+	"       y=\"529.828\">19</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"129.52899\"\r\n" +
+	"     y=\"578.328\"\r\n" +
+	"     id=\"text1717\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1715\"\r\n" +
+	"       x=\"129.52899\"\r\n" +
+	"       y=\"578.328\">20</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"79.933548\"\r\n" +
+	"     y=\"669.8255\"\r\n" +
+	"     id=\"text1721\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1719\"\r\n" +
+	"       x=\"79.933548\"\r\n" +
+	"       y=\"669.8255\"\r\n" +
+	"       style=\"fill:#000000\">21</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"93.622147\"\r\n" +
+	"     y=\"727.12396\"\r\n" +
+	"     id=\"text1725\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1723\"\r\n" +
+	"       x=\"93.622147\"\r\n" +
+	"       y=\"727.12396\"\r\n" +
+	"       style=\"fill:#000000\">22</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"251.02899\"\r\n" +
+	"     y=\"727.828\"\r\n" +
+	"     id=\"text1729\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1727\"\r\n" +
+	"       x=\"251.02899\"\r\n" +
+	"       y=\"727.828\">23</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"282.64423\"\r\n" +
+	"     y=\"802.84875\"\r\n" +
+	"     id=\"text1733\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1731\"\r\n" +
+	"       x=\"282.64423\"\r\n" +
+	"       y=\"802.84875\"\r\n" +
+	"       style=\"fill:#000000\">24</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"251.02899\"\r\n" +
+	"     y=\"505.328\"\r\n" +
+	"     id=\"text1737\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1735\"\r\n" +
+	"       x=\"251.02899\"\r\n" +
+	"       y=\"505.328\">25</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"324.02902\"\r\n" +
+	"     y=\"533.828\"\r\n" +
+	"     id=\"text1741\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1739\"\r\n" +
+	"       x=\"324.02902\"\r\n" +
+	"       y=\"533.828\">26</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"477.52902\"\r\n" +
+	"     y=\"509.828\"\r\n" +
+	"     id=\"text1745\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1743\"\r\n" +
+	"       x=\"477.52902\"\r\n" +
+	"       y=\"509.828\">27</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"459.02902\"\r\n" +
+	"     y=\"421.328\"\r\n" +
+	"     id=\"text1749\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1747\"\r\n" +
+	"       x=\"459.02902\"\r\n" +
+	"       y=\"421.328\">0</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"573.02899\"\r\n" +
+	"     y=\"535.328\"\r\n" +
+	"     id=\"text1753\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1751\"\r\n" +
+	"       x=\"573.02899\"\r\n" +
+	"       y=\"535.328\">28</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"718.52899\"\r\n" +
+	"     y=\"526.328\"\r\n" +
+	"     id=\"text1757\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1755\"\r\n" +
+	"       x=\"718.52899\"\r\n" +
+	"       y=\"526.328\">29</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"859.52899\"\r\n" +
+	"     y=\"533.828\"\r\n" +
+	"     id=\"text1761\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1759\"\r\n" +
+	"       x=\"859.52899\"\r\n" +
+	"       y=\"533.828\">30</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"413.52902\"\r\n" +
+	"     y=\"580.328\"\r\n" +
+	"     id=\"text1765\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1763\"\r\n" +
+	"       x=\"413.52902\"\r\n" +
+	"       y=\"580.328\">31</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"418.02902\"\r\n" +
+	"     y=\"679.828\"\r\n" +
+	"     id=\"text1769\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1767\"\r\n" +
+	"       x=\"418.02902\"\r\n" +
+	"       y=\"679.828\">32</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"513.52899\"\r\n" +
+	"     y=\"613.828\"\r\n" +
+	"     id=\"text1773\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1771\"\r\n" +
+	"       x=\"513.52899\"\r\n" +
+	"       y=\"613.828\">33</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"673.52899\"\r\n" +
+	"     y=\"642.328\"\r\n" +
+	"     id=\"text1777\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1775\"\r\n" +
+	"       x=\"673.52899\"\r\n" +
+	"       y=\"642.328\">34</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"821.02899\"\r\n" +
+	"     y=\"654.328\"\r\n" +
+	"     id=\"text1781\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1779\"\r\n" +
+	"       x=\"821.02899\"\r\n" +
+	"       y=\"654.328\">35</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"422.52902\"\r\n" +
+	"     y=\"789.328\"\r\n" +
+	"     id=\"text1785\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1783\"\r\n" +
+	"       x=\"422.52902\"\r\n" +
+	"       y=\"789.328\">36</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"528.52899\"\r\n" +
+	"     y=\"735.828\"\r\n" +
+	"     id=\"text1789\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1787\"\r\n" +
+	"       x=\"528.52899\"\r\n" +
+	"       y=\"735.828\">37</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"513.52899\"\r\n" +
+	"     y=\"809.328\"\r\n" +
+	"     id=\"text1793\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1791\"\r\n" +
+	"       x=\"513.52899\"\r\n" +
+	"       y=\"809.328\">38</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"426.52902\"\r\n" +
+	"     y=\"863.828\"\r\n" +
+	"     id=\"text1797\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1795\"\r\n" +
+	"       x=\"426.52902\"\r\n" +
+	"       y=\"863.828\">39</tspan></text>\r\n" +
+	"  <text\r\n" +
+	"     xml:space=\"preserve\"\r\n" +
+	"     style=\"font-style:normal;font-variant:normal;font-weight:normal;font-stretch:normal;font-size:29.33333397px;line-height:1.25;font-family:'Lucida Handwriting';-inkscape-font-specification:'Lucida Handwriting';letter-spacing:0px;word-spacing:0px;fill:#ffffff;fill-opacity:1;stroke:none\"\r\n" +
+	"     x=\"821.52899\"\r\n" +
+	"     y=\"794.828\"\r\n" +
+	"     id=\"text1801\"><tspan\r\n" +
+	"       sodipodi:role=\"line\"\r\n" +
+	"       id=\"tspan1799\"\r\n" +
+	"       x=\"821.52899\"\r\n" +
+	"       y=\"794.828\">40</tspan></text>\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:none;stroke:#000000;stroke-width:1.75748026;stroke-linecap:butt;stroke-linejoin:miter;stroke-miterlimit:4;stroke-dasharray:none;stroke-opacity:1\"\r\n" +
+	"     d=\"m 141.95645,714.6023 22.62742,-16.97057 z\"\r\n" +
+	"     id=\"path1811\"\r\n" +
+	"     inkscape:connector-curvature=\"0\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:none;stroke:#000000;stroke-width:1.75748026;stroke-linecap:butt;stroke-linejoin:miter;stroke-miterlimit:4;stroke-dasharray:none;stroke-opacity:1\"\r\n" +
+	"     d=\"m 326.51132,774.35282 28.28427,-24.04163 z\"\r\n" +
+	"     id=\"path1815\"\r\n" +
+	"     inkscape:connector-curvature=\"0\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:none;stroke:#000000;stroke-width:1.75748026;stroke-linecap:butt;stroke-linejoin:miter;stroke-miterlimit:4;stroke-dasharray:none;stroke-opacity:1\"\r\n" +
+	"     d=\"m 851.18456,197.35369 -34.64824,13.43502 z\"\r\n" +
+	"     id=\"path1817\"\r\n" +
+	"     inkscape:connector-curvature=\"0\" />\r\n" +
+	"  <path\r\n" +
+	"     style=\"fill:none;stroke:#000000;stroke-width:1.75748026;stroke-linecap:butt;stroke-linejoin:miter;stroke-miterlimit:4;stroke-dasharray:none;stroke-opacity:1;enable-background:new\"\r\n" +
+	"     d=\"M 128.89884,654.62007 151.52626,637.6495 Z\"\r\n" +
+	"     id=\"path1811-4\"\r\n" +
+	"     inkscape:connector-curvature=\"0\" />\r\n" +
+	"</svg>\r\n"
@@ -0,0 +1,68 @@
+//go:build ignore
+// +build ignore
+
+// builds maptemplate.go out of the map template at the root of the repo, so
+// the binary can draw the map wherever it runs
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"strconv"
+)
+
+const (
+	templatePath = "../maptemplate.svg"
+	outputPath   = "maptemplate.go"
+)
+
+func main() {
+	template, err := ioutil.ReadFile(templatePath)
+	if err != nil {
+		log.Fatalf("failed reading map template: %v", err)
+	}
+
+	var source bytes.Buffer
+	source.WriteString("// Code generated by go run maptemplate_generate.go; DO NOT EDIT.\n\n")
+	source.WriteString("package atlas\n\n")
+	source.WriteString("// MapTemplate is the svg every map is drawn from\n")
+	source.WriteString("const MapTemplate = \"\"")
+
+	// one line of the template to a line of source keeps the diffs readable
+	scanner := bufio.NewScanner(bytes.NewReader(template))
+	scanner.Buffer(nil, len(template)+1)
+	scanner.Split(scanLines)
+	for scanner.Scan() {
+		source.WriteString(" +\n\t" + strconv.Quote(scanner.Text()))
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatalf("failed reading map template: %v", err)
+	}
+	source.WriteString("\n")
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		log.Fatalf("failed formatting map template source: %v", err)
+	}
+	err = ioutil.WriteFile(outputPath, formatted, 0644)
+	if err != nil {
+		log.Fatalf("failed writing map template source: %v", err)
+	}
+	fmt.Printf("wrote %s from %s\n", outputPath, templatePath)
+}
+
+// scanLines splits after every newline, keeping it and any carriage return
+// before it
+func scanLines(data []byte, atEOF bool) (int, []byte, error) {
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		return i + 1, data[:i+1], nil
+	}
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
@@ -0,0 +1,73 @@
+package atlas
+
+import (
+	"bufio"
+	"database/sql"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate go run maptemplate_generate.go
+
+// tileMarker starts every line of the map template that fills in a location
+// tile. the location id follows in two hex digits
+const tileMarker = "*tile"
+
+// MapColors names the colour each order is drawn in on the map
+var MapColors = map[string]string{
+	"Staghorn Sect": "orange",
+	"Order Gorgona": "purple",
+	"The Baaturate": "green",
+}
+
+// MapFill returns the map template pattern for a location with the given owner
+// and occupier. nobody is drawn in gray
+func MapFill(owner sql.NullString, occupier sql.NullString) string {
+	ownerColor, occupierColor := "gray", "gray"
+	if owner.Valid {
+		ownerColor = MapColors[owner.String]
+	}
+	if occupier.Valid {
+		occupierColor = MapColors[occupier.String]
+	}
+	return "url(#" + ownerColor + "dot" + occupierColor + ")"
+}
+
+// RenderMap copies the map template to out, filling in every location tile with
+// whatever fill returns for it
+func RenderMap(template io.Reader, out io.Writer, fill func(locationID int32) string) error {
+	reader := bufio.NewReader(template)
+	writer := bufio.NewWriter(out)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return errors.Wrap(err, "failed reading map template")
+		}
+
+		if strings.HasPrefix(line, tileMarker) && len(line) >= len(tileMarker)+2 {
+			idDigits := line[len(tileMarker) : len(tileMarker)+2]
+			locationID, parseErr := strconv.ParseInt(idDigits, 16, 32)
+			if parseErr != nil {
+				return errors.Wrap(parseErr, "failed converting location id")
+			}
+			line = fill(int32(locationID)) + line[len(tileMarker)+2:]
+		}
+
+		_, writeErr := writer.WriteString(line)
+		if writeErr != nil {
+			return errors.Wrap(writeErr, "failed writing map")
+		}
+		if err == io.EOF {
+			break
+		}
+	}
+
+	err := writer.Flush()
+	if err != nil {
+		return errors.Wrap(err, "failed writing map")
+	}
+	return nil
+}
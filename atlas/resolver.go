@@ -0,0 +1,189 @@
+package atlas
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yisaj/heavens_throne/entities"
+)
+
+const (
+	// shorter prefixes are too vague to mean anything
+	minPrefixLength = 3
+	// how many typos are forgiven per character typed
+	typosPerCharacter = 4
+)
+
+var (
+	nonAlphanumeric = regexp.MustCompile("[^a-z0-9]+")
+	// words too common to identify a location on their own
+	stopWords = map[string]bool{"the": true, "of": true}
+)
+
+// Resolver matches what players type against the names of locations
+type Resolver struct {
+	ids     map[int32]bool
+	aliases map[string][]int32
+}
+
+// NewResolver builds a resolver with aliases generated from the names of the
+// given locations. a location can be called by its full name, any run of words
+// in its name, or any word with its possessive dropped
+func NewResolver(locations []entities.Location) *Resolver {
+	resolver := &Resolver{
+		ids:     make(map[int32]bool),
+		aliases: make(map[string][]int32),
+	}
+
+	for _, location := range locations {
+		resolver.ids[location.ID] = true
+
+		words := strings.Fields(strings.ToLower(location.Name))
+		for i := range words {
+			words[i] = nonAlphanumeric.ReplaceAllString(words[i], "")
+		}
+
+		for start := range words {
+			for end := start + 1; end <= len(words); end++ {
+				alias := strings.Join(words[start:end], "")
+				if end-start == 1 && (len(alias) < 2 || stopWords[alias]) {
+					continue
+				}
+				resolver.addAlias(alias, location.ID)
+			}
+		}
+
+		// St. Cecil's Bridge is also just Cecil's
+		for _, word := range strings.Fields(strings.ToLower(location.Name)) {
+			if strings.HasSuffix(word, "'s") {
+				resolver.addAlias(nonAlphanumeric.ReplaceAllString(strings.TrimSuffix(word, "'s"), ""), location.ID)
+			}
+		}
+	}
+
+	return resolver
+}
+
+func (r *Resolver) addAlias(alias string, locationID int32) {
+	for _, id := range r.aliases[alias] {
+		if id == locationID {
+			return
+		}
+	}
+	r.aliases[alias] = append(r.aliases[alias], locationID)
+}
+
+// Resolve returns the locations that best match the input, trying exact names,
+// then prefixes, then names within a few typos. when several locations match
+// equally well, any of the preferred locations win out. more than one result
+// means the input was ambiguous
+func (r *Resolver) Resolve(input string, preferred []int32) []int32 {
+	input = nonAlphanumeric.ReplaceAllString(strings.ToLower(input), "")
+	if input == "" || stopWords[input] {
+		return nil
+	}
+
+	if id, err := strconv.Atoi(input); err == nil {
+		if r.ids[int32(id)] {
+			return []int32{int32(id)}
+		}
+		return nil
+	}
+
+	if matches, ok := r.aliases[input]; ok {
+		return prefer(matches, preferred)
+	}
+
+	if len(input) >= minPrefixLength {
+		var matches []int32
+		for alias, ids := range r.aliases {
+			if strings.HasPrefix(alias, input) {
+				matches = append(matches, ids...)
+			}
+		}
+		if len(matches) > 0 {
+			return prefer(matches, preferred)
+		}
+	}
+
+	maxDistance := len(input) / typosPerCharacter
+	if maxDistance == 0 {
+		return nil
+	}
+	bestDistance := maxDistance + 1
+	var matches []int32
+	for alias, ids := range r.aliases {
+		distance := editDistance(input, alias)
+		if distance < bestDistance {
+			bestDistance = distance
+			matches = nil
+		}
+		if distance == bestDistance {
+			matches = append(matches, ids...)
+		}
+	}
+	return prefer(matches, preferred)
+}
+
+// prefer dedupes and sorts the matches, narrowing them down to the preferred
+// locations if any of them matched
+func prefer(matches []int32, preferred []int32) []int32 {
+	unique := make(map[int32]bool)
+	for _, id := range matches {
+		unique[id] = true
+	}
+
+	var narrowed []int32
+	for _, id := range preferred {
+		if unique[id] {
+			narrowed = append(narrowed, id)
+			delete(unique, id)
+		}
+	}
+	if len(narrowed) == 0 {
+		for id := range unique {
+			narrowed = append(narrowed, id)
+		}
+	}
+
+	sort.Slice(narrowed, func(i int, j int) bool {
+		return narrowed[i] < narrowed[j]
+	})
+	return narrowed
+}
+
+// editDistance counts the insertions, deletions and substitutions it takes to
+// turn one string into another
+func editDistance(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+func minInt(values ...int) int {
+	least := values[0]
+	for _, value := range values[1:] {
+		if value < least {
+			least = value
+		}
+	}
+	return least
+}
@@ -0,0 +1,69 @@
+package atlas
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yisaj/heavens_throne/entities"
+)
+
+func TestResolve(t *testing.T) {
+	resolver := NewResolver([]entities.Location{
+		{ID: 4, Name: "St. Cecil's Bridge"},
+		{ID: 10, Name: "The Ash Sea"},
+		{ID: 14, Name: "Necropolis"},
+		{ID: 19, Name: "Camp Gray"},
+		{ID: 20, Name: "Camp Watkins"},
+		{ID: 22, Name: "Mercy Cove"},
+		{ID: 23, Name: "Giant's Bluff"},
+	})
+
+	tests := []struct {
+		input     string
+		preferred []int32
+		matches   []int32
+	}{
+		{"St. Cecil's Bridge", nil, []int32{4}},
+		{"cecil", nil, []int32{4}},
+		{"bridge", nil, []int32{4}},
+		{"ash sea", nil, []int32{10}},
+		{"the", nil, nil},
+		{"the ash", nil, []int32{10}},
+		{"14", nil, []int32{14}},
+		{"99", nil, nil},
+		{"necro", nil, []int32{14}},
+		{"necroplis", nil, []int32{14}},
+		{"giants bluf", nil, []int32{23}},
+		{"camp", nil, []int32{19, 20}},
+		{"camp", []int32{20, 22}, []int32{20}},
+		{"cove", []int32{19}, []int32{22}},
+		{"zz", nil, nil},
+		{"", nil, nil},
+	}
+
+	for _, test := range tests {
+		matches := resolver.Resolve(test.input, test.preferred)
+		if !reflect.DeepEqual(matches, test.matches) {
+			t.Errorf("resolving %q: expected %v, got %v", test.input, test.matches, matches)
+		}
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		distance int
+	}{
+		{"", "", 0},
+		{"york", "york", 0},
+		{"york", "yrok", 2},
+		{"necroplis", "necropolis", 1},
+		{"", "fog", 3},
+	}
+
+	for _, test := range tests {
+		if distance := editDistance(test.a, test.b); distance != test.distance {
+			t.Errorf("distance from %q to %q: expected %d, got %d", test.a, test.b, test.distance, distance)
+		}
+	}
+}
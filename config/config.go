@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"strconv"
 	"strings"
 )
 
@@ -16,6 +17,23 @@ const (
 	accessTokenKey       = "ACCESS_TOKEN"
 	accessTokenSecretKey = "ACCESS_TOKEN_SECRET"
 	debugKey             = "DEBUG"
+	simulatorKey         = "SIMULATOR"
+	battlePhasesKey      = "BATTLE_PHASES"
+	adminsKey            = "ADMINS"
+	chatFilterKey        = "CHAT_FILTER"
+	templeFallbackKey    = "TEMPLE_FALLBACK_DAYS"
+	eliminateOrdersKey   = "ELIMINATE_ORDERS"
+	commanderTermKey     = "COMMANDER_TERM_DAYS"
+	opsAddrKey           = "OPS_ADDR"
+	statusTokenKey       = "STATUS_TOKEN"
+	readySimulationKey   = "READY_ON_SIMULATION"
+	scheduleKey          = "SCHEDULE"
+	timeZoneKey          = "TIME_ZONE"
+	catchUpKey           = "CATCH_UP_MISSED_DAYS"
+	gamesKey             = "GAMES"
+
+	defaultOpsAddr  = ":9090"
+	defaultSchedule = "0 0 * * *"
 )
 
 // Config defines the database and twitter configuration for the app
@@ -29,21 +47,103 @@ type Config struct {
 	AccessToken       string
 	AccessTokenSecret string
 	Debug             string
+	Simulator         string
+	BattlePhases      []string
+	Admins            []string
+	ChatFilter        []string
+	// how many days the dead of an order without its temple wait before
+	// returning elsewhere. zero means they wait for the temple
+	TempleFallbackDays int32
+	EliminateOrders    bool
+	// how many days an elected commander leads their order. zero means the
+	// simulator's default term
+	CommanderTermDays int32
+	// where metrics are served for scraping, apart from the public server
+	OpsAddr string
+	// the bearer token the status page asks for. the page is shut without one
+	StatusToken string
+	// whether a scheduled simulation that failed or never ran fails readiness
+	ReadyOnSimulation bool
+	// when the simulator runs, as a five field cron spec in the time zone. a
+	// blank time zone is the local one
+	Schedule string
+	TimeZone string
+	// whether days missed while the game was down are simulated when it comes
+	// back up, rather than skipped
+	CatchUpMissedDays bool
+	// the games run alongside the main one, each on its own schedule
+	Games []Game
+}
+
+// Game is a game run alongside the main one
+type Game struct {
+	Name     string
+	Schedule string
+}
+
+// parseGames parses games written as name=schedule;name=schedule. malformed
+// games are left out
+func parseGames(games string) []Game {
+	var parsed []Game
+	for _, game := range strings.Split(games, ";") {
+		nameAndSchedule := strings.SplitN(game, "=", 2)
+		if len(nameAndSchedule) != 2 {
+			continue
+		}
+		name := strings.TrimSpace(nameAndSchedule[0])
+		schedule := strings.TrimSpace(nameAndSchedule[1])
+		if name == "" || schedule == "" {
+			continue
+		}
+		parsed = append(parsed, Game{name, schedule})
+	}
+	return parsed
 }
 
 // New returns a new config object constructed from environment variables
 func New() *Config {
 	domains := strings.Split(os.Getenv(prefix+domainsKey), ",")
+	// malformed rules fall back to the defaults
+	templeFallbackDays, _ := strconv.ParseInt(os.Getenv(prefix+templeFallbackKey), 10, 32)
+	eliminateOrders, _ := strconv.ParseBool(os.Getenv(prefix + eliminateOrdersKey))
+	commanderTermDays, _ := strconv.ParseInt(os.Getenv(prefix+commanderTermKey), 10, 32)
+	opsAddr := os.Getenv(prefix + opsAddrKey)
+	if opsAddr == "" {
+		opsAddr = defaultOpsAddr
+	}
+	readyOnSimulation, err := strconv.ParseBool(os.Getenv(prefix + readySimulationKey))
+	if err != nil {
+		readyOnSimulation = true
+	}
+	schedule := os.Getenv(prefix + scheduleKey)
+	if schedule == "" {
+		schedule = defaultSchedule
+	}
+	catchUp, _ := strconv.ParseBool(os.Getenv(prefix + catchUpKey))
 
 	return &Config{
-		DatabaseURI:       os.Getenv(prefix + dbURIKey),
-		Domains:           domains,
-		Endpoint:          os.Getenv(prefix + endpointKey),
-		ConsumerKey:       os.Getenv(prefix + consumerKeyKey),
-		ConsumerKeySecret: os.Getenv(prefix + consumerKeySecretKey),
-		TwitterEnvName:    os.Getenv(prefix + twitterEnvNameKey),
-		AccessToken:       os.Getenv(prefix + accessTokenKey),
-		AccessTokenSecret: os.Getenv(prefix + accessTokenSecretKey),
-		Debug:             os.Getenv(prefix + debugKey),
+		DatabaseURI:        os.Getenv(prefix + dbURIKey),
+		Domains:            domains,
+		Endpoint:           os.Getenv(prefix + endpointKey),
+		ConsumerKey:        os.Getenv(prefix + consumerKeyKey),
+		ConsumerKeySecret:  os.Getenv(prefix + consumerKeySecretKey),
+		TwitterEnvName:     os.Getenv(prefix + twitterEnvNameKey),
+		AccessToken:        os.Getenv(prefix + accessTokenKey),
+		AccessTokenSecret:  os.Getenv(prefix + accessTokenSecretKey),
+		Debug:              os.Getenv(prefix + debugKey),
+		Simulator:          os.Getenv(prefix + simulatorKey),
+		BattlePhases:       strings.Split(os.Getenv(prefix+battlePhasesKey), ","),
+		Admins:             strings.Split(os.Getenv(prefix+adminsKey), ","),
+		ChatFilter:         strings.Split(os.Getenv(prefix+chatFilterKey), ","),
+		TempleFallbackDays: int32(templeFallbackDays),
+		EliminateOrders:    eliminateOrders,
+		CommanderTermDays:  int32(commanderTermDays),
+		OpsAddr:            opsAddr,
+		StatusToken:        os.Getenv(prefix + statusTokenKey),
+		ReadyOnSimulation:  readyOnSimulation,
+		Schedule:           schedule,
+		TimeZone:           os.Getenv(prefix + timeZoneKey),
+		CatchUpMissedDays:  catchUp,
+		Games:              parseGames(os.Getenv(prefix + gamesKey)),
 	}
 }
@@ -0,0 +1,120 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/yisaj/heavens_throne/entities"
+
+	"github.com/pkg/errors"
+)
+
+// ChatResource contains database methods for order chat
+type ChatResource interface {
+	ToggleOrderChat(ctx context.Context, twitterID string) (bool, error)
+	CreateOrderMessage(ctx context.Context, twitterID string, body string) (*entities.OrderMessage, error)
+	CountOrderMessagesSince(ctx context.Context, twitterID string, since time.Time) (int, error)
+	GetOrderChatRecipients(ctx context.Context, twitterID string) ([]string, error)
+	GetOrderMessages(ctx context.Context, twitterID string, limit int) ([]entities.OrderMessage, error)
+	MutePlayer(ctx context.Context, twitterID string, mutedID int32) (bool, error)
+	UnmutePlayer(ctx context.Context, twitterID string, mutedID int32) error
+}
+
+func (c *connection) ToggleOrderChat(ctx context.Context, twitterID string) (bool, error) {
+	query := `UPDATE player SET receive_order_chat = NOT receive_order_chat WHERE twitter_id=$1
+		RETURNING receive_order_chat`
+
+	var receiveOrderChat bool
+	err := c.db.GetContext(ctx, &receiveOrderChat, query, twitterID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed toggling player order chat setting")
+	}
+	return receiveOrderChat, nil
+}
+
+func (c *connection) CreateOrderMessage(ctx context.Context, twitterID string, body string) (*entities.OrderMessage, error) {
+	query := `INSERT INTO order_message (day, martial_order, sender, sender_class, body)
+		SELECT calendar.count, player.martial_order, player.id, player.class, $2 FROM calendar, player
+		WHERE player.twitter_id=$1 RETURNING *`
+
+	var message entities.OrderMessage
+	err := c.db.GetContext(ctx, &message, query, twitterID, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed creating order message")
+	}
+	return &message, nil
+}
+
+func (c *connection) CountOrderMessagesSince(ctx context.Context, twitterID string, since time.Time) (int, error) {
+	query := `SELECT COUNT(*) FROM order_message INNER JOIN player ON order_message.sender=player.id
+		WHERE player.twitter_id=$1 AND order_message.timestamp > $2`
+
+	var count int
+	err := c.db.GetContext(ctx, &count, query, twitterID, since)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed counting order messages")
+	}
+	return count, nil
+}
+
+// GetOrderChatRecipients finds the active members of the sender's order who
+// opted in to order chat and haven't muted the sender
+func (c *connection) GetOrderChatRecipients(ctx context.Context, twitterID string) ([]string, error) {
+	query := `SELECT recipient.twitter_id FROM player AS recipient, player AS sender
+		WHERE sender.twitter_id=$1 AND recipient.martial_order=sender.martial_order AND recipient.id != sender.id
+		AND recipient.active AND recipient.receive_order_chat
+		AND NOT EXISTS (SELECT 1 FROM order_mute WHERE order_mute.player=recipient.id AND order_mute.muted=sender.id)`
+
+	var recipients []string
+	err := c.db.SelectContext(ctx, &recipients, query, twitterID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting order chat recipients")
+	}
+	return recipients, nil
+}
+
+// GetOrderMessages returns the latest messages in the player's order chat, oldest
+// first, leaving out anyone they've muted
+func (c *connection) GetOrderMessages(ctx context.Context, twitterID string, limit int) ([]entities.OrderMessage, error) {
+	query := `SELECT * FROM (SELECT order_message.* FROM order_message, player
+		WHERE player.twitter_id=$1 AND order_message.martial_order=player.martial_order
+		AND NOT EXISTS (SELECT 1 FROM order_mute WHERE order_mute.player=player.id AND order_mute.muted=order_message.sender)
+		ORDER BY order_message.id DESC LIMIT $2) AS latest ORDER BY id`
+
+	var messages []entities.OrderMessage
+	err := c.db.SelectContext(ctx, &messages, query, twitterID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting order messages")
+	}
+	return messages, nil
+}
+
+// MutePlayer stops a member of the player's order from reaching them. returns
+// false if there's no such member
+func (c *connection) MutePlayer(ctx context.Context, twitterID string, mutedID int32) (bool, error) {
+	query := `WITH target AS (
+			SELECT player.id AS player, muted.id AS muted FROM player, player AS muted
+			WHERE player.twitter_id=$1 AND muted.id=$2 AND muted.martial_order=player.martial_order AND muted.id != player.id
+		), mute AS (
+			INSERT INTO order_mute (player, muted) SELECT player, muted FROM target ON CONFLICT DO NOTHING
+		)
+		SELECT COUNT(*) FROM target`
+
+	var found int
+	err := c.db.GetContext(ctx, &found, query, twitterID, mutedID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed muting player")
+	}
+	return found > 0, nil
+}
+
+func (c *connection) UnmutePlayer(ctx context.Context, twitterID string, mutedID int32) error {
+	query := `DELETE FROM order_mute USING player WHERE order_mute.player=player.id
+		AND player.twitter_id=$1 AND order_mute.muted=$2`
+
+	_, err := c.db.ExecContext(ctx, query, twitterID, mutedID)
+	if err != nil {
+		return errors.Wrap(err, "failed unmuting player")
+	}
+	return nil
+}
@@ -2,6 +2,10 @@ package database
 
 // TODO ENGINEER: migrate from sqlx to pgx
 import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/yisaj/heavens_throne/config"
@@ -10,7 +14,7 @@ import (
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/jmoiron/sqlx"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -19,27 +23,103 @@ const (
 	dbDriverName          = "postgres"
 	maxConnectionAttempts = 10
 	migrationsURL         = "file://migrations"
+	// every game but the main one lives in a schema named after it. migrations
+	// tell the schemas apart by this prefix too
+	gameSchemaPrefix = "game_"
 )
 
+// MainGame is the game kept in the default schema, which players are in until
+// they pick another
+const MainGame = "main"
+
+// game names become part of schema names
+var gameNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,30}$`)
+
 // Resource is the wrapper interface that includes all database access methods
 type Resource interface {
 	LocationResource
 	PlayerResource
 	WebhooksResource
 	GameResource
+	ExperienceResource
+	MarchResource
+	ChatResource
+	LeadershipResource
+	TempleResource
+	ScheduleResource
+	MembershipResource
+	StoryResource
+	Ping(ctx context.Context) error
+	Close() error
 }
 
 type connection struct {
-	db *sqlx.DB
+	db timedDB
 }
 
 // Connect opens a connection to the database and returns the resource object
+// for the main game
 func Connect(conf *config.Config, logger *logrus.Logger) (Resource, error) {
-	// open and ping db connection
+	return connect(conf.DatabaseURI, logger)
+}
+
+// ConnectGame opens a connection to one of the other games running alongside
+// the main one. each game keeps its own map, players and calendar in a schema
+// of its own, which is created and migrated on the first connection
+func ConnectGame(conf *config.Config, logger *logrus.Logger, game string) (Resource, error) {
+	if !gameNamePattern.MatchString(game) || game == MainGame {
+		return nil, errors.Errorf("invalid game name `%s`", game)
+	}
+	schema := gameSchemaPrefix + game
+
+	db, err := open(conf.DatabaseURI, logger)
+	if err != nil {
+		return nil, err
+	}
+	_, err = db.Exec("CREATE SCHEMA IF NOT EXISTS " + pq.QuoteIdentifier(schema))
+	db.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed creating game schema")
+	}
+
+	uri, err := withSearchPath(conf.DatabaseURI, schema)
+	if err != nil {
+		return nil, err
+	}
+	return connect(uri, logger)
+}
+
+// connect opens and migrates a connection
+func connect(uri string, logger *logrus.Logger) (Resource, error) {
+	db, err := open(uri, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := postgres.WithInstance(db.DB, &postgres.Config{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed database driver construction")
+	}
+
+	migration, err := migrate.NewWithDatabaseInstance(migrationsURL, dbDriverName, driver)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed database migrator construction")
+	}
+
+	err = migration.Up()
+	if err != nil && err != migrate.ErrNoChange {
+		return nil, errors.Wrap(err, "failed database migration")
+	}
+
+	return &connection{timedDB{db}}, nil
+}
+
+// open opens and pings a connection, retrying while the database comes up
+func open(uri string, logger *logrus.Logger) (*sqlx.DB, error) {
 	var db *sqlx.DB
 	var err error
 	for attempts := 1; attempts <= maxConnectionAttempts; attempts++ {
-		db, err = sqlx.Connect(dbDriverName, conf.DatabaseURI)
+		db, err = sqlx.Connect(dbDriverName, uri)
 		if err == nil {
 			break
 		}
@@ -50,21 +130,40 @@ func Connect(conf *config.Config, logger *logrus.Logger) (Resource, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "failed database connection")
 	}
+	return db, nil
+}
 
-	driver, err := postgres.WithInstance(db.DB, &postgres.Config{})
-	if err != nil {
-		return nil, errors.Wrap(err, "failed database driver construction")
+// withSearchPath points every connection made with the uri at the schema,
+// whether the uri is a url or a list of key=value settings
+func withSearchPath(uri string, schema string) (string, error) {
+	if !strings.HasPrefix(uri, "postgres://") && !strings.HasPrefix(uri, "postgresql://") {
+		return uri + " search_path=" + schema, nil
 	}
 
-	migration, err := migrate.NewWithDatabaseInstance(migrationsURL, dbDriverName, driver)
+	parsed, err := url.Parse(uri)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed database migrator construction")
+		return "", errors.Wrap(err, "failed parsing database uri")
 	}
+	query := parsed.Query()
+	query.Set("search_path", schema)
+	parsed.RawQuery = query.Encode()
+	return parsed.String(), nil
+}
 
-	err = migration.Up()
-	if err != nil && err != migrate.ErrNoChange {
-		return nil, errors.Wrap(err, "failed database migration")
+// Ping checks that the database can still be reached
+func (c *connection) Ping(ctx context.Context) error {
+	err := c.db.PingContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed pinging database")
 	}
+	return nil
+}
 
-	return &connection{db}, nil
+// Close closes the connection pool, waiting on any queries already running
+func (c *connection) Close() error {
+	err := c.db.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed closing database")
+	}
+	return nil
 }
@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/yisaj/heavens_throne/entities"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// ExperienceResource contains database methods for player experience data
+type ExperienceResource interface {
+	AwardExperience(ctx context.Context, gains []entities.ExperienceGain) error
+	GetRecentExperience(ctx context.Context, twitterID string, days int32) ([]entities.ExperienceGain, error)
+	GetDayExperience(ctx context.Context, day int32) ([]entities.ExperienceGain, error)
+}
+
+var experienceSourceStrings = map[entities.ExperienceSource]string{
+	entities.ExistenceExperience: "existence",
+	entities.BattleExperience:    "battle",
+	entities.VictoryExperience:   "victory",
+	entities.SurvivalExperience:  "survival",
+	entities.KillExperience:      "kill",
+	entities.DeathExperience:     "death",
+	entities.CaptureExperience:   "capture",
+}
+
+// experienceRow mirrors a row of the experience ledger before its source is
+// translated back into an entity
+type experienceRow struct {
+	Player      int32
+	Day         int32
+	Source      string
+	Amount      int16
+	TargetClass sql.NullString `db:"target_class"`
+}
+
+func (row *experienceRow) toGain() entities.ExperienceGain {
+	gain := entities.ExperienceGain{
+		PlayerID:    row.Player,
+		Day:         row.Day,
+		Amount:      row.Amount,
+		TargetClass: row.TargetClass.String,
+	}
+	for source, str := range experienceSourceStrings {
+		if str == row.Source {
+			gain.Source = source
+			break
+		}
+	}
+	return gain
+}
+
+// AwardExperience records every gain in the experience ledger and adds the
+// totals to each player in one transaction
+func (c *connection) AwardExperience(ctx context.Context, gains []entities.ExperienceGain) error {
+	if len(gains) == 0 {
+		return nil
+	}
+
+	players := make(pq.Int64Array, len(gains))
+	sources := make(pq.StringArray, len(gains))
+	amounts := make(pq.Int64Array, len(gains))
+	targetClasses := make(pq.StringArray, len(gains))
+	for i, gain := range gains {
+		players[i] = int64(gain.PlayerID)
+		sources[i] = experienceSourceStrings[gain.Source]
+		amounts[i] = int64(gain.Amount)
+		targetClasses[i] = gain.TargetClass
+	}
+
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed beginning experience transaction")
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO experience_record (day, player, source, amount, target_class)
+		SELECT calendar.count, gain.player, gain.source::experiencesource, gain.amount, NULLIF(gain.target_class, '')::playerclass
+		FROM calendar, unnest($1::integer[], $2::text[], $3::smallint[], $4::text[]) AS gain (player, source, amount, target_class)`
+	_, err = tx.ExecContext(ctx, query, players, sources, amounts, targetClasses)
+	if err != nil {
+		return errors.Wrap(err, "failed recording experience gains")
+	}
+
+	query = `UPDATE player SET experience = LEAST(player.experience + gain.total, 32767)
+		FROM (SELECT gain.player, SUM(gain.amount) AS total
+			FROM unnest($1::integer[], $2::smallint[]) AS gain (player, amount) GROUP BY gain.player) AS gain
+		WHERE player.id = gain.player`
+	_, err = tx.ExecContext(ctx, query, players, amounts)
+	if err != nil {
+		return errors.Wrap(err, "failed adding player experience")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errors.Wrap(err, "failed committing experience transaction")
+	}
+	return nil
+}
+
+func (c *connection) GetRecentExperience(ctx context.Context, twitterID string, days int32) ([]entities.ExperienceGain, error) {
+	query := `SELECT experience_record.player, experience_record.day, experience_record.source,
+		experience_record.amount, experience_record.target_class
+		FROM experience_record INNER JOIN player ON experience_record.player=player.id, calendar
+		WHERE player.twitter_id=$1 AND experience_record.day > calendar.count - $2
+		ORDER BY experience_record.day DESC, experience_record.amount DESC`
+
+	var rows []experienceRow
+	err := c.db.SelectContext(ctx, &rows, query, twitterID, days)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting recent experience")
+	}
+
+	gains := make([]entities.ExperienceGain, len(rows))
+	for i := range rows {
+		gains[i] = rows[i].toGain()
+	}
+	return gains, nil
+}
+
+func (c *connection) GetDayExperience(ctx context.Context, day int32) ([]entities.ExperienceGain, error) {
+	query := `SELECT player, day, source, amount, target_class FROM experience_record WHERE day=$1
+		ORDER BY player, amount DESC`
+
+	var rows []experienceRow
+	err := c.db.SelectContext(ctx, &rows, query, day)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting day experience")
+	}
+
+	gains := make([]entities.ExperienceGain, len(rows))
+	for i := range rows {
+		gains[i] = rows[i].toGain()
+	}
+	return gains, nil
+}
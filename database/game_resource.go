@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/yisaj/heavens_throne/entities"
 
@@ -13,6 +14,12 @@ type GameResource interface {
 	GetDay(ctx context.Context) (int32, error)
 	IncrementDay(ctx context.Context) error
 	CreateCombatRecord(ctx context.Context, locationID int32, event *entities.CombatEvent) error
+	GetDayRouts(ctx context.Context, day int32) ([]entities.RoutRecord, error)
+	GetDayReturns(ctx context.Context, day int32) ([]entities.ReturnRecord, error)
+	GetBattleSummaries(ctx context.Context, day int32) ([]entities.BattleSummary, error)
+	GetOwnershipRecords(ctx context.Context) ([]entities.OwnershipRecord, error)
+	GetCombatRecords(ctx context.Context, day int32, locationID int32) ([]entities.CombatRecord, error)
+	GetOrderStandings(ctx context.Context) ([]entities.Standing, error)
 }
 
 func (c *connection) GetDay(ctx context.Context) (int32, error) {
@@ -41,27 +48,154 @@ func (c *connection) IncrementDay(ctx context.Context) error {
 var combatTypeStrings = map[entities.CombatEventType]string{
 	entities.Attack:        "attack",
 	entities.CounterAttack: "counterattack",
-	entities.Revive:        "Revive",
+	entities.Revive:        "revive",
+	entities.Rout:          "rout",
 }
 
 var combatResultStrings = map[entities.CombatResult]string{
 	entities.Success:  "success",
 	entities.Failure:  "failure",
 	entities.NoTarget: "notarget",
+	entities.Routed:   "routed",
 }
 
 func (c *connection) CreateCombatRecord(ctx context.Context, locationID int32, event *entities.CombatEvent) error {
-	query := `INSERT INTO combat_record (day, location, type, attacker, defender, attacker_class, defender_class, result)
-		SELECT count, $1, $2, attacker.id, defender.id, attacker.class, defender.class, $3
-		FROM calendar, player AS attacker, player AS defender WHERE attacker.twitter_id=$4 AND defender.twitter_id=$5`
+	// the defender is missing for events that have no target, like a rout. the
+	// classes and stances are the ones fought with, whatever the players have
+	// since become
+	query := `INSERT INTO combat_record (day, location, type, attacker, defender, attacker_class, defender_class,
+			attacker_stance, defender_stance, result)
+		SELECT count, $1, $2, attacker.id, defender.id, $6, $7, $8, $9, $3
+		FROM calendar, player AS attacker LEFT JOIN player AS defender ON defender.twitter_id=$5
+		WHERE attacker.twitter_id=$4`
 
-	attacker := event.Attacker
-	defender := event.Defender
+	var defenderID, defenderClass, defenderStance sql.NullString
+	if event.Defender != nil {
+		defenderID = sql.NullString{String: event.Defender.TwitterID, Valid: true}
+		defenderClass = sql.NullString{String: event.Defender.Class, Valid: true}
+		defenderStance = sql.NullString{String: event.Defender.Stance, Valid: true}
+	}
 
 	_, err := c.db.ExecContext(ctx, query, locationID, combatTypeStrings[event.EventType],
-		combatResultStrings[event.Result], attacker.TwitterID, defender.TwitterID)
+		combatResultStrings[event.Result], event.Attacker.TwitterID, defenderID, event.Attacker.Class, defenderClass,
+		event.Attacker.Stance, defenderStance)
 	if err != nil {
 		return errors.Wrap(err, "failed creating combat record")
 	}
 	return nil
 }
+
+func (c *connection) GetDayRouts(ctx context.Context, day int32) ([]entities.RoutRecord, error) {
+	query := `SELECT player.twitter_id, location.name FROM move_record
+		INNER JOIN player ON move_record.player=player.id
+		INNER JOIN location ON move_record.location=location.id
+		WHERE move_record.day=$1 AND move_record.routed`
+
+	var routs []entities.RoutRecord
+	err := c.db.SelectContext(ctx, &routs, query, day)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting day routs")
+	}
+	return routs, nil
+}
+
+// GetDayReturns returns the players who came back from the dead on a day after
+// dying on an earlier one, with where they came back
+func (c *connection) GetDayReturns(ctx context.Context, day int32) ([]entities.ReturnRecord, error) {
+	query := `SELECT player.twitter_id, location.name, death_record.day AS death_day,
+			EXISTS (SELECT 1 FROM temple WHERE temple.location=player.location
+				AND temple.martial_order=player.martial_order) AS at_temple
+		FROM death_record
+		INNER JOIN player ON death_record.player=player.id
+		INNER JOIN location ON player.location=location.id
+		WHERE death_record.revived_day=$1 AND death_record.day < $1`
+
+	var returns []entities.ReturnRecord
+	err := c.db.SelectContext(ctx, &returns, query, day)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting day returns")
+	}
+	return returns, nil
+}
+
+// GetBattleSummaries sizes up every battle fought on a day, with a row for each
+// order at each battle. an order's casualties are the units struck down at
+// least once, whether or not they were revived
+func (c *connection) GetBattleSummaries(ctx context.Context, day int32) ([]entities.BattleSummary, error) {
+	query := `WITH participant AS (
+			SELECT location, attacker AS player FROM combat_record WHERE day=$1
+			UNION SELECT location, defender FROM combat_record WHERE day=$1 AND defender IS NOT NULL
+		), casualty AS (
+			SELECT DISTINCT location, defender AS player FROM combat_record
+			WHERE day=$1 AND type IN ('attack', 'counterattack') AND result='success'
+		)
+		SELECT participant.location, location.name AS location_name, player.martial_order,
+			COUNT(*) AS combatants, COUNT(casualty.player) AS casualties
+		FROM participant
+		INNER JOIN player ON participant.player=player.id
+		INNER JOIN location ON participant.location=location.id
+		LEFT JOIN casualty ON casualty.location=participant.location AND casualty.player=participant.player
+		GROUP BY participant.location, location.name, player.martial_order
+		ORDER BY participant.location, player.martial_order`
+
+	var summaries []entities.BattleSummary
+	err := c.db.SelectContext(ctx, &summaries, query, day)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting battle summaries")
+	}
+	return summaries, nil
+}
+
+func (c *connection) GetOwnershipRecords(ctx context.Context) ([]entities.OwnershipRecord, error) {
+	query := `SELECT ownership_record.day, ownership_record.location, location.name AS location_name,
+			ownership_record.event, ownership_record.martial_order
+		FROM ownership_record INNER JOIN location ON ownership_record.location=location.id
+		ORDER BY ownership_record.day, ownership_record.location`
+
+	var records []entities.OwnershipRecord
+	err := c.db.SelectContext(ctx, &records, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting ownership records")
+	}
+	return records, nil
+}
+
+// GetCombatRecords returns every combat event of a battle, in the order they
+// were recorded
+func (c *connection) GetCombatRecords(ctx context.Context, day int32, locationID int32) ([]entities.CombatRecord, error) {
+	query := `SELECT combat_record.type, combat_record.result,
+			attacker.martial_order AS attacker_order, combat_record.attacker_class, combat_record.attacker_stance,
+			defender.martial_order AS defender_order, combat_record.defender_class, combat_record.defender_stance
+		FROM combat_record
+		INNER JOIN player AS attacker ON combat_record.attacker=attacker.id
+		LEFT JOIN player AS defender ON combat_record.defender=defender.id
+		WHERE combat_record.day=$1 AND combat_record.location=$2
+		ORDER BY combat_record.id`
+
+	var records []entities.CombatRecord
+	err := c.db.SelectContext(ctx, &records, query, day, locationID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting combat records")
+	}
+	return records, nil
+}
+
+// GetOrderStandings ranks the orders by the land they hold, then by the units
+// they have standing
+func (c *connection) GetOrderStandings(ctx context.Context) ([]entities.Standing, error) {
+	query := `SELECT temple.martial_order,
+			(SELECT COUNT(*) FROM location WHERE location.owner=temple.martial_order) AS locations,
+			(SELECT COUNT(*) FROM player WHERE player.martial_order=temple.martial_order
+				AND player.location IS NOT NULL) AS units,
+			(SELECT COUNT(*) FROM combat_record INNER JOIN player ON combat_record.attacker=player.id
+				WHERE player.martial_order=temple.martial_order AND combat_record.type IN ('attack', 'counterattack')
+				AND combat_record.result='success') AS kills
+		FROM temple ORDER BY locations DESC, units DESC, temple.martial_order`
+
+	var standings []entities.Standing
+	err := c.db.SelectContext(ctx, &standings, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting order standings")
+	}
+	return standings, nil
+}
@@ -0,0 +1,148 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/yisaj/heavens_throne/entities"
+
+	"github.com/pkg/errors"
+)
+
+// LeadershipResource contains database methods for order commanders and their
+// elections
+type LeadershipResource interface {
+	CastVote(ctx context.Context, twitterID string, candidateID int32) (bool, error)
+	GetCommander(ctx context.Context, order string) (*entities.Commander, error)
+	GetVoteTally(ctx context.Context, order string) ([]entities.VoteTally, error)
+	HoldElection(ctx context.Context, order string, termLength int32) (*entities.Commander, error)
+	SetRallyPoint(ctx context.Context, order string, locationID sql.NullInt32) error
+	SetObjective(ctx context.Context, order string, objective string) error
+}
+
+// CastVote records the player's vote for an active member of their order,
+// replacing any earlier vote. returns false if there's no such member
+func (c *connection) CastVote(ctx context.Context, twitterID string, candidateID int32) (bool, error) {
+	query := `WITH ballot AS (
+			SELECT voter.id AS voter, candidate.id AS candidate, voter.martial_order FROM player AS voter, player AS candidate
+			WHERE voter.twitter_id=$1 AND candidate.id=$2 AND candidate.martial_order=voter.martial_order AND candidate.active
+		), vote AS (
+			INSERT INTO commander_vote (voter, candidate, martial_order, day)
+			SELECT ballot.voter, ballot.candidate, ballot.martial_order, calendar.count FROM ballot, calendar
+			ON CONFLICT (voter) DO UPDATE SET candidate=EXCLUDED.candidate, day=EXCLUDED.day
+		)
+		SELECT COUNT(*) FROM ballot`
+
+	var found int
+	err := c.db.GetContext(ctx, &found, query, twitterID, candidateID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed casting vote")
+	}
+	return found > 0, nil
+}
+
+// GetCommander returns the order's current term of command, or nil if the last
+// term is over
+func (c *connection) GetCommander(ctx context.Context, order string) (*entities.Commander, error) {
+	query := `SELECT commander.*, location.name AS rally_point_name
+		FROM commander LEFT JOIN location ON commander.rally_point=location.id, calendar
+		WHERE commander.martial_order=$1 AND commander.end_day >= calendar.count
+		ORDER BY commander.id DESC LIMIT 1`
+
+	var commander entities.Commander
+	err := c.db.GetContext(ctx, &commander, query, order)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting commander")
+	}
+	return &commander, nil
+}
+
+// GetVoteTally returns the votes the order's active members have for its next
+// commander, in the order the election would rank them
+func (c *connection) GetVoteTally(ctx context.Context, order string) ([]entities.VoteTally, error) {
+	query := `SELECT commander_vote.candidate, COUNT(*) AS votes FROM commander_vote
+		INNER JOIN player AS voter ON commander_vote.voter=voter.id
+		INNER JOIN player AS candidate ON commander_vote.candidate=candidate.id
+		WHERE commander_vote.martial_order=$1 AND voter.martial_order=$1 AND candidate.martial_order=$1
+		AND voter.active AND candidate.active
+		GROUP BY commander_vote.candidate
+		ORDER BY votes DESC, MIN(commander_vote.day), commander_vote.candidate`
+
+	var tallies []entities.VoteTally
+	err := c.db.SelectContext(ctx, &tallies, query, order)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting vote tally")
+	}
+	return tallies, nil
+}
+
+// HoldElection tallies the votes of the order's active members and starts a new
+// term for the winner. ties go to whoever was voted for first. the ballots are
+// cleared for the next election. returns nil if nobody was voted for
+func (c *connection) HoldElection(ctx context.Context, order string, termLength int32) (*entities.Commander, error) {
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed beginning election transaction")
+	}
+	defer tx.Rollback()
+
+	query := `WITH tally AS (
+			SELECT commander_vote.candidate, COUNT(*) AS votes FROM commander_vote
+			INNER JOIN player AS voter ON commander_vote.voter=voter.id
+			INNER JOIN player AS candidate ON commander_vote.candidate=candidate.id
+			WHERE commander_vote.martial_order=$1 AND voter.martial_order=$1 AND candidate.martial_order=$1
+			AND voter.active AND candidate.active
+			GROUP BY commander_vote.candidate
+			ORDER BY votes DESC, MIN(commander_vote.day), commander_vote.candidate LIMIT 1
+		)
+		INSERT INTO commander (martial_order, player, votes, start_day, end_day)
+		SELECT $1, tally.candidate, tally.votes, calendar.count, calendar.count + $2 - 1 FROM tally, calendar
+		RETURNING *`
+
+	var commander entities.Commander
+	err = tx.GetContext(ctx, &commander, query, order, termLength)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed electing commander")
+	}
+
+	query = `DELETE FROM commander_vote WHERE martial_order=$1`
+	_, err = tx.ExecContext(ctx, query, order)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed clearing votes")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed committing election transaction")
+	}
+	return &commander, nil
+}
+
+func (c *connection) SetRallyPoint(ctx context.Context, order string, locationID sql.NullInt32) error {
+	query := `UPDATE commander SET rally_point=$1
+		WHERE id=(SELECT MAX(id) FROM commander WHERE martial_order=$2)`
+
+	_, err := c.db.ExecContext(ctx, query, locationID, order)
+	if err != nil {
+		return errors.Wrap(err, "failed setting rally point")
+	}
+	return nil
+}
+
+func (c *connection) SetObjective(ctx context.Context, order string, objective string) error {
+	// an empty objective clears it
+	query := `UPDATE commander SET objective=NULLIF($1, '')
+		WHERE id=(SELECT MAX(id) FROM commander WHERE martial_order=$2)`
+
+	_, err := c.db.ExecContext(ctx, query, objective, order)
+	if err != nil {
+		return errors.Wrap(err, "failed setting objective")
+	}
+	return nil
+}
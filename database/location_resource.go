@@ -3,6 +3,7 @@ package database
 import (
 	"context"
 
+	"github.com/yisaj/heavens_throne/atlas"
 	"github.com/yisaj/heavens_throne/entities"
 
 	"github.com/pkg/errors"
@@ -12,11 +13,14 @@ import (
 type LocationResource interface {
 	GetLocation(ctx context.Context, locationID int32) (*entities.Location, error)
 	GetAdjacentLocations(ctx context.Context, locationID int32) ([]int32, error)
+	GetLocations(ctx context.Context) ([]entities.Location, error)
+	GetMapGraph(ctx context.Context) (atlas.Graph, error)
+	GetRetreatLocations(ctx context.Context, locationID int32, order string) ([]int32, error)
 	GetTempleLocation(ctx context.Context, order string) (int32, error)
-	GetCurrentLogistics(ctx context.Context, order string) ([]entities.Logistic, error)
-	GetNextLogistics(ctx context.Context, order string) ([]entities.Logistic, error)
-	GetArrivingLogistics(ctx context.Context, locationID int32) ([]entities.Logistic, error)
-	GetLeavingLogistics(ctx context.Context, locationID int32) ([]entities.Logistic, error)
+	GetOrderMovements(ctx context.Context, order string) ([]entities.Movement, error)
+	GetLocationPopulations(ctx context.Context) ([]entities.Population, error)
+	GetVisibleLocations(ctx context.Context, order string) ([]int32, error)
+	GetEnemyPresence(ctx context.Context, locationID int32, order string) ([]entities.Presence, error)
 	SetLocationOwner(ctx context.Context, locationID int32, owner string) error
 	SetLocationOccupier(ctx context.Context, locationID int32, occupier string) error
 	GetBattleLocations(ctx context.Context) ([]int32, error)
@@ -33,6 +37,17 @@ func (c *connection) GetLocation(ctx context.Context, locationID int32) (*entiti
 	return &location, nil
 }
 
+func (c *connection) GetLocations(ctx context.Context) ([]entities.Location, error) {
+	query := `SELECT * FROM location ORDER BY id`
+
+	var locations []entities.Location
+	err := c.db.SelectContext(ctx, &locations, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting locations")
+	}
+	return locations, nil
+}
+
 func (c *connection) GetAdjacentLocations(ctx context.Context, locationID int32) ([]int32, error) {
 	query := `SELECT adjacent FROM adjacent_location WHERE location=$1`
 
@@ -44,6 +59,37 @@ func (c *connection) GetAdjacentLocations(ctx context.Context, locationID int32)
 	return adjacentLocations, nil
 }
 
+func (c *connection) GetMapGraph(ctx context.Context) (atlas.Graph, error) {
+	query := `SELECT location, adjacent FROM adjacent_location`
+
+	var adjacencies []struct {
+		Location int32
+		Adjacent int32
+	}
+	err := c.db.SelectContext(ctx, &adjacencies, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting map graph")
+	}
+
+	graph := make(atlas.Graph)
+	for _, adjacency := range adjacencies {
+		graph[adjacency.Location] = append(graph[adjacency.Location], adjacency.Adjacent)
+	}
+	return graph, nil
+}
+
+func (c *connection) GetRetreatLocations(ctx context.Context, locationID int32, order string) ([]int32, error) {
+	query := `SELECT adjacent FROM adjacent_location INNER JOIN location ON adjacent_location.adjacent=location.id
+		WHERE adjacent_location.location=$1 AND location.owner=$2`
+
+	var retreatLocations []int32
+	err := c.db.SelectContext(ctx, &retreatLocations, query, locationID, order)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting retreat locations")
+	}
+	return retreatLocations, nil
+}
+
 func (c *connection) GetTempleLocation(ctx context.Context, order string) (int32, error) {
 	query := `SELECT location FROM temple WHERE martial_order=$1`
 
@@ -55,58 +101,67 @@ func (c *connection) GetTempleLocation(ctx context.Context, order string) (int32
 	return location, nil
 }
 
-func (c *connection) GetCurrentLogistics(ctx context.Context, order string) ([]entities.Logistic, error) {
-	query := `SELECT location.name, COUNT(*) FROM player
-    	INNER JOIN location ON player.location=location.id WHERE player.martial_order=$1 GROUP BY location.name`
-
-	var logistics []entities.Logistic
-	err := c.db.SelectContext(ctx, &logistics, query, order)
+// GetOrderMovements counts an order's units by where they stand and where
+// they're headed. units staying put move from a location to itself
+func (c *connection) GetOrderMovements(ctx context.Context, order string) ([]entities.Movement, error) {
+	query := `SELECT source.id AS source, source.name AS source_name,
+			destination.id AS destination, destination.name AS destination_name, COUNT(*) FROM player
+		INNER JOIN location AS source ON player.location=source.id
+		INNER JOIN location AS destination ON COALESCE(player.next_location, player.location)=destination.id
+		WHERE player.martial_order=$1
+		GROUP BY source.id, source.name, destination.id, destination.name`
+
+	var movements []entities.Movement
+	err := c.db.SelectContext(ctx, &movements, query, order)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed getting current logistics")
+		return nil, errors.Wrap(err, "failed getting order movements")
 	}
-	return logistics, nil
+	return movements, nil
 }
 
-func (c *connection) GetNextLogistics(ctx context.Context, order string) ([]entities.Logistic, error) {
-	query := `SELECT location.name, COUNT(*) FROM player
-    	INNER JOIN location ON player.next_location=location.id WHERE player.martial_order=$1 GROUP BY location.name`
+// GetLocationPopulations counts the living units at every location with any,
+// across all orders
+func (c *connection) GetLocationPopulations(ctx context.Context) ([]entities.Population, error) {
+	query := `SELECT location, COUNT(*) FROM player WHERE location IS NOT NULL GROUP BY location ORDER BY location`
 
-	var logistics []entities.Logistic
-	err := c.db.SelectContext(ctx, &logistics, query, order)
+	var populations []entities.Population
+	err := c.db.SelectContext(ctx, &populations, query)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed getting next logistics")
+		return nil, errors.Wrap(err, "failed getting location populations")
 	}
-	return logistics, nil
+	return populations, nil
 }
 
-func (c *connection) GetArrivingLogistics(ctx context.Context, locationID int32) ([]entities.Logistic, error) {
-	query := `SELECT prev_location.name, COUNT(*) FROM player
-		INNER JOIN location AS next_location ON player.next_location=next_location.id
-		INNER JOIN location AS prev_location ON player.location=prev_location.id
-		WHERE next_location.id=$1
-		GROUP BY prev_location.name`
-
-	var logistics []entities.Logistic
-	err := c.db.SelectContext(ctx, &logistics, query, locationID)
+// GetVisibleLocations returns the locations an order can see into: wherever its
+// units stand or it occupies, and everywhere bordering those
+func (c *connection) GetVisibleLocations(ctx context.Context, order string) ([]int32, error) {
+	query := `WITH held AS (
+			SELECT location AS id FROM player WHERE martial_order=$1 AND location IS NOT NULL
+			UNION SELECT id FROM location WHERE occupier=$1
+		)
+		SELECT id FROM held
+		UNION SELECT adjacent_location.adjacent FROM adjacent_location INNER JOIN held ON adjacent_location.location=held.id
+		ORDER BY id`
+
+	var locations []int32
+	err := c.db.SelectContext(ctx, &locations, query, order)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed getting arriving logistics")
+		return nil, errors.Wrap(err, "failed getting visible locations")
 	}
-	return logistics, nil
+	return locations, nil
 }
 
-func (c *connection) GetLeavingLogistics(ctx context.Context, locationID int32) ([]entities.Logistic, error) {
-	query := `SELECT next_location.name, COUNT(*) FROM player
-		INNER JOIN location AS next_location ON player.next_location=next_location.id
-		INNER JOIN location AS prev_location ON player.location=prev_location.id
-		WHERE prev_location.id=$1
-		GROUP BY next_location.name`
+// GetEnemyPresence counts the living units of every other order at a location
+func (c *connection) GetEnemyPresence(ctx context.Context, locationID int32, order string) ([]entities.Presence, error) {
+	query := `SELECT martial_order, COUNT(*) FROM player WHERE location=$1 AND martial_order != $2
+		GROUP BY martial_order ORDER BY martial_order`
 
-	var logistics []entities.Logistic
-	err := c.db.SelectContext(ctx, &logistics, query, locationID)
+	var presence []entities.Presence
+	err := c.db.SelectContext(ctx, &presence, query, locationID, order)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed getting arriving logistics")
+		return nil, errors.Wrap(err, "failed getting enemy presence")
 	}
-	return logistics, nil
+	return presence, nil
 }
 
 // TODO ENGINEER: think about merging recording queries into transactions, somehow?
@@ -0,0 +1,121 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/yisaj/heavens_throne/entities"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// MarchResource contains database methods for multi-day movement orders
+type MarchResource interface {
+	GetMarches(ctx context.Context) ([]entities.March, error)
+	GetPlayerMarch(ctx context.Context, twitterID string) (*entities.March, error)
+	SetPlayerMarch(ctx context.Context, twitterID string, route []int32) error
+	CancelPlayerMarch(ctx context.Context, twitterID string) error
+}
+
+// marchRow mirrors a march joined with its player before the route array is
+// converted
+type marchRow struct {
+	TwitterID string `db:"twitter_id"`
+	Location  sql.NullInt32
+	Route     pq.Int64Array
+}
+
+func (row *marchRow) toMarch() entities.March {
+	march := entities.March{
+		TwitterID: row.TwitterID,
+		Location:  row.Location,
+		Route:     make([]int32, len(row.Route)),
+	}
+	for i, location := range row.Route {
+		march.Route[i] = int32(location)
+	}
+	return march
+}
+
+func (c *connection) GetMarches(ctx context.Context) ([]entities.March, error) {
+	query := `SELECT player.twitter_id, player.location, march.route FROM march
+		INNER JOIN player ON march.player=player.id`
+
+	var rows []marchRow
+	err := c.db.SelectContext(ctx, &rows, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting marches")
+	}
+
+	marches := make([]entities.March, len(rows))
+	for i := range rows {
+		marches[i] = rows[i].toMarch()
+	}
+	return marches, nil
+}
+
+func (c *connection) GetPlayerMarch(ctx context.Context, twitterID string) (*entities.March, error) {
+	query := `SELECT player.twitter_id, player.location, march.route FROM march
+		INNER JOIN player ON march.player=player.id WHERE player.twitter_id=$1`
+
+	var row marchRow
+	err := c.db.GetContext(ctx, &row, query, twitterID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting player march")
+	}
+
+	march := row.toMarch()
+	return &march, nil
+}
+
+// SetPlayerMarch points the player at the first step of the route and stores the
+// rest of it for the days to come
+func (c *connection) SetPlayerMarch(ctx context.Context, twitterID string, route []int32) error {
+	if len(route) == 0 {
+		return errors.New("failed setting player march: empty route")
+	}
+
+	steps := make(pq.Int64Array, len(route))
+	for i, location := range route {
+		steps[i] = int64(location)
+	}
+
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed beginning march transaction")
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE player SET next_location=$1 WHERE twitter_id=$2`
+	_, err = tx.ExecContext(ctx, query, route[0], twitterID)
+	if err != nil {
+		return errors.Wrap(err, "failed updating player next location")
+	}
+
+	query = `INSERT INTO march (player, route) SELECT id, $1 FROM player WHERE twitter_id=$2
+		ON CONFLICT (player) DO UPDATE SET route=EXCLUDED.route`
+	_, err = tx.ExecContext(ctx, query, steps, twitterID)
+	if err != nil {
+		return errors.Wrap(err, "failed storing player march")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errors.Wrap(err, "failed committing march transaction")
+	}
+	return nil
+}
+
+func (c *connection) CancelPlayerMarch(ctx context.Context, twitterID string) error {
+	query := `DELETE FROM march USING player WHERE march.player=player.id AND player.twitter_id=$1`
+
+	_, err := c.db.ExecContext(ctx, query, twitterID)
+	if err != nil {
+		return errors.Wrap(err, "failed cancelling player march")
+	}
+	return nil
+}
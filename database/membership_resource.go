@@ -0,0 +1,43 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+)
+
+// MembershipResource contains database methods for which game each player is
+// playing. memberships are only kept by the main game, and the other games'
+// schemas don't have them
+type MembershipResource interface {
+	GetGameMembership(ctx context.Context, twitterID string) (string, error)
+	SetGameMembership(ctx context.Context, twitterID string, game string) error
+}
+
+// GetGameMembership returns the game a player picked, or an empty string if
+// they never picked one
+func (c *connection) GetGameMembership(ctx context.Context, twitterID string) (string, error) {
+	query := `SELECT game FROM game_membership WHERE twitter_id = $1`
+
+	var game string
+	err := c.db.GetContext(ctx, &game, query, twitterID)
+	if err == sql.ErrNoRows {
+		return "", nil
+	} else if err != nil {
+		return "", errors.Wrap(err, "failed getting game membership")
+	}
+	return game, nil
+}
+
+func (c *connection) SetGameMembership(ctx context.Context, twitterID string, game string) error {
+	query := `INSERT INTO game_membership (twitter_id, game)
+		VALUES ($1, $2)
+		ON CONFLICT (twitter_id) DO UPDATE SET game = EXCLUDED.game`
+
+	_, err := c.db.ExecContext(ctx, query, twitterID, game)
+	if err != nil {
+		return errors.Wrap(err, "failed setting game membership")
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/yisaj/heavens_throne/metrics"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var queryDuration = metrics.NewHistogram("db_query_duration_seconds",
+	"How long each database query took, by the Resource method that made it.", metrics.DefaultBuckets, "method")
+
+// timedDB times every query by the Resource method that made it
+type timedDB struct {
+	*sqlx.DB
+}
+
+// timedTx times every query in a transaction by the Resource method that began it
+type timedTx struct {
+	*sqlx.Tx
+	method string
+}
+
+// callerMethod names the Resource method a query came from, skipping the frames
+// of the timed wrapper in between
+func callerMethod() string {
+	pcs := make([]uintptr, 1)
+	if runtime.Callers(3, pcs) == 0 {
+		return "unknown"
+	}
+	frame, _ := runtime.CallersFrames(pcs).Next()
+	return frame.Function[strings.LastIndex(frame.Function, ".")+1:]
+}
+
+func observeQuery(method string, started time.Time) {
+	queryDuration.Observe(time.Since(started).Seconds(), method)
+}
+
+func (db timedDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	defer observeQuery(callerMethod(), time.Now())
+	return db.DB.ExecContext(ctx, query, args...)
+}
+
+func (db timedDB) GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	defer observeQuery(callerMethod(), time.Now())
+	return db.DB.GetContext(ctx, dest, query, args...)
+}
+
+func (db timedDB) SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	defer observeQuery(callerMethod(), time.Now())
+	return db.DB.SelectContext(ctx, dest, query, args...)
+}
+
+func (db timedDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	defer observeQuery(callerMethod(), time.Now())
+	return db.DB.QueryContext(ctx, query, args...)
+}
+
+func (db timedDB) BeginTxx(ctx context.Context, opts *sql.TxOptions) (*timedTx, error) {
+	method := callerMethod()
+	tx, err := db.DB.BeginTxx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &timedTx{tx, method}, nil
+}
+
+func (tx *timedTx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	defer observeQuery(tx.method, time.Now())
+	return tx.Tx.ExecContext(ctx, query, args...)
+}
+
+func (tx *timedTx) GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	defer observeQuery(tx.method, time.Now())
+	return tx.Tx.GetContext(ctx, dest, query, args...)
+}
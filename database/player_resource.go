@@ -19,11 +19,17 @@ type PlayerResource interface {
 	UpdatePlayerDestination(ctx context.Context, twitterID string, destination int32) error
 	MovePlayers(ctx context.Context) error
 	TogglePlayerUpdates(ctx context.Context, twitterID string) (bool, error)
+	SetPlayerStance(ctx context.Context, twitterID string, stance string) error
+	SetPlayerTargetPriority(ctx context.Context, twitterID string, family string) error
 	AdvancePlayer(ctx context.Context, twitterID string, class string, rank int16) error
 	GetAllPlayers(ctx context.Context) ([]entities.Player, error)
 	GetAlivePlayers(ctx context.Context) ([]entities.Player, error)
+	GetOrderPlayers(ctx context.Context, order string) ([]entities.Player, error)
 	KillPlayer(ctx context.Context, twitterID string) error
+	CreateDeathRecord(ctx context.Context, day int32, locationID int32, playerID int32, cause entities.DeathCause) error
+	RoutPlayer(ctx context.Context, twitterID string, destination int32) error
 	RevivePlayers(ctx context.Context) error
+	GetPlayerDeath(ctx context.Context, twitterID string) (*entities.Death, error)
 }
 
 func (c *connection) CreatePlayer(ctx context.Context, twitterID string, martialOrder string, location int32) (*entities.Player, error) {
@@ -122,6 +128,27 @@ func (c *connection) TogglePlayerUpdates(ctx context.Context, twitterID string)
 	return receiveUpdates, nil
 }
 
+func (c *connection) SetPlayerStance(ctx context.Context, twitterID string, stance string) error {
+	query := `UPDATE player SET stance=$1 WHERE twitter_id=$2`
+
+	_, err := c.db.ExecContext(ctx, query, stance, twitterID)
+	if err != nil {
+		return errors.Wrap(err, "failed setting player stance")
+	}
+	return nil
+}
+
+func (c *connection) SetPlayerTargetPriority(ctx context.Context, twitterID string, family string) error {
+	// an empty family clears the target priority
+	query := `UPDATE player SET target_priority=NULLIF($1, '')::classfamily WHERE twitter_id=$2`
+
+	_, err := c.db.ExecContext(ctx, query, family, twitterID)
+	if err != nil {
+		return errors.Wrap(err, "failed setting player target priority")
+	}
+	return nil
+}
+
 func (c *connection) AdvancePlayer(ctx context.Context, twitterID string, class string, rank int16) error {
 	query := `UPDATE player SET class=$1, rank=$2, experience=experience - 100 WHERE twitter_id=$3 RETURNING *`
 
@@ -154,6 +181,17 @@ func (c *connection) GetAlivePlayers(ctx context.Context) ([]entities.Player, er
 	return players, nil
 }
 
+func (c *connection) GetOrderPlayers(ctx context.Context, order string) ([]entities.Player, error) {
+	query := `SELECT * FROM player WHERE martial_order=$1 AND active`
+
+	var players []entities.Player
+	err := c.db.SelectContext(ctx, &players, query, order)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting order players")
+	}
+	return players, nil
+}
+
 func (c *connection) KillPlayer(ctx context.Context, twitterID string) error {
 	// make a record of player death movement before you kill them
 	query := `INSERT INTO move_record (day, location, player) SELECT calendar.count, NULL, player.id
@@ -171,6 +209,23 @@ func (c *connection) KillPlayer(ctx context.Context, twitterID string) error {
 	return nil
 }
 
+func (c *connection) RoutPlayer(ctx context.Context, twitterID string, destination int32) error {
+	// make a record of the player's retreat before you move them
+	query := `INSERT INTO move_record (day, location, player, routed) SELECT calendar.count, $1, player.id, TRUE
+		FROM calendar, player WHERE player.twitter_id = $2`
+	_, err := c.db.ExecContext(ctx, query, destination, twitterID)
+	if err != nil {
+		return errors.Wrap(err, "failed recording player rout movement")
+	}
+
+	query = `UPDATE player SET location=$1, next_location=$1 WHERE twitter_id=$2`
+	_, err = c.db.ExecContext(ctx, query, destination, twitterID)
+	if err != nil {
+		return errors.Wrap(err, "failed routing player")
+	}
+	return nil
+}
+
 func (c *connection) RevivePlayers(ctx context.Context) error {
 	// make a record of player revival movement before you revive them
 	query := `INSERT INTO move_record (day, location, player) SELECT calendar.count, temple.location, player.id
@@ -181,6 +236,15 @@ func (c *connection) RevivePlayers(ctx context.Context) error {
 		return errors.Wrap(err, "failed recording player revival movement")
 	}
 
+	query = `UPDATE death_record SET revived_day = calendar.count
+		FROM calendar, temple, location, player WHERE death_record.player = player.id AND death_record.revived_day IS NULL
+		AND player.location IS NULL AND player.martial_order = temple.martial_order
+		AND temple.martial_order = location.owner AND temple.location = location.id`
+	_, err = c.db.ExecContext(ctx, query)
+	if err != nil {
+		return errors.Wrap(err, "failed recording player revival")
+	}
+
 	query = `UPDATE player SET location = temple.location, next_location = temple.location
 	FROM temple, location WHERE player.location IS NULL AND player.martial_order=temple.martial_order
 	AND temple.martial_order=location.owner AND temple.location=location.id`
@@ -190,3 +254,37 @@ func (c *connection) RevivePlayers(ctx context.Context) error {
 	}
 	return nil
 }
+
+// CreateDeathRecord records where, when and how a player died
+func (c *connection) CreateDeathRecord(ctx context.Context, day int32, locationID int32, playerID int32, cause entities.DeathCause) error {
+	query := `INSERT INTO death_record (day, location, player, cause) VALUES ($1, $2, $3, $4)`
+	_, err := c.db.ExecContext(ctx, query, day, locationID, playerID, string(cause))
+	if err != nil {
+		return errors.Wrap(err, "failed creating death record")
+	}
+	return nil
+}
+
+// GetPlayerDeath returns the player's latest death, along with the class of
+// whoever landed the killing blow. nil if they've never died
+func (c *connection) GetPlayerDeath(ctx context.Context, twitterID string) (*entities.Death, error) {
+	query := `SELECT death_record.day, location.name AS location_name, death_record.cause, death_record.revived_day,
+			(SELECT combat_record.attacker_class FROM combat_record WHERE combat_record.defender=death_record.player
+				AND combat_record.day=death_record.day AND combat_record.location=death_record.location
+				AND combat_record.type IN ('attack', 'counterattack') AND combat_record.result='success'
+				LIMIT 1) AS killer_class
+		FROM death_record
+		INNER JOIN player ON death_record.player=player.id
+		INNER JOIN location ON death_record.location=location.id
+		WHERE player.twitter_id=$1 ORDER BY death_record.id DESC LIMIT 1`
+
+	var death entities.Death
+	err := c.db.GetContext(ctx, &death, query, twitterID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting player death")
+	}
+	return &death, nil
+}
@@ -0,0 +1,106 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/yisaj/heavens_throne/entities"
+
+	"github.com/pkg/errors"
+)
+
+// the advisory lock every instance takes before running a game's schedule,
+// alongside the game's schema. the number itself means nothing, it only has to
+// be the same everywhere
+const scheduleLockKey int32 = 0x68746872
+
+// ScheduleResource contains database methods for the simulation schedule
+type ScheduleResource interface {
+	LockSchedule(ctx context.Context) (func() error, error)
+	GetLastScheduledRun(ctx context.Context) (*entities.ScheduledRun, error)
+	StartScheduledRun(ctx context.Context, scheduledFor time.Time, status entities.RunStatus) (bool, error)
+	FinishScheduledRun(ctx context.Context, scheduledFor time.Time, status entities.RunStatus, runErr error) error
+}
+
+// LockSchedule takes the schedule's advisory lock, so only one instance runs a
+// game's schedule at a time. it returns a nil unlock function if another
+// instance already holds it. the lock belongs to a single connection, which is
+// held until unlocked
+func (c *connection) LockSchedule(ctx context.Context) (func() error, error) {
+	conn, err := c.db.Conn(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting a connection for the schedule lock")
+	}
+
+	query := `SELECT pg_try_advisory_lock($1, hashtext(current_schema()))`
+	var locked bool
+	err = conn.QueryRowContext(ctx, query, scheduleLockKey).Scan(&locked)
+	if err != nil || !locked {
+		conn.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed taking the schedule lock")
+		}
+		return nil, nil
+	}
+
+	return func() error {
+		defer conn.Close()
+		query := `SELECT pg_advisory_unlock($1, hashtext(current_schema()))`
+		_, err := conn.ExecContext(context.Background(), query, scheduleLockKey)
+		if err != nil {
+			return errors.Wrap(err, "failed releasing the schedule lock")
+		}
+		return nil
+	}, nil
+}
+
+func (c *connection) GetLastScheduledRun(ctx context.Context) (*entities.ScheduledRun, error) {
+	query := `SELECT scheduled_for, status, started_at, finished_at, error
+		FROM scheduled_run
+		ORDER BY scheduled_for DESC
+		LIMIT 1`
+
+	var run entities.ScheduledRun
+	err := c.db.GetContext(ctx, &run, query)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed getting the last scheduled run")
+	}
+	return &run, nil
+}
+
+// StartScheduledRun records a scheduled run, returning false if it was already
+// recorded
+func (c *connection) StartScheduledRun(ctx context.Context, scheduledFor time.Time, status entities.RunStatus) (bool, error) {
+	query := `INSERT INTO scheduled_run (scheduled_for, status)
+		VALUES ($1, $2)
+		ON CONFLICT (scheduled_for) DO NOTHING`
+
+	result, err := c.db.ExecContext(ctx, query, scheduledFor, status)
+	if err != nil {
+		return false, errors.Wrap(err, "failed recording scheduled run")
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "failed recording scheduled run")
+	}
+	return inserted == 1, nil
+}
+
+func (c *connection) FinishScheduledRun(ctx context.Context, scheduledFor time.Time, status entities.RunStatus, runErr error) error {
+	query := `UPDATE scheduled_run
+		SET status = $2, finished_at = now(), error = $3
+		WHERE scheduled_for = $1`
+
+	var message sql.NullString
+	if runErr != nil {
+		message = sql.NullString{String: runErr.Error(), Valid: true}
+	}
+	_, err := c.db.ExecContext(ctx, query, scheduledFor, status, message)
+	if err != nil {
+		return errors.Wrap(err, "failed finishing scheduled run")
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/yisaj/heavens_throne/entities"
+
+	"github.com/pkg/errors"
+)
+
+// StoryResource contains database methods for keeping what the storyteller
+// heard of each day, so the day can still be told after a restart
+type StoryResource interface {
+	SaveDayStory(ctx context.Context, day int32, story *entities.DayStory) error
+	GetDayStory(ctx context.Context, day int32) (*entities.DayStory, error)
+}
+
+// SaveDayStory keeps the story of a day, replacing any told of it before
+func (c *connection) SaveDayStory(ctx context.Context, day int32, story *entities.DayStory) error {
+	query := `INSERT INTO day_story (day, story) VALUES ($1, $2)
+		ON CONFLICT (day) DO UPDATE SET story = EXCLUDED.story`
+
+	encoded, err := json.Marshal(story)
+	if err != nil {
+		return errors.Wrap(err, "failed encoding day story")
+	}
+	_, err = c.db.ExecContext(ctx, query, day, encoded)
+	if err != nil {
+		return errors.Wrap(err, "failed saving day story")
+	}
+	return nil
+}
+
+// GetDayStory returns the story of a day, or nil if none was kept
+func (c *connection) GetDayStory(ctx context.Context, day int32) (*entities.DayStory, error) {
+	query := `SELECT story FROM day_story WHERE day = $1`
+
+	var encoded []byte
+	err := c.db.GetContext(ctx, &encoded, query, day)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed getting day story")
+	}
+
+	var story entities.DayStory
+	err = json.Unmarshal(encoded, &story)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed decoding day story")
+	}
+	return &story, nil
+}
@@ -0,0 +1,145 @@
+package database
+
+import (
+	"context"
+
+	"github.com/yisaj/heavens_throne/entities"
+
+	"github.com/pkg/errors"
+)
+
+// TempleResource describes the db methods for temples changing hands and the
+// orders that fall with them
+type TempleResource interface {
+	GetTemples(ctx context.Context) ([]entities.Temple, error)
+	CreateTempleRecord(ctx context.Context, temple *entities.Temple, event entities.TempleEvent) error
+	GetDayTempleRecords(ctx context.Context, day int32) ([]entities.TempleRecord, error)
+	ReviveOrderPlayers(ctx context.Context, order string, locationID int32, deadSince int32) error
+	EliminateDefeatedOrders(ctx context.Context) ([]string, error)
+	GetDayEliminations(ctx context.Context, day int32) ([]string, error)
+	IsOrderEliminated(ctx context.Context, order string) (bool, error)
+}
+
+func (c *connection) GetTemples(ctx context.Context) ([]entities.Temple, error) {
+	query := `SELECT temple.martial_order, temple.location, location.name AS location_name, location.owner
+		FROM temple INNER JOIN location ON temple.location=location.id ORDER BY temple.martial_order`
+
+	var temples []entities.Temple
+	err := c.db.SelectContext(ctx, &temples, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting temples")
+	}
+	return temples, nil
+}
+
+// CreateTempleRecord records a temple changing hands today. whoever owns the
+// temple's location now is recorded as the captor
+func (c *connection) CreateTempleRecord(ctx context.Context, temple *entities.Temple, event entities.TempleEvent) error {
+	query := `INSERT INTO temple_record (day, location, martial_order, event, captor)
+		SELECT calendar.count, location.id, $2, $3, location.owner FROM calendar, location WHERE location.id=$1`
+
+	_, err := c.db.ExecContext(ctx, query, temple.Location, temple.MartialOrder, string(event))
+	if err != nil {
+		return errors.Wrap(err, "failed creating temple record")
+	}
+	return nil
+}
+
+func (c *connection) GetDayTempleRecords(ctx context.Context, day int32) ([]entities.TempleRecord, error) {
+	query := `SELECT temple_record.day, location.name AS location_name, temple_record.martial_order,
+			temple_record.event, temple_record.captor
+		FROM temple_record INNER JOIN location ON temple_record.location=location.id
+		WHERE temple_record.day=$1`
+
+	var records []entities.TempleRecord
+	err := c.db.SelectContext(ctx, &records, query, day)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting day temple records")
+	}
+	return records, nil
+}
+
+// ReviveOrderPlayers brings back an order's dead at a location, as long as
+// they died on or before the given day
+func (c *connection) ReviveOrderPlayers(ctx context.Context, order string, locationID int32, deadSince int32) error {
+	// players who died before death records were kept count as long dead
+	const waited = `player.location IS NULL AND player.martial_order=$1
+		AND COALESCE((SELECT MAX(death_record.day) FROM death_record
+			WHERE death_record.player=player.id AND death_record.revived_day IS NULL), 0) <= $2`
+
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed beginning order revival transaction")
+	}
+	defer tx.Rollback()
+
+	// make a record of player revival movement before you revive them
+	query := `INSERT INTO move_record (day, location, player) SELECT calendar.count, $3, player.id
+		FROM calendar, player WHERE ` + waited
+	_, err = tx.ExecContext(ctx, query, order, deadSince, locationID)
+	if err != nil {
+		return errors.Wrap(err, "failed recording order revival movement")
+	}
+
+	query = `UPDATE death_record SET revived_day = calendar.count FROM calendar, player
+		WHERE death_record.player = player.id AND death_record.revived_day IS NULL AND ` + waited
+	_, err = tx.ExecContext(ctx, query, order, deadSince)
+	if err != nil {
+		return errors.Wrap(err, "failed recording order revival")
+	}
+
+	query = `UPDATE player SET location = $3, next_location = $3 WHERE ` + waited
+	_, err = tx.ExecContext(ctx, query, order, deadSince, locationID)
+	if err != nil {
+		return errors.Wrap(err, "failed reviving order players")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errors.Wrap(err, "failed committing order revival")
+	}
+	return nil
+}
+
+// EliminateDefeatedOrders knocks every order without its temple, a single
+// living unit, or any land to fall back to out of the war, returning the orders
+// knocked out today
+func (c *connection) EliminateDefeatedOrders(ctx context.Context) ([]string, error) {
+	query := `INSERT INTO elimination (martial_order, day)
+		SELECT temple.martial_order, calendar.count FROM calendar, temple
+		INNER JOIN location ON temple.location=location.id
+		WHERE location.owner IS DISTINCT FROM temple.martial_order
+		AND NOT EXISTS (SELECT 1 FROM player WHERE player.martial_order=temple.martial_order AND player.location IS NOT NULL)
+		AND NOT EXISTS (SELECT 1 FROM location AS held WHERE held.owner=temple.martial_order)
+		ON CONFLICT DO NOTHING
+		RETURNING martial_order`
+
+	var orders []string
+	err := c.db.SelectContext(ctx, &orders, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed eliminating defeated orders")
+	}
+	return orders, nil
+}
+
+func (c *connection) GetDayEliminations(ctx context.Context, day int32) ([]string, error) {
+	query := `SELECT martial_order FROM elimination WHERE day=$1`
+
+	var orders []string
+	err := c.db.SelectContext(ctx, &orders, query, day)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting day eliminations")
+	}
+	return orders, nil
+}
+
+func (c *connection) IsOrderEliminated(ctx context.Context, order string) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM elimination WHERE martial_order=$1)`
+
+	var eliminated bool
+	err := c.db.GetContext(ctx, &eliminated, query, order)
+	if err != nil {
+		return false, errors.Wrap(err, "failed checking order elimination")
+	}
+	return eliminated, nil
+}
@@ -3,6 +3,8 @@ package entities
 import (
 	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 )
 
 // TODO ENGINEER: review database structures and optimize
@@ -19,11 +21,14 @@ type Player struct {
 	Class          string
 	Experience     int16
 	Rank           int16
+	Stance         string
+	TargetPriority sql.NullString `db:"target_priority"`
+	// whether the player gets their order's chat relayed to them
+	ReceiveOrderChat bool `db:"receive_order_chat"`
 }
 
-// FormatClass outputs a pretty formatted string of the player's class and rank
-func (p *Player) FormatClass() string {
-	classTranslation := map[string]string{
+var (
+	classNames = map[string]string{
 		"recruit":       "Initiate",
 		"infantry":      "Infantry",
 		"spear":         "Spear",
@@ -41,16 +46,23 @@ func (p *Player) FormatClass() string {
 		"medic":         "Medic",
 		"healer":        "Healer",
 	}
-
-	rankTranslation := map[int16]string{
+	rankNames = map[int16]string{
 		1: "I",
 		2: "II",
 		3: "III",
 		4: "IV",
 		5: "V",
 	}
+)
 
-	return fmt.Sprintf("%s %s", classTranslation[p.Class], rankTranslation[p.Rank])
+// FormatClassName outputs a pretty formatted string of a class without a rank
+func FormatClassName(class string) string {
+	return classNames[class]
+}
+
+// FormatClass outputs a pretty formatted string of the player's class and rank
+func (p *Player) FormatClass() string {
+	return fmt.Sprintf("%s %s", classNames[p.Class], rankNames[p.Rank])
 }
 
 // Stats defines the stats for a given class and rank
@@ -88,11 +100,79 @@ func (p *Player) GetStats() Stats {
 	return classBaseStats[p.Class]
 }
 
+var classFamilies = map[string]string{
+	"recruit":  "recruit",
+	"infantry": "infantry", "spear": "infantry", "glaivemaster": "infantry", "sword": "infantry", "legionary": "infantry",
+	"cavalry": "cavalry", "heavycavalry": "cavalry", "monsterknight": "cavalry", "lightcavalry": "cavalry", "horsearcher": "cavalry",
+	"ranger": "ranger", "archer": "ranger", "mage": "ranger", "medic": "ranger", "healer": "ranger",
+}
+
+// ClassFamily returns the family a class belongs to. e.g. recruit, infantry,
+// cavalry, or ranger
+func ClassFamily(class string) string {
+	return classFamilies[class]
+}
+
+// Family returns the family of the player's class
+func (p *Player) Family() string {
+	return classFamilies[p.Class]
+}
+
+// StanceModifiers defines how a battle stance changes the way a player fights
+type StanceModifiers struct {
+	Attack       int
+	Defense      int
+	AggroFactor  float64
+	AttackChance float64
+	Support      int
+	Steadfast    bool
+}
+
+// Stances are the battle stances a player can take, in display order
+var Stances = []string{"aggressive", "defensive", "hold", "support"}
+
+// TODO DESIGN: balance stance modifiers
+var stanceModifiers = map[string]StanceModifiers{
+	"aggressive": {Attack: 10, Defense: -10, AggroFactor: 1.25, AttackChance: 1},
+	"defensive":  {Attack: -10, Defense: 15, AggroFactor: 1, AttackChance: 1},
+	"hold":       {Defense: 10, AggroFactor: 1, AttackChance: 0.5, Steadfast: true},
+	"support":    {Attack: -10, AggroFactor: 0.5, AttackChance: 0.5, Support: 20},
+}
+
+// IsStance returns whether the given string names a battle stance
+func IsStance(stance string) bool {
+	_, ok := stanceModifiers[stance]
+	return ok
+}
+
+// GetStanceModifiers returns the modifiers for the player's battle stance
+func (p *Player) GetStanceModifiers() StanceModifiers {
+	modifiers, ok := stanceModifiers[p.Stance]
+	if !ok {
+		return StanceModifiers{AggroFactor: 1, AttackChance: 1}
+	}
+	return modifiers
+}
+
+// ClassFamilies lists the class families a player can prioritize in battle
+var ClassFamilies = []string{"recruit", "infantry", "cavalry", "ranger"}
+
+// IsClassFamily returns whether the given string names a class family
+func IsClassFamily(family string) bool {
+	for _, classFamily := range ClassFamilies {
+		if classFamily == family {
+			return true
+		}
+	}
+	return false
+}
+
 // IsRanged returns whether the player is a ranged class
 func (p *Player) IsRanged() bool {
 	return p.Class == "archer" || p.Class == "mage"
 }
 
+// IsAlive returns whether the player is alive on the map
 func (p *Player) IsAlive() bool {
 	return p.Location.Valid
 }
@@ -106,12 +186,105 @@ type Location struct {
 }
 
 // Logistic defines the logistic object, which provides unit counts relative to
-// a location. mirrors the database
+// a location
 type Logistic struct {
-	LocationName string `db:"name"`
+	LocationName string
 	Count        int32
 }
 
+// Movement counts an order's units moving from one location to another. units
+// staying put move from a location to itself. mirrors the database
+type Movement struct {
+	Source          int32
+	SourceName      string `db:"source_name"`
+	Destination     int32
+	DestinationName string `db:"destination_name"`
+	Count           int32
+}
+
+// Presence counts the units an order has at a location. mirrors the database
+type Presence struct {
+	MartialOrder string `db:"martial_order"`
+	Count        int32
+}
+
+// Population counts every living unit at a location. mirrors the database
+type Population struct {
+	Location int32
+	Count    int32
+}
+
+// BattleSummary sizes up one order's part in a battle. mirrors the database
+type BattleSummary struct {
+	Location     int32
+	LocationName string `db:"location_name"`
+	MartialOrder string `db:"martial_order"`
+	Combatants   int32
+	Casualties   int32
+}
+
+// DayStory is what the storyteller heard of a day: the battles fought, the
+// locations captured and the players who came back. kept in the database as json
+type DayStory struct {
+	Battles  []BattleStory  `json:"battles"`
+	Captures []CaptureStory `json:"captures"`
+	Returns  []ReturnRecord `json:"returns"`
+}
+
+// BattleStory is a battle as it's told, with how each order that fought in it
+// fared
+type BattleStory struct {
+	LocationName string             `json:"location_name"`
+	Victor       string             `json:"victor"`
+	Orders       []BattleOrderStory `json:"orders"`
+}
+
+// BattleOrderStory is how one order fared in a battle
+type BattleOrderStory struct {
+	MartialOrder string `json:"order"`
+	Fought       int    `json:"fought"`
+	Fell         int    `json:"fell"`
+	Fled         int    `json:"fled"`
+}
+
+// CaptureStory is a location captured by an order
+type CaptureStory struct {
+	Location     int32  `json:"location"`
+	MartialOrder string `json:"order"`
+}
+
+// OwnershipRecord details an order occupying or capturing a location on a day.
+// mirrors the database
+type OwnershipRecord struct {
+	Day          int32
+	Location     int32
+	LocationName string `db:"location_name"`
+	Event        string
+	MartialOrder string `db:"martial_order"`
+}
+
+// CombatRecord is a combat event as it was recorded, with the orders and
+// classes of both sides. the defender is missing for events without a target.
+// mirrors the database
+type CombatRecord struct {
+	Type           string
+	Result         string
+	AttackerOrder  string         `db:"attacker_order"`
+	AttackerClass  string         `db:"attacker_class"`
+	AttackerStance sql.NullString `db:"attacker_stance"`
+	DefenderOrder  sql.NullString `db:"defender_order"`
+	DefenderClass  sql.NullString `db:"defender_class"`
+	DefenderStance sql.NullString `db:"defender_stance"`
+}
+
+// Standing sizes up an order for the leaderboards. mirrors the database
+type Standing struct {
+	MartialOrder string `db:"martial_order"`
+	Locations    int32
+	Units        int32
+	Kills        int32
+}
+
 // CombatEventType denotes the actions that can be taken during combat
 type CombatEventType int
 
@@ -120,6 +293,7 @@ const (
 	Attack CombatEventType = iota
 	CounterAttack
 	Revive
+	Rout
 )
 
 // CombatResult denotes the possible outcomes of combat
@@ -131,8 +305,171 @@ const (
 	Success CombatResult = iota
 	Failure
 	NoTarget
+	Routed
 )
 
+// RoutRecord details a player who was routed from a battle and where they fell
+// back to. mirrors the database
+type RoutRecord struct {
+	TwitterID    string `db:"twitter_id"`
+	LocationName string `db:"name"`
+}
+
+// DeathCause denotes how a player died
+type DeathCause string
+
+// All the death causes
+const (
+	Slain   DeathCause = "slain"
+	CutDown DeathCause = "cutdown"
+)
+
+// Death details how and where a player last died, and when they came back if
+// they have. mirrors the database
+type Death struct {
+	Day          int32
+	LocationName string `db:"location_name"`
+	Cause        DeathCause
+	KillerClass  sql.NullString `db:"killer_class"`
+	RevivedDay   sql.NullInt32  `db:"revived_day"`
+}
+
+// Format outputs a sentence describing the death
+func (d *Death) Format() string {
+	if d.Cause == CutDown {
+		return fmt.Sprintf("You were cut down while routing from %s on day %d.", d.LocationName, d.Day)
+	}
+	if d.KillerClass.Valid {
+		return fmt.Sprintf("You were slain by a %s at %s on day %d.", FormatClassName(d.KillerClass.String),
+			d.LocationName, d.Day)
+	}
+	return fmt.Sprintf("You were slain at %s on day %d.", d.LocationName, d.Day)
+}
+
+// ReturnRecord details a player who came back from the dead after waiting on
+// their temple, and whether they came back there or somewhere else their order
+// holds. mirrors the database
+type ReturnRecord struct {
+	TwitterID    string `db:"twitter_id"`
+	LocationName string `db:"name"`
+	DeathDay     int32  `db:"death_day"`
+	AtTemple     bool   `db:"at_temple"`
+}
+
+// Temple is where an order's dead return, as long as the order holds it.
+// mirrors the database
+type Temple struct {
+	MartialOrder string `db:"martial_order"`
+	Location     int32
+	LocationName string `db:"location_name"`
+	Owner        sql.NullString
+}
+
+// Held returns whether the temple is in its own order's hands
+func (t *Temple) Held() bool {
+	return t.Owner.Valid && t.Owner.String == t.MartialOrder
+}
+
+// TempleEvent denotes a temple changing hands
+type TempleEvent string
+
+// All the temple events
+const (
+	TempleCaptured  TempleEvent = "captured"
+	TempleReclaimed TempleEvent = "reclaimed"
+)
+
+// TempleRecord details a temple changing hands on a day. mirrors the database
+type TempleRecord struct {
+	Day          int32
+	LocationName string `db:"location_name"`
+	MartialOrder string `db:"martial_order"`
+	Event        TempleEvent
+	Captor       sql.NullString
+}
+
+// March is a multi-day movement order. the route holds the locations the player
+// has yet to step into, starting with their next location and ending with their
+// destination
+type March struct {
+	TwitterID string
+	Location  sql.NullInt32
+	Route     []int32
+}
+
+// Destination returns where the march ends
+func (m *March) Destination() int32 {
+	return m.Route[len(m.Route)-1]
+}
+
+// MartialOrders lists every order in the war
+var MartialOrders = []string{"Staghorn Sect", "Order Gorgona", "The Baaturate"}
+
+// Commander is an order's elected leader for a term, along with the orders
+// they've given. mirrors the database
+type Commander struct {
+	ID             int32
+	MartialOrder   string `db:"martial_order"`
+	Player         sql.NullInt32
+	Votes          int32
+	StartDay       int32          `db:"start_day"`
+	EndDay         int32          `db:"end_day"`
+	RallyPoint     sql.NullInt32  `db:"rally_point"`
+	RallyPointName sql.NullString `db:"rally_point_name"`
+	Objective      sql.NullString
+}
+
+// Format outputs who leads the order and what they've ordered, one line each
+func (c *Commander) Format() string {
+	var msg strings.Builder
+	if c.Player.Valid {
+		msg.WriteString(fmt.Sprintf("Commander: #%d (until day %d)\n", c.Player.Int32, c.EndDay))
+	} else {
+		msg.WriteString(fmt.Sprintf("Commander: none (until day %d)\n", c.EndDay))
+	}
+	if c.RallyPointName.Valid {
+		msg.WriteString(fmt.Sprintf("Rally point: %s\n", c.RallyPointName.String))
+	}
+	if c.Objective.Valid {
+		msg.WriteString(fmt.Sprintf("Objective: %s\n", c.Objective.String))
+	}
+	return msg.String()
+}
+
+// VoteTally is how many votes a member of an order has for its next commander
+type VoteTally struct {
+	Candidate int32
+	Votes     int32
+}
+
+// FormatVoteTallies outputs the votes for the next commander, one candidate a
+// line
+func FormatVoteTallies(tallies []VoteTally) string {
+	var msg strings.Builder
+	msg.WriteString("Votes for the next commander:\n")
+	for _, tally := range tallies {
+		msg.WriteString(fmt.Sprintf("#%d: %d\n", tally.Candidate, tally.Votes))
+	}
+	return msg.String()
+}
+
+// OrderMessage is a chat message relayed between the members of an order.
+// mirrors the database
+type OrderMessage struct {
+	ID           int32
+	Day          int32
+	MartialOrder string `db:"martial_order"`
+	Sender       int32
+	SenderClass  string `db:"sender_class"`
+	Body         string
+	Timestamp    time.Time
+}
+
+// Format outputs the message the way it's relayed to the order
+func (m *OrderMessage) Format() string {
+	return fmt.Sprintf("#%d (%s): %s", m.Sender, FormatClassName(m.SenderClass), m.Body)
+}
+
 // CombatEvent details what happened in a particular instance of combat
 type CombatEvent struct {
 	Attacker  *Player
@@ -140,3 +477,75 @@ type CombatEvent struct {
 	EventType CombatEventType
 	Result    CombatResult
 }
+
+// ExperienceSource denotes the ways a player can gain experience
+type ExperienceSource int
+
+// All the experience sources
+const (
+	ExistenceExperience ExperienceSource = iota
+	BattleExperience
+	VictoryExperience
+	SurvivalExperience
+	KillExperience
+	DeathExperience
+	CaptureExperience
+)
+
+// ExperienceGain details a single award of experience to a player. mirrors the
+// database
+type ExperienceGain struct {
+	PlayerID    int32
+	Day         int32
+	Source      ExperienceSource
+	Amount      int16
+	TargetClass string
+}
+
+// Format outputs a pretty formatted string explaining the experience gain
+func (g *ExperienceGain) Format() string {
+	var reason string
+	switch g.Source {
+	case ExistenceExperience:
+		reason = "enduring another day"
+	case BattleExperience:
+		reason = "taking part in a battle"
+	case VictoryExperience:
+		reason = "winning a battle"
+	case SurvivalExperience:
+		reason = "surviving a battle"
+	case KillExperience:
+		name := FormatClassName(g.TargetClass)
+		article := "a"
+		if name != "" && strings.ContainsRune("AEIOU", rune(name[0])) {
+			article = "an"
+		}
+		reason = fmt.Sprintf("slaying %s %s", article, name)
+	case DeathExperience:
+		reason = "falling in battle"
+	case CaptureExperience:
+		reason = "capturing a location"
+	}
+	return fmt.Sprintf("%+d XP for %s", g.Amount, reason)
+}
+
+// RunStatus denotes how far a scheduled simulation got
+type RunStatus string
+
+// All the run statuses
+const (
+	RunRunning  RunStatus = "running"
+	RunFinished RunStatus = "finished"
+	RunFailed   RunStatus = "failed"
+	RunSkipped  RunStatus = "skipped"
+)
+
+// ScheduledRun is a simulation the schedule called for, and what came of it.
+// mirrors the database
+type ScheduledRun struct {
+	ScheduledFor time.Time `db:"scheduled_for"`
+	Status       RunStatus
+	StartedAt    time.Time    `db:"started_at"`
+	FinishedAt   sql.NullTime `db:"finished_at"`
+	Error        sql.NullString
+}
@@ -0,0 +1,158 @@
+package events
+
+import (
+	"sync"
+	"time"
+)
+
+// how many past messages the bus holds on to for subscribers catching up
+const historySize = 256
+
+// Message is an event as it's told to the outside world, numbered in the order
+// it was published
+type Message struct {
+	ID   uint64      `json:"id"`
+	Type string      `json:"type"`
+	Time time.Time   `json:"time"`
+	Data interface{} `json:"data"`
+}
+
+// Simulation is the message data of a simulation starting or finishing
+type Simulation struct {
+	Day   int32  `json:"day"`
+	Error string `json:"error,omitempty"`
+}
+
+// BattleOrder is how a single order fared in a battle
+type BattleOrder struct {
+	MartialOrder string `json:"order"`
+	Combatants   int    `json:"combatants"`
+	Casualties   int    `json:"casualties"`
+	Routed       int    `json:"routed"`
+}
+
+// Battle is the message data of a battle being resolved
+type Battle struct {
+	Day      int32         `json:"day"`
+	Location int32         `json:"location"`
+	Victor   string        `json:"victor"`
+	Orders   []BattleOrder `json:"orders"`
+}
+
+// Capture is the message data of a location changing owner
+type Capture struct {
+	Day           int32  `json:"day"`
+	Location      int32  `json:"location"`
+	MartialOrder  string `json:"order"`
+	PreviousOwner string `json:"previous_owner,omitempty"`
+}
+
+// Temple is the message data of an order losing its temple
+type Temple struct {
+	Day          int32  `json:"day"`
+	Location     int32  `json:"location"`
+	MartialOrder string `json:"order"`
+	Captor       string `json:"captor"`
+}
+
+// Join is the message data of a new player joining an order
+type Join struct {
+	MartialOrder string `json:"order"`
+}
+
+// Bus fans published messages out to every subscriber. publishing never waits
+// on a subscriber; one that falls too far behind is dropped and has to subscribe
+// again from the last message it saw
+type Bus struct {
+	lock        sync.Mutex
+	subscribers map[chan Message]bool
+	history     []Message
+	lastID      uint64
+	now         func() time.Time
+}
+
+// NewBus constructs a message bus with no subscribers
+func NewBus() *Bus {
+	return &Bus{
+		sync.Mutex{},
+		make(map[chan Message]bool),
+		make([]Message, 0, historySize),
+		0,
+		time.Now,
+	}
+}
+
+// Publish sends a message to every subscriber. publishing to a nil bus does
+// nothing
+func (b *Bus) Publish(messageType string, data interface{}) {
+	if b == nil {
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.lastID++
+	message := Message{b.lastID, messageType, b.now(), data}
+	if len(b.history) == historySize {
+		copy(b.history, b.history[1:])
+		b.history = b.history[:historySize-1]
+	}
+	b.history = append(b.history, message)
+
+	for subscriber := range b.subscribers {
+		select {
+		case subscriber <- message:
+		default:
+			delete(b.subscribers, subscriber)
+			close(subscriber)
+		}
+	}
+}
+
+// Subscribe returns a channel of every message published from now on. the
+// channel is closed when the subscriber falls more than buffer messages behind
+func (b *Bus) Subscribe(buffer int) <-chan Message {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.subscribe(b.lastID, buffer)
+}
+
+// Resume is like Subscribe, but starts with any messages after the given id
+// that the bus still remembers
+func (b *Bus) Resume(after uint64, buffer int) <-chan Message {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.subscribe(after, buffer)
+}
+
+func (b *Bus) subscribe(after uint64, buffer int) <-chan Message {
+	var missed []Message
+	for i, message := range b.history {
+		if message.ID > after {
+			missed = b.history[i:]
+			break
+		}
+	}
+
+	subscriber := make(chan Message, buffer+len(missed))
+	for _, message := range missed {
+		subscriber <- message
+	}
+	b.subscribers[subscriber] = true
+	return subscriber
+}
+
+// Unsubscribe stops sending messages to a subscriber and closes its channel
+func (b *Bus) Unsubscribe(subscriber <-chan Message) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for candidate := range b.subscribers {
+		if candidate == subscriber {
+			delete(b.subscribers, candidate)
+			close(candidate)
+			return
+		}
+	}
+}
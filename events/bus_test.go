@@ -0,0 +1,60 @@
+package events
+
+import (
+	"testing"
+)
+
+func TestBus(t *testing.T) {
+	bus := NewBus()
+	bus.Publish("simulation_started", Simulation{Day: 1})
+
+	// new subscribers only hear what happens next
+	live := bus.Subscribe(4)
+	bus.Publish("player_joined", Join{MartialOrder: "Staghorn Sect"})
+	message := <-live
+	if message.ID != 2 || message.Type != "player_joined" || message.Data.(Join).MartialOrder != "Staghorn Sect" {
+		t.Errorf("expected the join, got %+v", message)
+	}
+
+	// returning subscribers catch up on what they missed
+	resumed := bus.Resume(1, 4)
+	if message := <-resumed; message.ID != 2 {
+		t.Errorf("expected to resume at message 2, got %d", message.ID)
+	}
+
+	// subscribers that fall behind are dropped rather than holding up the bus
+	for i := 0; i < 5; i++ {
+		bus.Publish("simulation_finished", Simulation{Day: 1})
+	}
+	count := 0
+	for range live {
+		count++
+	}
+	if count != 4 {
+		t.Errorf("expected the slow subscriber to get 4 messages before closing, got %d", count)
+	}
+
+	// unsubscribing closes the channel, ending the range
+	bus.Unsubscribe(resumed)
+	for range resumed {
+	}
+	bus.Unsubscribe(live)
+
+	// messages can always be published to a missing bus
+	var missing *Bus
+	missing.Publish("simulation_started", Simulation{Day: 2})
+}
+
+func TestBusHistory(t *testing.T) {
+	bus := NewBus()
+	for i := 0; i < historySize+10; i++ {
+		bus.Publish("battle_resolved", Battle{})
+	}
+
+	// only so much history is kept
+	resumed := bus.Resume(0, 0)
+	first := <-resumed
+	if first.ID != 11 || len(resumed) != historySize-1 {
+		t.Errorf("expected to resume from message 11 with %d more, got %d with %d more", historySize-1, first.ID, len(resumed))
+	}
+}
@@ -0,0 +1,171 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/yisaj/heavens_throne/entities"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Event is something that happened in the game, as the simulator and player
+// input see it. subscribers tell the events apart with a type switch
+type Event interface {
+	// Name is how the event is known outside the process
+	Name() string
+}
+
+// SimulationStarted is published once a new day has begun simulating
+type SimulationStarted struct {
+	Day int32
+}
+
+// SimulationFinished is published once a simulation is over and the database
+// can be read again, whether or not it succeeded
+type SimulationFinished struct {
+	Day int32
+	Err error
+}
+
+// DayAdvanced is published once everything that happens in a day has happened,
+// before the database can be read again
+type DayAdvanced struct {
+	Day int32
+}
+
+// BattleResolved carries everything that happened in a battle at a location,
+// with the players grouped by order
+type BattleResolved struct {
+	Day int32
+	// the location as it was before the battle
+	Location     entities.Location
+	Victor       string
+	Combatants   map[string][]entities.Player
+	Survivors    map[string][]*entities.Player
+	Fatalities   map[string][]*entities.Player
+	Routs        map[string][]*entities.Player
+	CombatEvents []entities.CombatEvent
+}
+
+// LocationCaptured is published when an order takes ownership of a location
+type LocationCaptured struct {
+	Day int32
+	// the location as it was before the capture
+	Location     entities.Location
+	MartialOrder string
+}
+
+// TempleChanged is published when an order loses or retakes its temple
+type TempleChanged struct {
+	Day    int32
+	Temple entities.Temple
+	Change entities.TempleEvent
+}
+
+// PlayerKilled is published when a player dies, whether in battle or cut down
+// while routing
+type PlayerKilled struct {
+	Day      int32
+	Location int32
+	Player   *entities.Player
+	Cause    entities.DeathCause
+}
+
+// PlayerRevived is published when a player who died on an earlier day returns
+type PlayerRevived struct {
+	Day    int32
+	Return entities.ReturnRecord
+}
+
+// PlayerJoined is published when a new player joins an order
+type PlayerJoined struct {
+	Player *entities.Player
+}
+
+func (SimulationStarted) Name() string  { return "simulation_started" }
+func (SimulationFinished) Name() string { return "simulation_finished" }
+func (DayAdvanced) Name() string        { return "day_advanced" }
+func (BattleResolved) Name() string     { return "battle_resolved" }
+func (LocationCaptured) Name() string   { return "location_captured" }
+func (TempleChanged) Name() string      { return "temple_changed" }
+func (PlayerKilled) Name() string       { return "player_killed" }
+func (PlayerRevived) Name() string      { return "player_revived" }
+func (PlayerJoined) Name() string       { return "player_joined" }
+
+// Subscriber reacts to domain events, ignoring the ones it doesn't care about
+type Subscriber interface {
+	Handle(ctx context.Context, event Event) error
+}
+
+// SubscriberFunc lets a plain function subscribe to domain events
+type SubscriberFunc func(ctx context.Context, event Event) error
+
+// Handle calls the function
+func (f SubscriberFunc) Handle(ctx context.Context, event Event) error {
+	return f(ctx, event)
+}
+
+// Dispatcher hands domain events to every subscriber in the order they
+// subscribed. unlike the Bus, it waits on each subscriber, so what they do is
+// done by the time the publisher carries on
+type Dispatcher struct {
+	logger      *logrus.Logger
+	lock        sync.RWMutex
+	subscribers []subscription
+}
+
+// subscription is a subscriber, and whether its failures are only logged
+type subscription struct {
+	subscriber Subscriber
+	bestEffort bool
+}
+
+// NewDispatcher constructs a dispatcher with no subscribers, logging the
+// failures of the ones that subscribe best effort
+func NewDispatcher(logger *logrus.Logger) *Dispatcher {
+	return &Dispatcher{logger: logger}
+}
+
+// Subscribe adds a subscriber to every event published from now on. its
+// failures stop the publisher, so it should be something the publisher can't
+// carry on without
+func (d *Dispatcher) Subscribe(subscriber Subscriber) {
+	d.subscribe(subscription{subscriber, false})
+}
+
+// SubscribeBestEffort adds a subscriber to every event published from now on,
+// logging its failures instead of handing them to the publisher
+func (d *Dispatcher) SubscribeBestEffort(subscriber Subscriber) {
+	d.subscribe(subscription{subscriber, true})
+}
+
+func (d *Dispatcher) subscribe(sub subscription) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.subscribers = append(d.subscribers, sub)
+}
+
+// Publish hands an event to each subscriber in turn, stopping at the first one
+// that fails, unless it subscribed best effort. publishing to a nil dispatcher
+// does nothing
+func (d *Dispatcher) Publish(ctx context.Context, event Event) error {
+	if d == nil {
+		return nil
+	}
+
+	d.lock.RLock()
+	subscribers := d.subscribers
+	d.lock.RUnlock()
+
+	for _, sub := range subscribers {
+		err := sub.subscriber.Handle(ctx, event)
+		if err != nil && sub.bestEffort {
+			d.logger.WithError(err).WithField("event", event.Name()).Error("failed handling event")
+		} else if err != nil {
+			return errors.Wrapf(err, "failed handling %s event", event.Name())
+		}
+	}
+	return nil
+}
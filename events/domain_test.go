@@ -0,0 +1,136 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/yisaj/heavens_throne/entities"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+func TestDispatcher(t *testing.T) {
+	dispatcher := NewDispatcher(logrus.New())
+	var heard []string
+	listen := func(name string, err error) Subscriber {
+		return SubscriberFunc(func(ctx context.Context, event Event) error {
+			heard = append(heard, name+" "+event.Name())
+			return err
+		})
+	}
+	dispatcher.Subscribe(listen("first", nil))
+	dispatcher.Subscribe(listen("second", errors.New("full")))
+	dispatcher.Subscribe(listen("third", nil))
+
+	err := dispatcher.Publish(context.Background(), DayAdvanced{Day: 3})
+	if err == nil {
+		t.Error("expected the failing subscriber's error")
+	}
+	expected := []string{"first day_advanced", "second day_advanced"}
+	if !reflect.DeepEqual(heard, expected) {
+		t.Errorf("expected subscribers in order up to the failure, got %v", heard)
+	}
+
+	// events can always be published to a missing dispatcher
+	var missing *Dispatcher
+	if err := missing.Publish(context.Background(), DayAdvanced{Day: 3}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDispatcherBestEffort(t *testing.T) {
+	var logged bytes.Buffer
+	logger := logrus.New()
+	logger.SetOutput(&logged)
+	dispatcher := NewDispatcher(logger)
+	var heard []string
+	listen := func(name string, err error) Subscriber {
+		return SubscriberFunc(func(ctx context.Context, event Event) error {
+			heard = append(heard, name+" "+event.Name())
+			return err
+		})
+	}
+	dispatcher.Subscribe(listen("recorder", nil))
+	dispatcher.SubscribeBestEffort(listen("feed", errors.New("full")))
+	dispatcher.SubscribeBestEffort(listen("cache", nil))
+
+	// a best effort subscriber's failure is only logged
+	err := dispatcher.Publish(context.Background(), DayAdvanced{Day: 3})
+	if err != nil {
+		t.Errorf("expected the failure to be kept from the publisher, got %v", err)
+	}
+	expected := []string{"recorder day_advanced", "feed day_advanced", "cache day_advanced"}
+	if !reflect.DeepEqual(heard, expected) {
+		t.Errorf("expected every subscriber in order, got %v", heard)
+	}
+	if !strings.Contains(logged.String(), "full") || !strings.Contains(logged.String(), "day_advanced") {
+		t.Errorf("expected the failure to be logged, got %q", logged.String())
+	}
+
+	// the subscribers that can fail the publisher still do
+	dispatcher.Subscribe(listen("ledger", errors.New("broken")))
+	err = dispatcher.Publish(context.Background(), DayAdvanced{Day: 4})
+	if err == nil {
+		t.Error("expected the failing subscriber's error")
+	}
+}
+
+func TestFeed(t *testing.T) {
+	bus := NewBus()
+	messages := bus.Subscribe(8)
+	feed := Feed(bus)
+
+	player := &entities.Player{MartialOrder: "Staghorn Sect"}
+	published := []Event{
+		BattleResolved{
+			Day:      2,
+			Location: entities.Location{ID: 7},
+			Victor:   "Staghorn Sect",
+			Combatants: map[string][]entities.Player{
+				"Staghorn Sect": {*player, *player},
+				"Order Gorgona": {{MartialOrder: "Order Gorgona"}},
+				"The Baaturate": nil,
+			},
+			Fatalities: map[string][]*entities.Player{"Order Gorgona": {player}},
+			Routs:      map[string][]*entities.Player{"Staghorn Sect": {player}},
+		},
+		// deaths aren't public
+		PlayerKilled{Day: 2, Location: 7, Player: player, Cause: entities.Slain},
+		TempleChanged{Day: 2, Temple: entities.Temple{MartialOrder: "Order Gorgona", Location: 7,
+			Owner: sql.NullString{String: "Staghorn Sect", Valid: true}}, Change: entities.TempleCaptured},
+		// only lost temples are
+		TempleChanged{Day: 2, Temple: entities.Temple{MartialOrder: "Staghorn Sect", Location: 1,
+			Owner: sql.NullString{String: "Staghorn Sect", Valid: true}}, Change: entities.TempleReclaimed},
+		PlayerJoined{Player: player},
+	}
+	for _, event := range published {
+		err := feed.Handle(context.Background(), event)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	bus.Unsubscribe(messages)
+
+	expected := []Message{
+		{Type: "battle_resolved", Data: Battle{2, 7, "Staghorn Sect", []BattleOrder{
+			{"Order Gorgona", 1, 1, 0},
+			{"Staghorn Sect", 2, 0, 1},
+		}}},
+		{Type: "temple_lost", Data: Temple{2, 7, "Order Gorgona", "Staghorn Sect"}},
+		{Type: "player_joined", Data: Join{"Staghorn Sect"}},
+	}
+	var got []Message
+	for message := range messages {
+		message.ID = 0
+		message.Time = expected[0].Time
+		got = append(got, message)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+}
@@ -0,0 +1,69 @@
+package events
+
+import (
+	"context"
+	"sort"
+)
+
+// Feed subscribes the bus to domain events, telling it only what's public.
+// what a single player did and where the armies are stay behind the fog of war
+func Feed(bus *Bus) Subscriber {
+	return SubscriberFunc(func(ctx context.Context, event Event) error {
+		switch e := event.(type) {
+		case SimulationStarted:
+			bus.Publish(e.Name(), Simulation{Day: e.Day})
+		case SimulationFinished:
+			finished := Simulation{Day: e.Day}
+			if e.Err != nil {
+				finished.Error = e.Err.Error()
+			}
+			bus.Publish(e.Name(), finished)
+		case BattleResolved:
+			bus.Publish(e.Name(), battleMessage(&e))
+		case LocationCaptured:
+			bus.Publish(e.Name(), Capture{
+				Day:           e.Day,
+				Location:      e.Location.ID,
+				MartialOrder:  e.MartialOrder,
+				PreviousOwner: e.Location.Owner.String,
+			})
+		case TempleChanged:
+			if e.Temple.Held() {
+				break
+			}
+			bus.Publish("temple_lost", Temple{
+				Day:          e.Day,
+				Location:     e.Temple.Location,
+				MartialOrder: e.Temple.MartialOrder,
+				Captor:       e.Temple.Owner.String,
+			})
+		case PlayerJoined:
+			bus.Publish(e.Name(), Join{MartialOrder: e.Player.MartialOrder})
+		}
+		return nil
+	})
+}
+
+// battleMessage sums up how each order fared in a battle
+func battleMessage(battle *BattleResolved) Battle {
+	message := Battle{
+		Day:      battle.Day,
+		Location: battle.Location.ID,
+		Victor:   battle.Victor,
+	}
+	for order, combatants := range battle.Combatants {
+		if len(combatants) == 0 {
+			continue
+		}
+		message.Orders = append(message.Orders, BattleOrder{
+			MartialOrder: order,
+			Combatants:   len(combatants),
+			Casualties:   len(battle.Fatalities[order]),
+			Routed:       len(battle.Routs[order]),
+		})
+	}
+	sort.Slice(message.Orders, func(i int, j int) bool {
+		return message.Orders[i].MartialOrder < message.Orders[j].MartialOrder
+	})
+	return message
+}
@@ -0,0 +1,84 @@
+package input
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/yisaj/heavens_throne/twitspeak"
+)
+
+// how long a long reply, like a table, can get before the rest is cut off,
+// leaving plenty of room under the DM limit for other replies in the same batch
+const maxReplyLength = 4000
+
+// replyBatch is a speaker that holds back the DMs to one player, so the replies
+// to several commands can go out as a single message. everything else goes
+// straight through to the real speaker
+type replyBatch struct {
+	twitspeak.TwitterSpeaker
+	recipientID string
+	replies     []string
+	options     []twitspeak.QuickReplyOption
+}
+
+func newReplyBatch(speaker twitspeak.TwitterSpeaker, recipientID string) *replyBatch {
+	return &replyBatch{
+		TwitterSpeaker: speaker,
+		recipientID:    recipientID,
+	}
+}
+
+// SendDM holds on to messages for the batch's player and sends any others
+func (b *replyBatch) SendDM(userID string, msg string) error {
+	return b.SendDMWithOptions(userID, msg, nil)
+}
+
+// SendDMWithOptions holds on to messages and quick reply options for the batch's
+// player and sends any others
+func (b *replyBatch) SendDMWithOptions(userID string, msg string, options []twitspeak.QuickReplyOption) error {
+	if userID != b.recipientID {
+		return b.TwitterSpeaker.SendDMWithOptions(userID, msg, options)
+	}
+	b.replies = append(b.replies, msg)
+	b.options = append(b.options, options...)
+	return nil
+}
+
+// take returns the messages held back since the last take
+func (b *replyBatch) take() string {
+	reply := strings.Join(b.replies, "")
+	b.replies = nil
+	return reply
+}
+
+// takeOptions returns the quick reply options held back since the last take
+func (b *replyBatch) takeOptions() []twitspeak.QuickReplyOption {
+	options := b.options
+	b.options = nil
+	return options
+}
+
+// splitCommands finds where each command in a DM starts. commands start with a
+// bang at the beginning of a word. a DM without any is treated as a single bare
+// command starting at its first bang, or its first character
+func splitCommands(msg string) []int {
+	var starts []int
+	for i, char := range msg {
+		if char != '!' {
+			continue
+		}
+		if previous, _ := utf8.DecodeLastRuneInString(msg[:i]); i == 0 || unicode.IsSpace(previous) {
+			starts = append(starts, i)
+		}
+	}
+
+	if len(starts) == 0 {
+		start := strings.IndexByte(msg, '!')
+		if start == -1 {
+			start = 0
+		}
+		starts = append(starts, start)
+	}
+	return starts
+}
@@ -0,0 +1,51 @@
+package input
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitCommands(t *testing.T) {
+	tests := []struct {
+		msg    string
+		starts []int
+	}{
+		{"!status", []int{0}},
+		{"status", []int{0}},
+		{"hey !status", []int{4}},
+		{"!advance spear !move york", []int{0, 15}},
+		{"!move st.cecil's!bridge", []int{0}},
+		{"wow!status", []int{3}},
+		{"!stance hold\n!target cavalry", []int{0, 13}},
+		{"voilà!move york", []int{6}},
+		{"voilà!move york !status", []int{17}},
+		{"voilà\u00a0!move york", []int{8}},
+	}
+
+	for _, test := range tests {
+		starts := splitCommands(test.msg)
+		if !reflect.DeepEqual(starts, test.starts) {
+			t.Errorf("splitting %q: expected %v, got %v", test.msg, test.starts, starts)
+		}
+	}
+}
+
+func TestReplyBatch(t *testing.T) {
+	batch := newReplyBatch(nil, "player")
+	batch.SendDM("player", "one ")
+	batch.SendDM("player", "two")
+	if reply := batch.take(); reply != "one two" {
+		t.Errorf("expected the replies to be joined, got %q", reply)
+	}
+	if reply := batch.take(); reply != "" {
+		t.Errorf("expected the batch to be empty after a take, got %q", reply)
+	}
+
+	batch.SendDMWithOptions("player", "join?", orderOptions)
+	if options := batch.takeOptions(); !reflect.DeepEqual(options, orderOptions) {
+		t.Errorf("expected the order options to be held, got %v", options)
+	}
+	if options := batch.takeOptions(); options != nil {
+		t.Errorf("expected no options after a take, got %v", options)
+	}
+}
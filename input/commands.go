@@ -0,0 +1,423 @@
+package input
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// requirement is what a player needs to be to use a command
+type requirement int
+
+const (
+	anyone requirement = iota
+	activePlayer
+	alivePlayer
+	orderCommander
+)
+
+// command describes a player command, how it's used, and how to run it
+type command struct {
+	name    string
+	aliases []string
+	// how the argument is written in usage, e.g. [location]
+	argument string
+	// whether the command refuses to run without an argument
+	argumentRequired bool
+	// whether the argument is lowercased before being handed over
+	rawArgument bool
+	requires    requirement
+	adminOnly   bool
+	summary     string
+	help        string
+	run         func(h Handler, ctx context.Context, recipientID string, argument string) error
+}
+
+// usage formats how the command is typed
+func (c *command) usage() string {
+	if c.argument == "" {
+		return "!" + c.name
+	}
+	return "!" + c.name + " " + c.argument
+}
+
+// registry holds every command the game understands
+type registry struct {
+	commands []*command
+	lookup   map[string]*command
+	admins   map[string]bool
+}
+
+// newRegistry indexes the commands by name and alias
+func newRegistry(admins []string, commands ...*command) *registry {
+	r := &registry{
+		commands: commands,
+		lookup:   make(map[string]*command),
+		admins:   make(map[string]bool),
+	}
+	for _, cmd := range commands {
+		r.lookup[cmd.name] = cmd
+		for _, alias := range cmd.aliases {
+			r.lookup[alias] = cmd
+		}
+	}
+	for _, admin := range admins {
+		if admin != "" {
+			r.admins[admin] = true
+		}
+	}
+	return r
+}
+
+// find looks up a command by name or alias, with or without the leading bang.
+// admin commands are hidden from everyone else
+func (r *registry) find(name string, recipientID string) *command {
+	cmd, ok := r.lookup[strings.TrimPrefix(strings.ToLower(name), "!")]
+	if !ok || (cmd.adminOnly && !r.isAdmin(recipientID)) {
+		return nil
+	}
+	return cmd
+}
+
+// available lists the commands a player can see, sorted by name
+func (r *registry) available(recipientID string) []*command {
+	var commands []*command
+	for _, cmd := range r.commands {
+		if !cmd.adminOnly || r.isAdmin(recipientID) {
+			commands = append(commands, cmd)
+		}
+	}
+	sort.Slice(commands, func(i int, j int) bool {
+		return commands[i].name < commands[j].name
+	})
+	return commands
+}
+
+func (r *registry) isAdmin(recipientID string) bool {
+	return r.admins[recipientID]
+}
+
+// newCommandRegistry builds the registry of every player and admin command
+func newCommandRegistry(admins []string) *registry {
+	return newRegistry(admins,
+		&command{
+			name:     "help",
+			aliases:  []string{"commands"},
+			argument: "[command]",
+			summary:  "list commands, or explain one",
+			help:     "Lists every command you can use. Give it the name of a command to learn more about it.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Help(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:     "join",
+			argument: "[order]",
+			summary:  "join the war",
+			help:     "Pledges yourself to a martial order: staghorn, gorgona, or baaturate. You start at your order's temple.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Join(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:     "status",
+			aliases:  []string{"me"},
+			requires: activePlayer,
+			summary:  "see your status",
+			help:     "Shows your order, class, experience, location, stance, march and recent experience. If you're dead, shows how you died and when you'll return.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Status(ctx, recipientID)
+			},
+		},
+		&command{
+			name:     "logistics",
+			aliases:  []string{"logi"},
+			argument: "[location]",
+			requires: activePlayer,
+			summary:  "see where your order's units are",
+			help:     "Shows where your order's units are and where they're headed. Give it a location to see who's arriving and leaving there, and the enemy forces if your order is close enough to see them.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Logistics(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:     "move",
+			aliases:  []string{"go"},
+			argument: "[location]",
+			requires: alivePlayer,
+			summary:  "move to an adjacent location",
+			help:     "Sets the adjacent location you'll move to at the end of the day. Cancels any march. Leave out the location to pick from a menu.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Move(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:             "march",
+			aliases:          []string{"travel"},
+			argument:         "[location]",
+			argumentRequired: true,
+			requires:         alivePlayer,
+			summary:          "march to any location",
+			help:             "Takes the shortest road to a location, one step a day. The march ends if you're caught in a battle.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.March(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:     "scout",
+			argument: "[location]",
+			requires: alivePlayer,
+			summary:  "estimate enemy numbers nearby",
+			help:     "Estimates the enemy forces where you stand, or at a location next to it. Rangers make sharper scouts.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Scout(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:     "forecast",
+			argument: "[location]",
+			requires: alivePlayer,
+			summary:  "preview tomorrow's battles nearby",
+			help:     "Plays out tomorrow where you're headed, or at a location next to where you stand, and tells how your order is likely to fare. Only your order's movements are known.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Forecast(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:     "advance",
+			argument: "[class]",
+			requires: activePlayer,
+			summary:  "spend experience to rank up",
+			help:     "Spends 100 experience to advance a rank. At the top rank of a class, give it the name of the class to advance to.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Advance(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:     "stance",
+			argument: "[stance]",
+			requires: activePlayer,
+			summary:  "choose how you fight",
+			help:     "Sets your battle stance: aggressive, defensive, hold, or support. Leave it out to see your current stance.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Stance(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:     "target",
+			argument: "[class family]",
+			requires: activePlayer,
+			summary:  "choose who you seek out in battle",
+			help:     "Makes you seek out recruits, infantry, cavalry, or rangers in battle. Use none to clear it.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Target(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:     "toggleupdates",
+			aliases:  []string{"updates"},
+			requires: activePlayer,
+			summary:  "turn daily battle reports on or off",
+			help:     "Turns your daily personal battle reports on or off.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.ToggleUpdates(ctx, recipientID)
+			},
+		},
+		&command{
+			name:    "games",
+			summary: "list the games being played",
+			help:    "Lists every game running, and which one you're playing.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Games(ctx, recipientID)
+			},
+		},
+		&command{
+			name:     "play",
+			argument: "[game]",
+			summary:  "switch games",
+			help:     "Switches which game you're playing. Your unit in the game you leave stays in play without you: it still makes its last move, fights whoever comes for it, and can fall while you're away. Its reports keep coming, marked with its game.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Play(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:     "quit",
+			aliases:  []string{"leave"},
+			argument: "[confirm]",
+			requires: activePlayer,
+			summary:  "leave the game",
+			help:     "Leaves the game for good. Asks you to confirm first.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Quit(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:             "order",
+			aliases:          []string{"chat"},
+			argument:         "[message]",
+			argumentRequired: true,
+			rawArgument:      true,
+			requires:         activePlayer,
+			summary:          "message your order",
+			help:             "Passes a message along to everyone in your order who has order chat turned on.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.OrderChat(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:     "orderlog",
+			aliases:  []string{"chatlog"},
+			argument: "[count]",
+			requires: activePlayer,
+			summary:  "read your order's latest messages",
+			help:     "Shows the latest messages from your order's chat, 10 unless you ask for more.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.OrderLog(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:     "orderchat",
+			requires: activePlayer,
+			summary:  "turn order chat on or off",
+			help:     "Turns relayed messages from your order on or off. Order chat is off until you turn it on.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.ToggleOrderChat(ctx, recipientID)
+			},
+		},
+		&command{
+			name:             "mute",
+			argument:         "[player number]",
+			argumentRequired: true,
+			requires:         activePlayer,
+			summary:          "stop hearing from someone in order chat",
+			help:             "Stops messages from a member of your order, by the number shown next to their messages.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Mute(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:             "unmute",
+			argument:         "[player number]",
+			argumentRequired: true,
+			requires:         activePlayer,
+			summary:          "hear from someone in order chat again",
+			help:             "Lets a member of your order you muted reach you again.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Unmute(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:             "vote",
+			argument:         "[player number]",
+			argumentRequired: true,
+			requires:         activePlayer,
+			summary:          "vote for your order's commander",
+			help:             "Votes for a member of your order to be commander. Votes are counted when the current commander's term ends.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Vote(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:             "rally",
+			argument:         "[location]",
+			argumentRequired: true,
+			requires:         orderCommander,
+			summary:          "set your order's rally point",
+			help:             "Sets the location your order should gather at. Use none to clear it. Commanders only.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Rally(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:             "objective",
+			argument:         "[objective]",
+			argumentRequired: true,
+			rawArgument:      true,
+			requires:         orderCommander,
+			summary:          "set your order's objective",
+			help:             "Sets the objective shown to your whole order. Use none to clear it. Commanders only.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Objective(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:             "broadcast",
+			argument:         "[message]",
+			argumentRequired: true,
+			rawArgument:      true,
+			requires:         orderCommander,
+			summary:          "send orders to your whole order",
+			help:             "Sends a message to every active member of your order, whether or not they listen to order chat. Commanders only.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Broadcast(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:             "echo",
+			argument:         "[message]",
+			argumentRequired: true,
+			rawArgument:      true,
+			adminOnly:        true,
+			summary:          "echo a message back",
+			help:             "Sends the message straight back to you.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Echo(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:      "simulate",
+			adminOnly: true,
+			summary:   "simulate a day",
+			help:      "Runs the daily simulation right away.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Simulate(ctx, recipientID)
+			},
+		},
+		&command{
+			name:      "dryrun",
+			argument:  "[runs]",
+			adminOnly: true,
+			summary:   "preview the next day",
+			help:      "Plays out the next day without changing anything, fighting every battle 100 times or as many as given, up to 1000.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.DryRun(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:             "tweet",
+			argument:         "[message]",
+			argumentRequired: true,
+			rawArgument:      true,
+			adminOnly:        true,
+			summary:          "post a tweet",
+			help:             "Posts the message as a tweet.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Tweet(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:             "reply",
+			argument:         "[tweet id] [message]",
+			argumentRequired: true,
+			rawArgument:      true,
+			adminOnly:        true,
+			summary:          "reply to a tweet",
+			help:             "Posts the message as a reply to the tweet.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.Reply(ctx, recipientID, argument)
+			},
+		},
+		&command{
+			name:             "image",
+			argument:         "[filename]",
+			argumentRequired: true,
+			rawArgument:      true,
+			adminOnly:        true,
+			summary:          "tweet an image",
+			help:             "Uploads the PNG file and tweets it.",
+			run: func(h Handler, ctx context.Context, recipientID string, argument string) error {
+				return h.ImageTweet(ctx, recipientID, argument)
+			},
+		},
+	)
+}
@@ -0,0 +1,49 @@
+package input
+
+import "testing"
+
+func TestRegistryFind(t *testing.T) {
+	commands := newCommandRegistry([]string{"admin"})
+
+	tests := []struct {
+		name        string
+		recipientID string
+		found       string
+	}{
+		{"!move", "player", "move"},
+		{"move", "player", "move"},
+		{"!MOVE", "player", "move"},
+		{"!go", "player", "move"},
+		{"!commands", "player", "help"},
+		{"!dance", "player", ""},
+		{"!simulate", "player", ""},
+		{"!simulate", "admin", "simulate"},
+	}
+
+	for _, test := range tests {
+		cmd := commands.find(test.name, test.recipientID)
+		found := ""
+		if cmd != nil {
+			found = cmd.name
+		}
+		if found != test.found {
+			t.Errorf("finding %s for %s: expected %q, got %q", test.name, test.recipientID, test.found, found)
+		}
+	}
+}
+
+func TestRegistryAvailable(t *testing.T) {
+	commands := newCommandRegistry([]string{"admin", ""})
+
+	for _, cmd := range commands.available("player") {
+		if cmd.adminOnly {
+			t.Errorf("expected %s to be hidden from players", cmd.name)
+		}
+	}
+	if len(commands.available("admin")) != len(commands.commands) {
+		t.Error("expected admins to see every command")
+	}
+	if commands.isAdmin("") {
+		t.Error("expected an empty id to never be an admin")
+	}
+}
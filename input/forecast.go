@@ -0,0 +1,241 @@
+package input
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yisaj/heavens_throne/simulation"
+
+	"github.com/pkg/errors"
+)
+
+const busyForecasting = `
+The day is being simulated. Try again once it's done.
+`
+
+// Forecast tells the player how tomorrow is likely to go where they're headed,
+// or at a location next to where they stand. only their own order's movements
+// are known, so everyone else is assumed to hold where they stand
+func (h *handler) Forecast(ctx context.Context, recipientID string, locationString string) error {
+	const tooFar = `
+That's too far to forecast. You can forecast where you stand and the locations next to it.
+`
+	const forecastHeader = `
+FORECAST FOR %s, DAY %d:
+`
+	const battleForecast = `A battle is likely. Your order holds the field about %s of the time, losing about %.1f units.
+`
+	const othersBattleForecast = `A battle between %s is likely.
+`
+	const quietForecast = `No battle is likely.
+`
+	const captureForecast = `%s falls to %s about %s of the time.
+`
+	const forecastFooter = `
+Enemy movements are unknown, so they're assumed to hold where they stand.
+`
+
+	player, err := h.resource.GetPlayer(ctx, recipientID)
+	if err != nil {
+		return errors.Wrap(err, "failed parsing DM")
+	}
+	if player == nil {
+		return nil
+	}
+
+	adjacentLocations, err := h.resource.GetAdjacentLocations(ctx, player.Location.Int32)
+	if err != nil {
+		return errors.Wrap(err, "failed forecasting")
+	}
+	nearbyLocations := append([]int32{player.Location.Int32}, adjacentLocations...)
+
+	locationID := player.Location.Int32
+	if player.NextLocation.Valid {
+		locationID = player.NextLocation.Int32
+	}
+	if locationString != "" {
+		var ok bool
+		locationID, ok, err = h.resolveLocation(ctx, recipientID, locationString, nearbyLocations)
+		if err != nil {
+			return errors.Wrap(err, "failed forecasting")
+		}
+		if !ok {
+			return nil
+		}
+	}
+
+	inRange := false
+	for _, nearbyLocation := range nearbyLocations {
+		if nearbyLocation == locationID {
+			inRange = true
+			break
+		}
+	}
+	if !inRange {
+		err = h.speaker.SendDM(recipientID, tooFar)
+		if err != nil {
+			return errors.Wrap(err, "failed sending too far message")
+		}
+		return nil
+	}
+
+	forecast, err := h.forecaster.Forecast(ctx, simulation.ForecastOptions{
+		Order:    player.MartialOrder,
+		Location: locationID,
+	})
+	if err == simulation.ErrSimulating {
+		err = h.speaker.SendDM(recipientID, busyForecasting)
+		if err != nil {
+			return errors.Wrap(err, "failed sending busy forecasting message")
+		}
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "failed forecasting")
+	}
+
+	location, err := h.resource.GetLocation(ctx, locationID)
+	if err != nil {
+		return errors.Wrap(err, "failed forecasting")
+	}
+
+	var msg strings.Builder
+	msg.WriteString(fmt.Sprintf(forecastHeader, strings.ToUpper(location.Name), forecast.Day))
+	if len(forecast.Battles) == 0 {
+		msg.WriteString(quietForecast)
+	}
+	for _, battle := range forecast.Battles {
+		if battle.Armies[player.MartialOrder] == 0 {
+			msg.WriteString(fmt.Sprintf(othersBattleForecast, strings.Join(battleOrders(&battle), " and ")))
+			continue
+		}
+		msg.WriteString(fmt.Sprintf(battleForecast, percentage(battle.Victories[player.MartialOrder]),
+			battle.Losses[player.MartialOrder]))
+	}
+	for _, capture := range forecast.Captures {
+		msg.WriteString(fmt.Sprintf(captureForecast, capture.Location.Name, capture.MartialOrder, percentage(capture.Chance)))
+	}
+	msg.WriteString(forecastFooter)
+
+	err = h.speaker.SendDM(recipientID, msg.String())
+	if err != nil {
+		return errors.Wrap(err, "failed sending forecast")
+	}
+	return nil
+}
+
+// DryRun tells an admin how tomorrow is likely to go everywhere, fighting every
+// battle the given number of times
+func (h *handler) DryRun(ctx context.Context, recipientID string, runs string) error {
+	const invalidRuns = `
+That's not a number of runs.
+`
+
+	count := simulation.DefaultForecastRuns
+	if runs != "" {
+		var err error
+		count, err = strconv.Atoi(runs)
+		if err != nil || count <= 0 {
+			err = h.speaker.SendDM(recipientID, invalidRuns)
+			if err != nil {
+				return errors.Wrap(err, "failed sending invalid runs message")
+			}
+			return nil
+		}
+	}
+
+	forecast, err := h.forecaster.Forecast(ctx, simulation.ForecastOptions{Runs: count})
+	if err == simulation.ErrSimulating {
+		err = h.speaker.SendDM(recipientID, busyForecasting)
+		if err != nil {
+			return errors.Wrap(err, "failed sending busy forecasting message")
+		}
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "failed dry run")
+	}
+
+	err = h.speaker.SendDM(recipientID, formatDryRun(forecast))
+	if err != nil {
+		return errors.Wrap(err, "failed sending dry run")
+	}
+	return nil
+}
+
+// formatDryRun lays out every battle and capture in a forecast, cutting the
+// rest off once it gets too long for a DM
+func formatDryRun(forecast *simulation.Forecast) string {
+	const dryRunHeader = `
+DRY RUN OF DAY %d, %d RUNS EACH:
+`
+	const battlesHeader = `
+BATTLES:
+`
+	const armyForecast = `%s: %d units, wins %s, loses %.1f
+`
+	const capturesHeader = `
+CAPTURES:
+`
+	const captureForecast = `%s by %s: %s
+`
+	const quietDay = `
+No battles or captures are likely.
+`
+	const truncated = "...and %d more.\n"
+
+	var msg strings.Builder
+	msg.WriteString(fmt.Sprintf(dryRunHeader, forecast.Day, forecast.Runs))
+	if len(forecast.Battles) == 0 && len(forecast.Captures) == 0 {
+		msg.WriteString(quietDay)
+		return msg.String()
+	}
+
+	// every battle and capture is an entry, and the entries that don't fit are
+	// counted instead
+	var entries []string
+	for i, battle := range forecast.Battles {
+		var entry strings.Builder
+		if i == 0 {
+			entry.WriteString(battlesHeader)
+		}
+		entry.WriteString(battle.Location.Name + "\n")
+		for _, order := range battleOrders(&battle) {
+			entry.WriteString(fmt.Sprintf(armyForecast, order, battle.Armies[order], percentage(battle.Victories[order]),
+				battle.Losses[order]))
+		}
+		entries = append(entries, entry.String())
+	}
+	for i, capture := range forecast.Captures {
+		entry := fmt.Sprintf(captureForecast, capture.Location.Name, capture.MartialOrder, percentage(capture.Chance))
+		if i == 0 {
+			entry = capturesHeader + entry
+		}
+		entries = append(entries, entry)
+	}
+
+	for i, entry := range entries {
+		if msg.Len()+len(entry)+len(truncated) > maxReplyLength {
+			msg.WriteString(fmt.Sprintf(truncated, len(entries)-i))
+			break
+		}
+		msg.WriteString(entry)
+	}
+	return msg.String()
+}
+
+// battleOrders lists the orders fighting a battle alphabetically
+func battleOrders(battle *simulation.BattleForecast) []string {
+	orders := make([]string, 0, len(battle.Armies))
+	for order := range battle.Armies {
+		orders = append(orders, order)
+	}
+	sort.Strings(orders)
+	return orders
+}
+
+// percentage formats a chance as a whole percentage
+func percentage(chance float64) string {
+	return fmt.Sprintf("%.0f%%", chance*100)
+}
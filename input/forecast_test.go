@@ -0,0 +1,183 @@
+package input
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/yisaj/heavens_throne/database"
+	"github.com/yisaj/heavens_throne/entities"
+	"github.com/yisaj/heavens_throne/simulation"
+)
+
+// forecastResource places the player at the first location, headed for the
+// second
+type forecastResource struct {
+	database.Resource
+	locations []entities.Location
+}
+
+func (r *forecastResource) GetPlayer(ctx context.Context, twitterID string) (*entities.Player, error) {
+	return &entities.Player{
+		ID:           1,
+		TwitterID:    twitterID,
+		MartialOrder: "Staghorn Sect",
+		Location:     sql.NullInt32{Int32: 1, Valid: true},
+		NextLocation: sql.NullInt32{Int32: 2, Valid: true},
+	}, nil
+}
+
+func (r *forecastResource) GetAdjacentLocations(ctx context.Context, locationID int32) ([]int32, error) {
+	return []int32{2}, nil
+}
+
+func (r *forecastResource) GetLocations(ctx context.Context) ([]entities.Location, error) {
+	return r.locations, nil
+}
+
+func (r *forecastResource) GetLocation(ctx context.Context, locationID int32) (*entities.Location, error) {
+	for _, location := range r.locations {
+		if location.ID == locationID {
+			return &location, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+// fixedForecaster forecasts a battle at whichever location it's asked about,
+// remembering what it was asked
+type fixedForecaster struct {
+	simulating bool
+	// whether the battle is between two other orders
+	bystander bool
+	options   simulation.ForecastOptions
+}
+
+func (f *fixedForecaster) Forecast(ctx context.Context, options simulation.ForecastOptions) (*simulation.Forecast, error) {
+	if f.simulating {
+		return nil, simulation.ErrSimulating
+	}
+	f.options = options
+	location := entities.Location{ID: options.Location, Name: "Aral"}
+	if f.bystander {
+		return &simulation.Forecast{
+			Day:  5,
+			Runs: options.Runs,
+			Battles: []simulation.BattleForecast{{
+				Location:  location,
+				Armies:    map[string]int{"The Baaturate": 3, "Order Gorgona": 2},
+				Victories: map[string]float64{"The Baaturate": 0.75, "Order Gorgona": 0.25},
+				Losses:    map[string]float64{"The Baaturate": 1.5, "Order Gorgona": 1.8},
+			}},
+		}, nil
+	}
+	return &simulation.Forecast{
+		Day:  5,
+		Runs: options.Runs,
+		Battles: []simulation.BattleForecast{{
+			Location:  location,
+			Armies:    map[string]int{"Staghorn Sect": 3, "Order Gorgona": 2},
+			Victories: map[string]float64{"Staghorn Sect": 0.75, "Order Gorgona": 0.25},
+			Losses:    map[string]float64{"Staghorn Sect": 1.5, "Order Gorgona": 1.8},
+		}},
+		Captures: []simulation.CaptureForecast{{Location: location, MartialOrder: "Staghorn Sect", Chance: 0.75}},
+	}, nil
+}
+
+func TestForecast(t *testing.T) {
+	resource := &forecastResource{locations: []entities.Location{
+		{ID: 1, Name: "Vessel"},
+		{ID: 2, Name: "Aral"},
+		{ID: 3, Name: "Reach"},
+	}}
+
+	tests := []struct {
+		name       string
+		argument   string
+		simulating bool
+		bystander  bool
+		location   int32
+		expected   []string
+	}{
+		{"headed", "", false, false, 2, []string{"ARAL, DAY 5", "about 75% of the time, losing about 1.5", "Aral falls to Staghorn Sect"}},
+		{"standing", "vessel", false, false, 1, []string{"VESSEL"}},
+		{"bystander", "", false, true, 2, []string{"A battle between Order Gorgona and The Baaturate is likely."}},
+		{"too far", "reach", false, false, 0, []string{"too far"}},
+		{"simulating", "", true, false, 0, []string{"being simulated"}},
+	}
+	for _, test := range tests {
+		speaker := &recordingSpeaker{}
+		forecaster := &fixedForecaster{simulating: test.simulating, bystander: test.bystander}
+		h := newInputHandler(resource, speaker, nil, forecaster, nil, simulation.TempleRules{}, nil, nil, nil)
+
+		err := h.Forecast(context.Background(), "player", test.argument)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if forecaster.options.Location != test.location {
+			t.Errorf("%s: expected a forecast of %d, got %d", test.name, test.location, forecaster.options.Location)
+		}
+		if test.location != 0 && forecaster.options.Order != "Staghorn Sect" {
+			t.Errorf("%s: expected only the player's order's movements, got %q", test.name, forecaster.options.Order)
+		}
+		for _, expected := range test.expected {
+			if len(speaker.sent) != 1 || !strings.Contains(speaker.sent[0], expected) {
+				t.Errorf("%s: expected %q in %v", test.name, expected, speaker.sent)
+			}
+		}
+		if test.bystander && strings.Contains(speaker.sent[0], "holds the field") {
+			t.Errorf("%s: expected no odds for an order not in the battle, got %s", test.name, speaker.sent[0])
+		}
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	speaker := &recordingSpeaker{}
+	forecaster := &fixedForecaster{}
+	h := newInputHandler(nil, speaker, nil, forecaster, nil, simulation.TempleRules{}, nil, nil, nil)
+
+	err := h.DryRun(context.Background(), "admin", "lots")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = h.DryRun(context.Background(), "admin", "50")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(speaker.sent) != 2 || !strings.Contains(speaker.sent[0], "not a number") {
+		t.Fatalf("expected the runs to be refused first, got %v", speaker.sent)
+	}
+	if forecaster.options.Runs != 50 || forecaster.options.Order != "" {
+		t.Errorf("expected 50 runs with every order moving, got %+v", forecaster.options)
+	}
+	for _, expected := range []string{"DAY 5, 50 RUNS", "Order Gorgona: 2 units, wins 25%, loses 1.8", "Aral by Staghorn Sect: 75%"} {
+		if !strings.Contains(speaker.sent[1], expected) {
+			t.Errorf("expected %q in\n%s", expected, speaker.sent[1])
+		}
+	}
+}
+
+func TestFormatDryRunLimit(t *testing.T) {
+	forecast := &simulation.Forecast{Day: 5, Runs: 100}
+	for i := 0; i < 82; i++ {
+		location := entities.Location{ID: int32(i), Name: fmt.Sprintf("Location %d", i)}
+		forecast.Battles = append(forecast.Battles, simulation.BattleForecast{
+			Location:  location,
+			Armies:    map[string]int{"Staghorn Sect": 3, "Order Gorgona": 2, "The Baaturate": 1},
+			Victories: map[string]float64{"Staghorn Sect": 0.5, "Order Gorgona": 0.3, "The Baaturate": 0.2},
+			Losses:    map[string]float64{"Staghorn Sect": 1, "Order Gorgona": 2, "The Baaturate": 1},
+		})
+		forecast.Captures = append(forecast.Captures, simulation.CaptureForecast{Location: location, MartialOrder: "Staghorn Sect", Chance: 0.5})
+	}
+
+	msg := formatDryRun(forecast)
+	if len(msg) > maxReplyLength {
+		t.Errorf("expected at most %d characters, got %d", maxReplyLength, len(msg))
+	}
+	if !strings.Contains(msg, "more.") || !strings.Contains(msg, "Location 0\n") {
+		t.Errorf("expected the first battles and a truncation line, got\n%s", msg)
+	}
+}
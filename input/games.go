@@ -0,0 +1,147 @@
+package input
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/yisaj/heavens_throne/database"
+	"github.com/yisaj/heavens_throne/events"
+	"github.com/yisaj/heavens_throne/simulation"
+	"github.com/yisaj/heavens_throne/twitspeak"
+
+	"github.com/pkg/errors"
+)
+
+// Game is one of the games running in the deployment, as player input sees it
+type Game struct {
+	Name       string
+	Resource   database.Resource
+	Simulator  simulation.Simulator
+	Forecaster simulation.Forecaster
+	Dispatcher *events.Dispatcher
+	Rules      simulation.TempleRules
+}
+
+// games knows every game and which one each player is playing. players who
+// never picked one play the first
+type games struct {
+	list        []Game
+	memberships database.MembershipResource
+}
+
+// newGames constructs the game directory. memberships are kept alongside the
+// first game
+func newGames(list []Game) *games {
+	return &games{
+		list,
+		list[0].Resource,
+	}
+}
+
+// find looks up a game by name
+func (g *games) find(name string) *Game {
+	for i := range g.list {
+		if g.list[i].Name == name {
+			return &g.list[i]
+		}
+	}
+	return nil
+}
+
+// playing returns the game the player is playing
+func (g *games) playing(ctx context.Context, twitterID string) (*Game, error) {
+	name, err := g.memberships.GetGameMembership(ctx, twitterID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed finding player's game")
+	}
+	// a game that's no longer running leaves its players in the first
+	if game := g.find(name); game != nil {
+		return game, nil
+	}
+	return &g.list[0], nil
+}
+
+// Games lists every game, marking the one the player is playing
+func (h *handler) Games(ctx context.Context, recipientID string) error {
+	const gamesHeader = `
+GAMES:
+`
+	const gamesFooter = `
+Type !play [game] to switch.
+`
+
+	playing, err := h.games.playing(ctx, recipientID)
+	if err != nil {
+		return errors.Wrap(err, "failed listing games")
+	}
+
+	var msg strings.Builder
+	msg.WriteString(gamesHeader)
+	options := make([]twitspeak.QuickReplyOption, 0, len(h.games.list))
+	for _, game := range h.games.list {
+		if game.Name == playing.Name {
+			msg.WriteString(fmt.Sprintf("%s (playing)\n", game.Name))
+			continue
+		}
+		msg.WriteString(game.Name + "\n")
+		options = append(options, twitspeak.QuickReplyOption{Label: game.Name, Metadata: "!play " + game.Name})
+	}
+	msg.WriteString(gamesFooter)
+
+	err = h.speaker.SendDMWithOptions(recipientID, msg.String(), options)
+	if err != nil {
+		return errors.Wrap(err, "failed to send games message")
+	}
+	return nil
+}
+
+// Play switches which game the player's DMs go to. their unit in the game they
+// leave stays in play, fighting and dying without them
+func (h *handler) Play(ctx context.Context, recipientID string, name string) error {
+	const alreadyPlaying = `
+You're already playing %s.
+`
+	const switched = `
+You're now playing %s.
+`
+	const notJoined = `
+Type !join [order] to join.
+`
+
+	game := h.games.find(strings.TrimSpace(name))
+	if game == nil {
+		return h.Games(ctx, recipientID)
+	}
+
+	playing, err := h.games.playing(ctx, recipientID)
+	if err != nil {
+		return errors.Wrap(err, "failed switching games")
+	}
+	if playing.Name == game.Name {
+		err = h.speaker.SendDM(recipientID, fmt.Sprintf(alreadyPlaying, game.Name))
+		if err != nil {
+			return errors.Wrap(err, "failed to send already playing message")
+		}
+		return nil
+	}
+
+	err = h.games.memberships.SetGameMembership(ctx, recipientID, game.Name)
+	if err != nil {
+		return errors.Wrap(err, "failed switching games")
+	}
+
+	player, err := game.Resource.GetPlayer(ctx, recipientID)
+	if err != nil {
+		return errors.Wrap(err, "failed switching games")
+	}
+	if player == nil {
+		err = h.speaker.SendDMWithOptions(recipientID, fmt.Sprintf(switched, game.Name)+notJoined, orderOptions)
+	} else {
+		err = h.speaker.SendDM(recipientID, fmt.Sprintf(switched, game.Name))
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to send switched games message")
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package input
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/yisaj/heavens_throne/config"
+	"github.com/yisaj/heavens_throne/database"
+	"github.com/yisaj/heavens_throne/entities"
+	"github.com/yisaj/heavens_throne/twitspeak"
+
+	"github.com/sirupsen/logrus"
+)
+
+// gameResource fakes a game's players, counting how often they're looked up.
+// the first game keeps the memberships
+type gameResource struct {
+	database.Resource
+	players     map[string]bool
+	playerReads int
+	memberships map[string]string
+}
+
+func (r *gameResource) GetPlayer(ctx context.Context, twitterID string) (*entities.Player, error) {
+	r.playerReads++
+	if !r.players[twitterID] {
+		return nil, nil
+	}
+	return &entities.Player{TwitterID: twitterID, Active: true}, nil
+}
+
+func (r *gameResource) GetGameMembership(ctx context.Context, twitterID string) (string, error) {
+	return r.memberships[twitterID], nil
+}
+
+func (r *gameResource) SetGameMembership(ctx context.Context, twitterID string, game string) error {
+	r.memberships[twitterID] = game
+	return nil
+}
+
+// replySpeaker keeps the replies to each DM
+type replySpeaker struct {
+	twitspeak.TwitterSpeaker
+	replies []string
+}
+
+func (s *replySpeaker) SendDMWithOptions(userID string, msg string, options []twitspeak.QuickReplyOption) error {
+	s.replies = append(s.replies, msg)
+	return nil
+}
+
+func TestGameRouting(t *testing.T) {
+	main := &gameResource{players: map[string]bool{"1": true}, memberships: make(map[string]string)}
+	fast := &gameResource{players: map[string]bool{}}
+	speaker := &replySpeaker{}
+	logger := logrus.New()
+	logger.SetOutput(ioutil.Discard)
+	parser := NewDMParser(&config.Config{}, speaker, logger, []Game{
+		{Name: "main", Resource: main},
+		{Name: "fast", Resource: fast},
+	})
+
+	tests := []struct {
+		msg      string
+		expected []string
+		// which game should have looked the player up
+		read *gameResource
+		// whether the reply came from the fast game
+		labeled bool
+	}{
+		{"!games", []string{"main (playing)", "fast"}, nil, false},
+		{"!play fast", []string{"You're now playing fast.", "!join"}, fast, false},
+		{"!status", []string{"You haven't joined the war yet."}, fast, true},
+		{"!play fast", []string{"You're already playing fast."}, nil, true},
+		{"!play nowhere", []string{"main\n", "fast (playing)"}, nil, true},
+		{"!play main", []string{"You're now playing main."}, main, true},
+		{"!games", []string{"main (playing)"}, nil, false},
+	}
+	for _, test := range tests {
+		mainReads, fastReads := main.playerReads, fast.playerReads
+		err := parser.ParseDM(context.Background(), "1", test.msg)
+		if err != nil {
+			t.Fatalf("%s: %v", test.msg, err)
+		}
+
+		reply := speaker.replies[len(speaker.replies)-1]
+		for _, expected := range test.expected {
+			if !strings.Contains(reply, expected) {
+				t.Errorf("%s: expected %q in %q", test.msg, expected, reply)
+			}
+		}
+		if strings.HasPrefix(reply, "[FAST]\n") != test.labeled {
+			t.Errorf("%s: expected the reply to be labeled only by the fast game, got %q", test.msg, reply)
+		}
+		if test.read == main && (main.playerReads == mainReads || fast.playerReads != fastReads) {
+			t.Errorf("%s: expected the main game to be read", test.msg)
+		}
+		if test.read == fast && (fast.playerReads == fastReads || main.playerReads != mainReads) {
+			t.Errorf("%s: expected the fast game to be read", test.msg)
+		}
+	}
+	if main.memberships["1"] != "main" {
+		t.Errorf("expected the player to be back in the main game, got %q", main.memberships["1"])
+	}
+}
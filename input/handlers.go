@@ -2,64 +2,35 @@ package input
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"regexp"
-	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/yisaj/heavens_throne/atlas"
 	"github.com/yisaj/heavens_throne/database"
+	"github.com/yisaj/heavens_throne/entities"
+	"github.com/yisaj/heavens_throne/events"
 	"github.com/yisaj/heavens_throne/simulation"
 	"github.com/yisaj/heavens_throne/twitspeak"
 
 	"github.com/pkg/errors"
 )
 
+const (
+	// how many order chat messages a player can send per window
+	orderChatLimit  = 5
+	orderChatWindow = time.Hour
+	// how long the order log is by default, and at most
+	defaultOrderLogLength = 10
+	maxOrderLogLength     = 25
+	// the longest order chat message that will be relayed
+	maxOrderMessageLength = 500
+)
+
 var (
-	locationIDs = map[string]int32{
-		"heavensthrone": 0, "heavens": 0, "heaven": 0, "throne": 0, "throneofheaven": 0, "power": 0, "madness": 0,
-		"sulferpoint": 1, "sulfer": 1, "point": 1,
-		"herebe": 2, "here": 2, "be": 2,
-		"nowhere":        3,
-		"stcecilsbridge": 4, "stcecils": 4, "cecilsbridge": 4, "st": 4, "cecils": 4, "bridge": 4,
-		"saintcecilsbridge": 4, "saintcecils": 4, "saint": 4, "stcecil": 4, "saintcecil": 4, "cecil": 4,
-		"eyeofgideon": 5, "eye": 5, "gideon": 5, "gideonseye": 5, "eyeof": 5, "ofgideon": 5, "gideons": 5,
-		"newdelphia": 6, "new": 6, "delphia": 6,
-		"fog":      7,
-		"wormland": 8, "worm": 8,
-		"passageofsmoke": 9, "passage": 9, "passageof": 9, "ofsmoke": 9, "smoke": 9, "smokepassage": 9,
-		"theashsea": 10, "ashsea": 10, "ash": 10, "sea": 10,
-		"asteria":      11,
-		"york":         12,
-		"hideousmarsh": 13, "hideous": 13, "marsh": 13,
-		"necropolis": 14, "necro": 14, "polis": 14,
-		"crawlerpits": 15, "crawler": 15, "pits": 15,
-		"obsidianlake": 16, "obsidian": 16, "lake": 16,
-		"grisag":    17,
-		"fucoterre": 18, "fuco": 18, "terre": 18,
-		"campgray": 19, "gray": 19,
-		"campwatkins": 20, "watkins": 20,
-		"gallows":   21,
-		"mercycove": 22, "mercy": 22, "cove": 22,
-		"giantsbluff": 23, "giants": 23, "giant": 23, "bluff": 23,
-		"hbeach": 24, "hollowbeach": 24, "hollow": 24, "beach": 24,
-		"duncantalley": 25, "duncan": 25, "talley": 25,
-		"mangrove":   26,
-		"lighthouse": 27, "light": 27, "house": 27,
-		"apostlevalley": 28, "apostle": 28, "valley": 28,
-		"poppyfields": 29, "poppy": 29, "fields": 29, "field": 29,
-		"agathinias": 30,
-		"ithmont":    31,
-		"whitecrypt": 32, "white": 32, "crypt": 32,
-		"visygi":     33,
-		"outerrealm": 34, "outer": 34, "realm": 34,
-		"memoria": 35,
-		"hemwood": 36, "hem": 36, "wood": 36,
-		"rivercrossing": 37, "river": 37, "crossing": 37,
-		"foolsway": 38, "fools": 38, "fool": 38, "way": 38,
-		"landfall": 39, "fall": 39,
-		"bouchardsisland": 40, "bouchards": 40, "bouchard": 40, "island": 40,
-	}
 	maxClassRanks = map[string]int16{
 		"recruit":  1,
 		"infantry": 3, "cavalry": 3, "ranger": 3,
@@ -74,6 +45,15 @@ var (
 		"archer": {"mage"}, "medic": {"healer"},
 		"glaivemaster": {}, "legionary": {}, "monsterknight": {}, "horsearcher": {}, "mage": {}, "healer": {},
 	}
+	orderOptions = []twitspeak.QuickReplyOption{
+		{Label: "Staghorn Sect", Metadata: "!join staghorn"},
+		{Label: "Order Gorgona", Metadata: "!join gorgona"},
+		{Label: "The Baaturate", Metadata: "!join baaturate"},
+	}
+	quitOptions = []twitspeak.QuickReplyOption{
+		{Label: "Leave", Description: "Leave the game for good", Metadata: "!quit confirm"},
+		{Label: "Stay", Metadata: "!quit cancel"},
+	}
 	classDescriptions = map[string]string{
 		"infantry":      "INFANTRY: ",
 		"cavalry":       "CAVALRY: ",
@@ -95,17 +75,34 @@ var (
 
 // Handler contains methods to handle each of the possible player inputs
 type Handler interface {
-	Help(ctx context.Context, recipientID string) error
+	Help(ctx context.Context, recipientID string, topic string) error
 	Status(ctx context.Context, recipientID string) error
 	Logistics(ctx context.Context, recipientID string, locationString string) error
 	Join(ctx context.Context, recipientID string, order string) error
 	Move(ctx context.Context, recipientID string, location string) error
+	March(ctx context.Context, recipientID string, location string) error
+	Scout(ctx context.Context, recipientID string, location string) error
 	Advance(ctx context.Context, recipientID string, class string) error
-	Quit(ctx context.Context, recipientID string) error
+	Quit(ctx context.Context, recipientID string, confirmation string) error
 	ToggleUpdates(ctx context.Context, recipientID string) error
+	OrderChat(ctx context.Context, recipientID string, msg string) error
+	OrderLog(ctx context.Context, recipientID string, count string) error
+	ToggleOrderChat(ctx context.Context, recipientID string) error
+	Mute(ctx context.Context, recipientID string, playerNumber string) error
+	Unmute(ctx context.Context, recipientID string, playerNumber string) error
+	Vote(ctx context.Context, recipientID string, playerNumber string) error
+	Rally(ctx context.Context, recipientID string, location string) error
+	Objective(ctx context.Context, recipientID string, objective string) error
+	Broadcast(ctx context.Context, recipientID string, msg string) error
+	Stance(ctx context.Context, recipientID string, stance string) error
+	Target(ctx context.Context, recipientID string, family string) error
 	InvalidCommand(ctx context.Context, recipientID string) error
+	Games(ctx context.Context, recipientID string) error
+	Play(ctx context.Context, recipientID string, game string) error
+	Forecast(ctx context.Context, recipientID string, location string) error
 	Echo(ctx context.Context, recipientID string, msg string) error
 	Simulate(ctx context.Context, recipientID string) error
+	DryRun(ctx context.Context, recipientID string, runs string) error
 	Tweet(ctx context.Context, recipientID string, msg string) error
 	Reply(ctx context.Context, recipientID string, argument string) error
 	ImageTweet(ctx context.Context, recipientID string, filename string) error
@@ -114,40 +111,93 @@ type Handler interface {
 // A player input handler has to be able to access database resources and respond
 // to the player via a twitter speaker
 type handler struct {
-	resource  database.Resource
-	speaker   twitspeak.TwitterSpeaker
-	simulator simulation.Simulator
+	resource   database.Resource
+	speaker    twitspeak.TwitterSpeaker
+	simulator  simulation.Simulator
+	forecaster simulation.Forecaster
+	dispatcher *events.Dispatcher
+	rules      simulation.TempleRules
+	commands   *registry
+	moderators []Moderator
+	games      *games
 }
 
-// newInputHandler constructs a handler to handle player input
-func newInputHandler(resource database.Resource, speaker twitspeak.TwitterSpeaker, simulator simulation.Simulator) Handler {
+// newInputHandler constructs a handler to handle player input for a game
+func newInputHandler(resource database.Resource, speaker twitspeak.TwitterSpeaker, simulator simulation.Simulator,
+	forecaster simulation.Forecaster, dispatcher *events.Dispatcher, rules simulation.TempleRules, commands *registry,
+	moderators []Moderator, games *games) Handler {
 	return &handler{
 		resource,
 		speaker,
 		simulator,
+		forecaster,
+		dispatcher,
+		rules,
+		commands,
+		moderators,
+		games,
 	}
 }
 
-// Help sends the player a list of commands and info about the game
-func (h *handler) Help(ctx context.Context, recipientID string) error {
+// Help sends the player a list of the commands they can use, or detailed usage
+// for a single command
+func (h *handler) Help(ctx context.Context, recipientID string, topic string) error {
 	const newPlayerHelp = `
-	Type !join [order] to join.
+Type !join [order] to join.
+`
+	const commandsHeader = `
+Commands:
+`
+	const commandsFooter = `
+Type !help [command] to learn more.
+`
+	const commandHelp = `
+%s
+%s
 `
-	const activePlayerHelp = `
-	!status to see your status.
+	const aliasesFormat = `Also: %s
 `
+	const unknownCommand = `
+There's no command called %s.
+`
+
+	if topic != "" {
+		cmd := h.commands.find(topic, recipientID)
+		if cmd == nil {
+			err := h.speaker.SendDM(recipientID, fmt.Sprintf(unknownCommand, topic))
+			if err != nil {
+				return errors.Wrap(err, "failed sending unknown command message")
+			}
+			return nil
+		}
+
+		msg := fmt.Sprintf(commandHelp, cmd.usage(), cmd.help)
+		if len(cmd.aliases) > 0 {
+			msg += fmt.Sprintf(aliasesFormat, "!"+strings.Join(cmd.aliases, ", !"))
+		}
+		err := h.speaker.SendDM(recipientID, msg)
+		if err != nil {
+			return errors.Wrap(err, "failed sending command help message")
+		}
+		return nil
+	}
 
 	player, err := h.resource.GetPlayer(ctx, recipientID)
 	if err != nil {
 		return errors.Wrap(err, "failed parsing DM")
 	}
 
+	var msg strings.Builder
 	if player == nil {
-		err = h.speaker.SendDM(recipientID, newPlayerHelp)
-	} else {
-		err = h.speaker.SendDM(recipientID, activePlayerHelp)
+		msg.WriteString(newPlayerHelp)
+	}
+	msg.WriteString(commandsHeader)
+	for _, cmd := range h.commands.available(recipientID) {
+		msg.WriteString(fmt.Sprintf("%s - %s\n", cmd.usage(), cmd.summary))
 	}
+	msg.WriteString(commandsFooter)
 
+	err = h.speaker.SendDM(recipientID, msg.String())
 	if err != nil {
 		return errors.Wrap(err, "failed sending help message")
 	}
@@ -162,10 +212,19 @@ Class: %s
 Experience: %d
 Location: %s
 Next Location: %s
+Stance: %s
+Target priority: %s
 `
 	const advanceFormat = `
 You have an !advance available
 `
+	const experienceHeader = `
+Recent experience:
+`
+	const marchFormat = `
+Marching to %s: %s
+`
+	const experienceDays = 3
 
 	player, err := h.resource.GetPlayer(ctx, recipientID)
 	if err != nil {
@@ -175,7 +234,6 @@ You have an !advance available
 		return nil
 	}
 
-	// TODO ENGINEER: include status for dead players
 	if player.IsAlive() {
 		location, err := h.resource.GetLocation(ctx, player.Location.Int32)
 		if err != nil || location == nil {
@@ -186,38 +244,203 @@ You have an !advance available
 			return errors.Wrap(err, "failed sending player status")
 		}
 
-		msg := fmt.Sprintf(statusFormat, player.MartialOrder, player.FormatClass(), player.Experience, location.Name, nextLocation.Name)
+		targetPriority := "none"
+		if player.TargetPriority.Valid {
+			targetPriority = player.TargetPriority.String
+		}
+
+		msg := fmt.Sprintf(statusFormat, player.MartialOrder, player.FormatClass(), player.Experience,
+			location.Name, nextLocation.Name, player.Stance, targetPriority)
 		if player.Experience >= 100 {
 			msg += fmt.Sprintf(advanceFormat)
 		}
 
+		march, err := h.resource.GetPlayerMarch(ctx, recipientID)
+		if err != nil {
+			return errors.Wrap(err, "failed sending player status")
+		}
+		if march != nil {
+			destination, err := h.resource.GetLocation(ctx, march.Destination())
+			if err != nil {
+				return errors.Wrap(err, "failed sending player status")
+			}
+			route, err := h.formatRoute(ctx, march.Route)
+			if err != nil {
+				return errors.Wrap(err, "failed sending player status")
+			}
+			msg += fmt.Sprintf(marchFormat, destination.Name, route)
+		}
+
+		commander, err := h.resource.GetCommander(ctx, player.MartialOrder)
+		if err != nil {
+			return errors.Wrap(err, "failed sending player status")
+		}
+		if commander != nil {
+			msg += "\n" + commander.Format()
+		}
+
+		gains, err := h.resource.GetRecentExperience(ctx, recipientID, experienceDays)
+		if err != nil {
+			return errors.Wrap(err, "failed sending player status")
+		}
+		if len(gains) > 0 {
+			msg += experienceHeader
+			for _, gain := range gains {
+				msg += fmt.Sprintf("Day %d: %s\n", gain.Day, gain.Format())
+			}
+		}
+
 		err = h.speaker.SendDM(recipientID, msg)
 		if err != nil {
 			return errors.Wrap(err, "failed sending help message")
 		}
+	} else {
+		err = h.deadStatus(ctx, player)
+		if err != nil {
+			return errors.Wrap(err, "failed sending player status")
+		}
 	}
 
 	return nil
 }
 
-// Logistics sends the player information about where allied units are and where
-// they're going
-// TODO ENGINEER: this shit is broke yo. displayed logistics are reversed, seemingly?
-func (h *handler) Logistics(ctx context.Context, recipientID string, locationString string) error {
-	const notFound = `
-That's not a place that I know of.
+// deadStatus tells a dead player how they died and when they'll be back
+func (h *handler) deadStatus(ctx context.Context, player *entities.Player) error {
+	const statusFormat = `
+Order: %s
+Class: %s
+Experience: %d
+Location: among the dead
 `
-	const allHeader = `
-Here's all the logistics
-----------------------------
+	const respawnFormat = `
+You'll return at your temple in %s with the next update.
+`
+	const templeHeldFormat = `
+Your temple in %s is held by %s.`
+	const templeLostFormat = `
+Your temple in %s has fallen.`
+	const waitForTemple = ` You can't return there until your order retakes it.
 `
-	const arrivingHeader = `
-These are the arriving logistics
+	const noFallback = ` Your order holds no ground to fall back to, so you can't return until it retakes your temple.
+`
+	const eliminated = `
+Your order is out of the war. You won't return.
+`
+
+	msg := fmt.Sprintf(statusFormat, player.MartialOrder, player.FormatClass(), player.Experience)
+
+	death, err := h.resource.GetPlayerDeath(ctx, player.TwitterID)
+	if err != nil {
+		return errors.Wrap(err, "failed getting dead player status")
+	}
+	if death != nil {
+		msg += "\n" + death.Format() + "\n"
+	}
+
+	out, err := h.resource.IsOrderEliminated(ctx, player.MartialOrder)
+	if err != nil {
+		return errors.Wrap(err, "failed getting dead player status")
+	}
+	templeID, err := h.resource.GetTempleLocation(ctx, player.MartialOrder)
+	if err != nil {
+		return errors.Wrap(err, "failed getting dead player status")
+	}
+	temple, err := h.resource.GetLocation(ctx, templeID)
+	if err != nil {
+		return errors.Wrap(err, "failed getting dead player status")
+	}
+	if out {
+		msg += eliminated
+	} else if temple.Owner.Valid && temple.Owner.String == player.MartialOrder {
+		msg += fmt.Sprintf(respawnFormat, temple.Name)
+	} else {
+		if temple.Owner.Valid {
+			msg += fmt.Sprintf(templeHeldFormat, temple.Name, temple.Owner.String)
+		} else {
+			msg += fmt.Sprintf(templeLostFormat, temple.Name)
+		}
+
+		if h.rules.FallbackRespawnDays > 0 && death != nil {
+			fallback, err := h.fallbackStatus(ctx, player.MartialOrder, templeID, death.Day)
+			if err != nil {
+				return errors.Wrap(err, "failed getting dead player status")
+			}
+			if fallback != "" {
+				msg += fallback
+			} else {
+				msg += noFallback
+			}
+		} else {
+			msg += waitForTemple
+		}
+	}
+
+	commander, err := h.resource.GetCommander(ctx, player.MartialOrder)
+	if err != nil {
+		return errors.Wrap(err, "failed getting dead player status")
+	}
+	if commander != nil {
+		msg += "\n" + commander.Format()
+	}
+
+	err = h.speaker.SendDM(player.TwitterID, msg)
+	if err != nil {
+		return errors.Wrap(err, "failed sending dead player status")
+	}
+	return nil
+}
 
+// fallbackStatus tells a dead player where and when they'll fall back to while
+// their temple is lost, or nothing if their order holds no ground to fall
+// back to
+func (h *handler) fallbackStatus(ctx context.Context, order string, templeID int32, deathDay int32) (string, error) {
+	const fallbackFormat = ` Unless your order retakes it first, you'll return at %s, the nearest ground your order holds, %s.
 `
-	const leavingHeader = `
-These are the leaving logistics
 
+	graph, err := h.resource.GetMapGraph(ctx)
+	if err != nil {
+		return "", err
+	}
+	locations, err := h.resource.GetLocations(ctx)
+	if err != nil {
+		return "", err
+	}
+	names := make(map[int32]string)
+	owners := make(map[int32]string)
+	for _, location := range locations {
+		names[location.ID] = location.Name
+		owners[location.ID] = location.Owner.String
+	}
+	fallback, ok := graph.Nearest(templeID, func(location int32) bool {
+		return owners[location] == order
+	})
+	if !ok {
+		return "", nil
+	}
+
+	// the update run on a day brings back those who died far enough before it
+	day, err := h.resource.GetDay(ctx)
+	if err != nil {
+		return "", err
+	}
+	when := "with the next update"
+	if returnDay := deathDay + h.rules.FallbackRespawnDays; returnDay > day {
+		when = fmt.Sprintf("on day %d", returnDay)
+	}
+	return fmt.Sprintf(fallbackFormat, names[fallback], when), nil
+}
+
+// Logistics sends the player a table of where allied units are, where they're
+// headed and how many will be left after the next move
+func (h *handler) Logistics(ctx context.Context, recipientID string, locationString string) error {
+	const allHeader = `
+Here's all the logistics
+`
+	const locationHeader = `
+Here are the logistics for %s
+`
+	const noUnits = `
+Your order has no units on the field.
 `
 
 	player, err := h.resource.GetPlayer(ctx, recipientID)
@@ -228,102 +451,205 @@ These are the leaving logistics
 		return nil
 	}
 
+	movements, err := h.resource.GetOrderMovements(ctx, player.MartialOrder)
+	if err != nil {
+		return errors.Wrap(err, "failed getting logistics")
+	}
+	logistics := tallyLogistics(movements)
+
+	var msg strings.Builder
 	if locationString == "" {
-		currentLogistics, err := h.resource.GetCurrentLogistics(ctx, player.MartialOrder)
+		commander, err := h.resource.GetCommander(ctx, player.MartialOrder)
 		if err != nil {
 			return errors.Wrap(err, "failed getting logistics")
 		}
-		nextLogistics, err := h.resource.GetNextLogistics(ctx, player.MartialOrder)
+		tallies, err := h.resource.GetVoteTally(ctx, player.MartialOrder)
 		if err != nil {
 			return errors.Wrap(err, "failed getting logistics")
 		}
 
-		sort.Slice(currentLogistics, func(i int, j int) bool {
-			return currentLogistics[i].LocationName < currentLogistics[j].LocationName
-		})
-		sort.Slice(nextLogistics, func(i int, j int) bool {
-			return nextLogistics[i].LocationName < nextLogistics[j].LocationName
-		})
-
-		var msg strings.Builder
 		msg.WriteString(allHeader)
-		i, j := 0, 0
-		for i < len(currentLogistics) && j < len(nextLogistics) {
-			current, next := currentLogistics[i], nextLogistics[j]
-			if current.LocationName < next.LocationName {
-				msg.WriteString(fmt.Sprintf("%s:  0 -> %d (%+d)\n", current.LocationName, current.Count, current.Count))
-				i++
-			} else if current.LocationName > next.LocationName {
-				msg.WriteString(fmt.Sprintf("%s: %d -> 0 (%+d)\n", next.LocationName, next.Count, -next.Count))
-				j++
-			} else {
-				msg.WriteString(fmt.Sprintf("%s: %d -> %d (%+d)\n", current.LocationName, current.Count, next.Count, next.Count-current.Count))
-				i++
-				j++
-			}
-		}
-		for i < len(currentLogistics) {
-			current := currentLogistics[i]
-			msg.WriteString(fmt.Sprintf("%s: 0 -> %d (%+d)\n", current.LocationName, current.Count, current.Count))
-			i++
+		if commander != nil {
+			msg.WriteString(commander.Format())
 		}
-		for j < len(nextLogistics) {
-			next := nextLogistics[j]
-			msg.WriteString(fmt.Sprintf("%s: %d -> 0 (%+d)\n", next.LocationName, next.Count, -next.Count))
-			j++
+		if len(tallies) > 0 {
+			msg.WriteString(entities.FormatVoteTallies(tallies))
 		}
-
-		err = h.speaker.SendDM(recipientID, msg.String())
-		if err != nil {
-			return errors.Wrap(err, "failed getting logistics")
+		msg.WriteString("\n")
+		if len(logistics) == 0 {
+			msg.WriteString(noUnits)
+		} else {
+			msg.WriteString(formatLogistics(logistics))
 		}
 	} else {
-		reg, err := regexp.Compile("[^a-zA-Z0-9]+")
+		locationID, ok, err := h.resolveLocation(ctx, recipientID, locationString, nil)
 		if err != nil {
-			return errors.Wrap(err, "failed building regexp")
+			return errors.Wrap(err, "failed getting location logistics")
+		}
+		if !ok {
+			return nil
 		}
-		locationString = reg.ReplaceAllString(locationString, "")
 
-		id, err := strconv.Atoi(locationString)
-		locationID := int32(id)
-		if err != nil {
-			id, ok := locationIDs[locationString]
-			if ok {
-				locationID = id
-			} else {
-				err = h.speaker.SendDM(recipientID, notFound)
-				if err != nil {
-					return errors.Wrap(err, "failed sending location not found message")
-				}
-				return nil
+		var view *locationLogistics
+		for _, location := range logistics {
+			if location.locationID == locationID {
+				view = location
+				break
 			}
 		}
+		if view == nil {
+			location, err := h.resource.GetLocation(ctx, locationID)
+			if err != nil {
+				return errors.Wrap(err, "failed getting location logistics")
+			}
+			view = &locationLogistics{locationID: locationID, name: location.Name}
+		}
 
-		arrivingLogistics, err := h.resource.GetArrivingLogistics(ctx, locationID)
+		enemyReport, err := h.enemyReport(ctx, player, locationID)
 		if err != nil {
 			return errors.Wrap(err, "failed getting location logistics")
 		}
-		leavingLogistics, err := h.resource.GetLeavingLogistics(ctx, locationID)
-		if err != nil {
-			return errors.Wrap(err, "failed getting location logistics")
+
+		msg.WriteString(fmt.Sprintf(locationHeader, view.name))
+		msg.WriteString("\n")
+		msg.WriteString(formatLogistics([]*locationLogistics{view}))
+		msg.WriteString(enemyReport)
+	}
+
+	err = h.speaker.SendDM(recipientID, msg.String())
+	if err != nil {
+		return errors.Wrap(err, "failed sending logistics")
+	}
+	return nil
+}
+
+// enemyReport describes the enemy forces at a location, if the player's order
+// can see that far
+func (h *handler) enemyReport(ctx context.Context, player *entities.Player, locationID int32) (string, error) {
+	const hidden = `
+Enemy forces are hidden from your order. Try !scout.
+`
+	const enemyHeader = `
+Enemy forces:
+`
+	const noEnemies = `
+No enemy forces.
+`
+
+	visible, err := h.resource.GetVisibleLocations(ctx, player.MartialOrder)
+	if err != nil {
+		return "", errors.Wrap(err, "failed getting enemy report")
+	}
+	seen := false
+	for _, visibleLocation := range visible {
+		if visibleLocation == locationID {
+			seen = true
+			break
 		}
+	}
+	if !seen {
+		return hidden, nil
+	}
+
+	presence, err := h.resource.GetEnemyPresence(ctx, locationID, player.MartialOrder)
+	if err != nil {
+		return "", errors.Wrap(err, "failed getting enemy report")
+	}
+	if len(presence) == 0 {
+		return noEnemies, nil
+	}
+
+	var msg strings.Builder
+	msg.WriteString(enemyHeader)
+	for _, enemy := range presence {
+		msg.WriteString(fmt.Sprintf("%s: %d\n", enemy.MartialOrder, enemy.Count))
+	}
+	return msg.String(), nil
+}
 
-		var msg strings.Builder
-		msg.WriteString(arrivingHeader)
-		for _, logistic := range arrivingLogistics {
-			msg.WriteString(fmt.Sprintf("%s (+%d)\n", logistic.LocationName, logistic.Count))
+// Scout sends the player an estimate of the enemy forces at or next to their
+// location. rangers give sharper estimates
+func (h *handler) Scout(ctx context.Context, recipientID string, locationString string) error {
+	const tooFar = `
+That's too far to scout. You can scout where you stand and the locations next to it.
+`
+	const scoutHeader = `
+Your scouting of %s turned up:
+`
+	const noEnemies = `
+Your scouts found no sign of the enemy at %s.
+`
+
+	player, err := h.resource.GetPlayer(ctx, recipientID)
+	if err != nil {
+		return errors.Wrap(err, "failed parsing DM")
+	}
+	if player == nil {
+		return nil
+	}
+
+	adjacentLocations, err := h.resource.GetAdjacentLocations(ctx, player.Location.Int32)
+	if err != nil {
+		return errors.Wrap(err, "failed scouting")
+	}
+	nearbyLocations := append([]int32{player.Location.Int32}, adjacentLocations...)
+
+	locationID := player.Location.Int32
+	if locationString != "" {
+		var ok bool
+		locationID, ok, err = h.resolveLocation(ctx, recipientID, locationString, nearbyLocations)
+		if err != nil {
+			return errors.Wrap(err, "failed scouting")
 		}
-		msg.WriteString(leavingHeader)
-		for _, logistic := range leavingLogistics {
-			msg.WriteString(fmt.Sprintf("%s (-%d)\n", logistic.LocationName, logistic.Count))
+		if !ok {
+			return nil
 		}
+	}
 
-		err = h.speaker.SendDM(recipientID, msg.String())
+	inRange := false
+	for _, nearbyLocation := range nearbyLocations {
+		if nearbyLocation == locationID {
+			inRange = true
+			break
+		}
+	}
+	if !inRange {
+		err = h.speaker.SendDM(recipientID, tooFar)
 		if err != nil {
-			return errors.Wrap(err, "failed getting location logistics")
+			return errors.Wrap(err, "failed sending too far message")
+		}
+		return nil
+	}
+
+	location, err := h.resource.GetLocation(ctx, locationID)
+	if err != nil {
+		return errors.Wrap(err, "failed scouting")
+	}
+	day, err := h.resource.GetDay(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed scouting")
+	}
+	presence, err := h.resource.GetEnemyPresence(ctx, locationID, player.MartialOrder)
+	if err != nil {
+		return errors.Wrap(err, "failed scouting")
+	}
+
+	var msg strings.Builder
+	for i, enemy := range presence {
+		estimate := estimateCount(enemy.Count, player.Family(), scoutSeed(player.ID, locationID, day)+int64(i))
+		if estimate > 0 {
+			msg.WriteString(fmt.Sprintf("%s: about %d\n", enemy.MartialOrder, estimate))
 		}
 	}
 
+	if msg.Len() == 0 {
+		err = h.speaker.SendDM(recipientID, fmt.Sprintf(noEnemies, location.Name))
+	} else {
+		err = h.speaker.SendDM(recipientID, fmt.Sprintf(scoutHeader, location.Name)+msg.String())
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed sending scouting report")
+	}
 	return nil
 }
 
@@ -337,12 +663,18 @@ LOCATION: %d
 `
 	const invalidOrder = `
 Invalid order. Please select from 'staghorn', 'gorgona', or 'baaturate'.
+`
+	const chooseOrder = `
+Choose your order: 'staghorn', 'gorgona', or 'baaturate'.
 `
 	const alreadyPlaying = `
 You're already playing.
 `
 	const deactivatedPlayer = `
 The Gate is closed to you. At least for this cycle.
+`
+	const eliminatedOrder = `
+%s has been driven from the war. Choose another order.
 `
 
 	player, err := h.resource.GetPlayer(ctx, recipientID)
@@ -373,13 +705,29 @@ The Gate is closed to you. At least for this cycle.
 	} else if strings.Contains(order, "baaturate") {
 		orderName = "The Baaturate"
 	} else {
-		err := h.speaker.SendDM(recipientID, invalidOrder)
+		msg := invalidOrder
+		if order == "" {
+			msg = chooseOrder
+		}
+		err := h.speaker.SendDMWithOptions(recipientID, msg, orderOptions)
 		if err != nil {
 			return errors.Wrap(err, "failed to send invalid order message")
 		}
 		return nil
 	}
 
+	eliminated, err := h.resource.IsOrderEliminated(ctx, orderName)
+	if err != nil {
+		return errors.Wrap(err, "failed checking order elimination")
+	}
+	if eliminated {
+		err = h.speaker.SendDMWithOptions(recipientID, fmt.Sprintf(eliminatedOrder, orderName), orderOptions)
+		if err != nil {
+			return errors.Wrap(err, "failed to send eliminated order message")
+		}
+		return nil
+	}
+
 	locationID, err := h.resource.GetTempleLocation(ctx, orderName)
 	if err != nil {
 		return errors.Wrap(err, "failed getting starting location")
@@ -389,6 +737,10 @@ The Gate is closed to you. At least for this cycle.
 	if err != nil {
 		return errors.Wrap(err, "failed joining new player")
 	}
+	err = h.dispatcher.Publish(ctx, events.PlayerJoined{Player: player})
+	if err != nil {
+		return errors.Wrap(err, "failed joining new player")
+	}
 
 	err = h.speaker.SendDM(recipientID, fmt.Sprintf(joinFormat, player.MartialOrder, player.FormatClass(), player.Location.Int32))
 	if err != nil {
@@ -400,17 +752,14 @@ The Gate is closed to you. At least for this cycle.
 
 // Move tries to set the player's next location to the given location
 func (h *handler) Move(ctx context.Context, recipientID string, locationString string) error {
-	const notFound = `
-That's not a place that I know of.
-`
 	const notAdjacent = `
-That's not an adjacent location.'
+That's not an adjacent location. Try !march to travel further.
+`
+	const chooseLocation = `
+Where to?
 `
 	const moving = `
 You are now moving to %s.
-`
-	const dead = `
-You are too dead to move anywhere.	
 `
 	player, err := h.resource.GetPlayer(ctx, recipientID)
 	if err != nil {
@@ -420,43 +769,35 @@ You are too dead to move anywhere.
 		return nil
 	}
 
-	if !player.IsAlive() {
-		err = h.speaker.SendDM(recipientID, dead)
+	// players moving a single step most likely mean somewhere nearby
+	adjacentLocations, err := h.resource.GetAdjacentLocations(ctx, player.Location.Int32)
+	if err != nil {
+		return errors.Wrap(err, "failed moving player")
+	}
+
+	if locationString == "" {
+		options, err := h.locationOptions(ctx, "!move", adjacentLocations)
 		if err != nil {
-			return errors.Wrap(err, "failed sending player move on dead message")
+			return errors.Wrap(err, "failed moving player")
+		}
+		err = h.speaker.SendDMWithOptions(recipientID, chooseLocation, options)
+		if err != nil {
+			return errors.Wrap(err, "failed sending choose location message")
 		}
 		return nil
 	}
+	nearbyLocations := append([]int32{player.Location.Int32}, adjacentLocations...)
 
-	reg, err := regexp.Compile("[^a-zA-Z0-9]+")
+	locationID, ok, err := h.resolveLocation(ctx, recipientID, locationString, nearbyLocations)
 	if err != nil {
-		return errors.Wrap(err, "failed building regexp")
+		return errors.Wrap(err, "failed moving player")
 	}
-	locationString = reg.ReplaceAllString(locationString, "")
-
-	id, err := strconv.Atoi(locationString)
-	locationID := int32(id)
-	if err != nil {
-		id, ok := locationIDs[locationString]
-		if ok {
-			locationID = id
-		} else {
-			err = h.speaker.SendDM(recipientID, notFound)
-			if err != nil {
-				return errors.Wrap(err, "failed sending location not found message")
-			}
-			return nil
-		}
+	if !ok {
+		return nil
 	}
 
 	if locationID != player.Location.Int32 {
-		adjacentLocations, err := h.resource.GetAdjacentLocations(ctx, player.Location.Int32)
-		if err != nil {
-			return errors.Wrap(err, "failed moving player")
-		}
-
-		// TODO ENGINEER: Revert to false after testing
-		found := true
+		found := false
 		for _, adjacentLocation := range adjacentLocations {
 			if adjacentLocation == locationID {
 				found = true
@@ -476,6 +817,11 @@ You are too dead to move anywhere.
 	if err != nil {
 		return errors.Wrap(err, "failed moving player")
 	}
+	// a direct order overrides any march in progress
+	err = h.resource.CancelPlayerMarch(ctx, recipientID)
+	if err != nil {
+		return errors.Wrap(err, "failed moving player")
+	}
 	location, err := h.resource.GetLocation(ctx, locationID)
 	if err != nil {
 		return errors.Wrap(err, "failed moving player")
@@ -488,26 +834,172 @@ You are too dead to move anywhere.
 	return nil
 }
 
-// Advance attempts to level a player up to another rank or class
-// TODO ENGINEER: think about if rank advance with a class name should error or just auto rank advance
-func (h *handler) Advance(ctx context.Context, recipientID string, class string) error {
-	const notExperienced = `
-You don't have enough experience.
-`
-	const maxClass = `
-You've already reached the peak.
-`
-	const advanceInfoHeader = `
-Here are your advances:
+// March sets the player on the shortest path to a location, moving one step a
+// day until they arrive
+func (h *handler) March(ctx context.Context, recipientID string, locationString string) error {
+	const alreadyThere = `
+You're already there.
 `
-	const rankAdvance = `
-You advanced: %s -> %s.
+	const unreachable = `
+There's no road that leads there.
 `
-	const classAdvance = `
-You advanced: %s -> %s.
+	const marching = `
+You march for %s. It will take %d days.
+Route: %s
 `
-	const unknownClass = `
-That's not a class I'm aware of.
+
+	player, err := h.resource.GetPlayer(ctx, recipientID)
+	if err != nil {
+		return errors.Wrap(err, "failed parsing DM")
+	}
+	if player == nil {
+		return nil
+	}
+
+	locationID, ok, err := h.resolveLocation(ctx, recipientID, locationString, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed marching player")
+	}
+	if !ok {
+		return nil
+	}
+
+	graph, err := h.resource.GetMapGraph(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed marching player")
+	}
+	route := graph.ShortestPath(player.Location.Int32, locationID)
+	if route == nil {
+		err = h.speaker.SendDM(recipientID, unreachable)
+		if err != nil {
+			return errors.Wrap(err, "failed sending unreachable message")
+		}
+		return nil
+	}
+	if len(route) == 0 {
+		err = h.speaker.SendDM(recipientID, alreadyThere)
+		if err != nil {
+			return errors.Wrap(err, "failed sending already there message")
+		}
+		return nil
+	}
+
+	err = h.resource.SetPlayerMarch(ctx, recipientID, route)
+	if err != nil {
+		return errors.Wrap(err, "failed marching player")
+	}
+
+	routeNames, err := h.formatRoute(ctx, route)
+	if err != nil {
+		return errors.Wrap(err, "failed marching player")
+	}
+	location, err := h.resource.GetLocation(ctx, locationID)
+	if err != nil {
+		return errors.Wrap(err, "failed marching player")
+	}
+	err = h.speaker.SendDM(recipientID, fmt.Sprintf(marching, location.Name, len(route), routeNames))
+	if err != nil {
+		return errors.Wrap(err, "failed sending marching player message")
+	}
+
+	return nil
+}
+
+// formatRoute lists the names of the locations along a route
+func (h *handler) formatRoute(ctx context.Context, route []int32) (string, error) {
+	names := make([]string, len(route))
+	for i, locationID := range route {
+		location, err := h.resource.GetLocation(ctx, locationID)
+		if err != nil {
+			return "", errors.Wrap(err, "failed formatting route")
+		}
+		names[i] = location.Name
+	}
+	return strings.Join(names, " -> "), nil
+}
+
+// locationOptions builds a quick reply button for the command on each location
+func (h *handler) locationOptions(ctx context.Context, command string, locationIDs []int32) ([]twitspeak.QuickReplyOption, error) {
+	options := make([]twitspeak.QuickReplyOption, len(locationIDs))
+	for i, locationID := range locationIDs {
+		location, err := h.resource.GetLocation(ctx, locationID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed building location options")
+		}
+		options[i] = twitspeak.QuickReplyOption{
+			Label:    location.Name,
+			Metadata: fmt.Sprintf("%s %d", command, location.ID),
+		}
+	}
+	return options, nil
+}
+
+// resolveLocation works out which location the player meant, preferring the
+// given locations when the name is ambiguous. if it can't settle on exactly one
+// location, it tells the player so and returns false
+func (h *handler) resolveLocation(ctx context.Context, recipientID string, locationString string, preferred []int32) (int32, bool, error) {
+	const notFound = `
+That's not a place that I know of.
+`
+	const ambiguous = `
+Did you mean %s?
+`
+
+	locations, err := h.resource.GetLocations(ctx)
+	if err != nil {
+		return -1, false, errors.Wrap(err, "failed resolving location")
+	}
+
+	matches := atlas.NewResolver(locations).Resolve(locationString, preferred)
+	switch len(matches) {
+	case 0:
+		err = h.speaker.SendDM(recipientID, notFound)
+		if err != nil {
+			return -1, false, errors.Wrap(err, "failed sending location not found message")
+		}
+		return -1, false, nil
+	case 1:
+		return matches[0], true, nil
+	}
+
+	names := make([]string, len(matches))
+	for i, match := range matches {
+		for _, location := range locations {
+			if location.ID == match {
+				names[i] = location.Name
+				break
+			}
+		}
+	}
+	options := strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1]
+
+	err = h.speaker.SendDM(recipientID, fmt.Sprintf(ambiguous, options))
+	if err != nil {
+		return -1, false, errors.Wrap(err, "failed sending ambiguous location message")
+	}
+	return -1, false, nil
+}
+
+// Advance attempts to level a player up to another rank or class
+// TODO ENGINEER: think about if rank advance with a class name should error or just auto rank advance
+func (h *handler) Advance(ctx context.Context, recipientID string, class string) error {
+	const notExperienced = `
+You don't have enough experience.
+`
+	const maxClass = `
+You've already reached the peak.
+`
+	const advanceInfoHeader = `
+Here are your advances:
+`
+	const rankAdvance = `
+You advanced: %s -> %s.
+`
+	const classAdvance = `
+You advanced: %s -> %s.
+`
+	const unknownClass = `
+That's not a class I'm aware of.
 `
 
 	player, err := h.resource.GetPlayer(ctx, recipientID)
@@ -561,7 +1053,7 @@ That's not a class I'm aware of.
 				msg.WriteString(classDescriptions[advance])
 			}
 
-			err := h.speaker.SendDM(recipientID, msg.String())
+			err := h.speaker.SendDMWithOptions(recipientID, msg.String(), advanceOptions(advances))
 			if err != nil {
 				return errors.Wrap(err, "failed getting advance info")
 			}
@@ -596,7 +1088,7 @@ That's not a class I'm aware of.
 		}
 
 		// unknown advance name
-		err = h.speaker.SendDM(recipientID, unknownClass)
+		err = h.speaker.SendDMWithOptions(recipientID, unknownClass, advanceOptions(advances))
 		if err != nil {
 			return errors.Wrap(err, "failed advancing player class")
 		}
@@ -605,11 +1097,29 @@ That's not a class I'm aware of.
 	return nil
 }
 
+// advanceOptions builds a quick reply button for each class advance
+func advanceOptions(advances []string) []twitspeak.QuickReplyOption {
+	options := make([]twitspeak.QuickReplyOption, len(advances))
+	for i, advance := range advances {
+		options[i] = twitspeak.QuickReplyOption{
+			Label:    entities.FormatClassName(advance),
+			Metadata: "!advance " + advance,
+		}
+	}
+	return options
+}
+
 // Quit deactivates a player's account
 // TODO DESIGN: remember to deactivate instead of deleting (also think of rejoin logic)
-func (h *handler) Quit(ctx context.Context, recipientID string) error {
+func (h *handler) Quit(ctx context.Context, recipientID string, confirmation string) error {
 	quitMsg := `
 Heaven's Gate closes behind you.
+`
+	const confirmQuit = `
+Are you sure you want to leave? There's no coming back. Type !quit confirm to leave.
+`
+	const stayMsg = `
+Heaven's Gate stays open.
 `
 
 	player, err := h.resource.GetPlayer(ctx, recipientID)
@@ -620,6 +1130,22 @@ Heaven's Gate closes behind you.
 		return nil
 	}
 
+	switch confirmation {
+	case "confirm", "yes":
+	case "":
+		err = h.speaker.SendDMWithOptions(recipientID, confirmQuit, quitOptions)
+		if err != nil {
+			return errors.Wrap(err, "failed to send quit confirmation message")
+		}
+		return nil
+	default:
+		err = h.speaker.SendDM(recipientID, stayMsg)
+		if err != nil {
+			return errors.Wrap(err, "failed to send stay message")
+		}
+		return nil
+	}
+
 	err = h.resource.DeletePlayer(ctx, recipientID)
 	if err != nil {
 		return errors.Wrap(err, "failed quitting game")
@@ -666,6 +1192,593 @@ You will now receive daily personal battle reports.
 	return nil
 }
 
+// Stance sets how the player behaves in battle
+func (h *handler) Stance(ctx context.Context, recipientID string, stance string) error {
+	const stanceInfo = `
+Your stance is %s.
+Choose from: %s
+`
+	const invalidStance = `
+That's not a stance. Choose from: %s
+`
+	const stanceSet = `
+You will fight with a %s stance.
+`
+
+	player, err := h.resource.GetPlayer(ctx, recipientID)
+	if err != nil {
+		return errors.Wrap(err, "failed parsing DM")
+	}
+	if player == nil {
+		return nil
+	}
+
+	stances := strings.Join(entities.Stances, ", ")
+	stance = strings.TrimSpace(stance)
+	if stance == "" {
+		err = h.speaker.SendDM(recipientID, fmt.Sprintf(stanceInfo, player.Stance, stances))
+		if err != nil {
+			return errors.Wrap(err, "failed sending stance info")
+		}
+		return nil
+	}
+
+	if !entities.IsStance(stance) {
+		err = h.speaker.SendDM(recipientID, fmt.Sprintf(invalidStance, stances))
+		if err != nil {
+			return errors.Wrap(err, "failed sending invalid stance message")
+		}
+		return nil
+	}
+
+	err = h.resource.SetPlayerStance(ctx, recipientID, stance)
+	if err != nil {
+		return errors.Wrap(err, "failed setting stance")
+	}
+
+	err = h.speaker.SendDM(recipientID, fmt.Sprintf(stanceSet, stance))
+	if err != nil {
+		return errors.Wrap(err, "failed sending stance set message")
+	}
+	return nil
+}
+
+// Target sets the class family the player prefers to attack in battle
+func (h *handler) Target(ctx context.Context, recipientID string, family string) error {
+	const targetInfo = `
+Your target priority is %s.
+Choose from: %s, or none
+`
+	const invalidTarget = `
+That's not something you can target. Choose from: %s, or none
+`
+	const targetSet = `
+You will seek out %s in battle.
+`
+	const targetCleared = `
+You will strike at whoever stands out in battle.
+`
+
+	player, err := h.resource.GetPlayer(ctx, recipientID)
+	if err != nil {
+		return errors.Wrap(err, "failed parsing DM")
+	}
+	if player == nil {
+		return nil
+	}
+
+	families := strings.Join(entities.ClassFamilies, ", ")
+	family = strings.TrimSpace(family)
+	if family == "" {
+		priority := "none"
+		if player.TargetPriority.Valid {
+			priority = player.TargetPriority.String
+		}
+		err = h.speaker.SendDM(recipientID, fmt.Sprintf(targetInfo, priority, families))
+		if err != nil {
+			return errors.Wrap(err, "failed sending target info")
+		}
+		return nil
+	}
+
+	var msg string
+	if family == "none" {
+		family = ""
+		msg = targetCleared
+	} else if entities.IsClassFamily(family) {
+		msg = fmt.Sprintf(targetSet, family)
+	} else {
+		err = h.speaker.SendDM(recipientID, fmt.Sprintf(invalidTarget, families))
+		if err != nil {
+			return errors.Wrap(err, "failed sending invalid target message")
+		}
+		return nil
+	}
+
+	err = h.resource.SetPlayerTargetPriority(ctx, recipientID, family)
+	if err != nil {
+		return errors.Wrap(err, "failed setting target priority")
+	}
+
+	err = h.speaker.SendDM(recipientID, msg)
+	if err != nil {
+		return errors.Wrap(err, "failed sending target set message")
+	}
+	return nil
+}
+
+// OrderChat relays a message to every member of the player's order who's
+// listening
+func (h *handler) OrderChat(ctx context.Context, recipientID string, msg string) error {
+	const relayFormat = `
+[%s] %s
+`
+	const rateLimited = `
+You've sent too many messages. Give your order a moment.
+`
+	const refused = `
+%s
+`
+	const relayed = `
+Message passed along to %d of your order.
+`
+
+	player, err := h.resource.GetPlayer(ctx, recipientID)
+	if err != nil {
+		return errors.Wrap(err, "failed parsing DM")
+	}
+	if player == nil {
+		return nil
+	}
+
+	sent, err := h.resource.CountOrderMessagesSince(ctx, recipientID, time.Now().Add(-orderChatWindow))
+	if err != nil {
+		return errors.Wrap(err, "failed relaying order message")
+	}
+	if sent >= orderChatLimit {
+		err = h.speaker.SendDM(recipientID, rateLimited)
+		if err != nil {
+			return errors.Wrap(err, "failed sending rate limited message")
+		}
+		return nil
+	}
+
+	msg, reason, err := h.moderate(ctx, player, msg)
+	if err != nil {
+		return errors.Wrap(err, "failed relaying order message")
+	}
+	if reason != "" {
+		err = h.speaker.SendDM(recipientID, fmt.Sprintf(refused, reason))
+		if err != nil {
+			return errors.Wrap(err, "failed sending refused message")
+		}
+		return nil
+	}
+
+	message, err := h.resource.CreateOrderMessage(ctx, recipientID, msg)
+	if err != nil {
+		return errors.Wrap(err, "failed relaying order message")
+	}
+	recipients, err := h.resource.GetOrderChatRecipients(ctx, recipientID)
+	if err != nil {
+		return errors.Wrap(err, "failed relaying order message")
+	}
+
+	relay := fmt.Sprintf(relayFormat, player.MartialOrder, message.Format())
+	for _, recipient := range recipients {
+		err = h.speaker.SendDM(recipient, relay)
+		if err != nil {
+			return errors.Wrap(err, "failed relaying order message")
+		}
+	}
+
+	err = h.speaker.SendDM(recipientID, fmt.Sprintf(relayed, len(recipients)))
+	if err != nil {
+		return errors.Wrap(err, "failed sending relayed message")
+	}
+	return nil
+}
+
+// OrderLog sends the player the latest messages from their order's chat
+func (h *handler) OrderLog(ctx context.Context, recipientID string, count string) error {
+	const logHeader = `
+%s chat:
+`
+	const emptyLog = `
+Your order has been silent.
+`
+	const invalidCount = `
+That's not a number of messages.
+`
+
+	player, err := h.resource.GetPlayer(ctx, recipientID)
+	if err != nil {
+		return errors.Wrap(err, "failed parsing DM")
+	}
+	if player == nil {
+		return nil
+	}
+
+	limit := defaultOrderLogLength
+	if count != "" {
+		limit, err = strconv.Atoi(count)
+		if err != nil || limit <= 0 {
+			err = h.speaker.SendDM(recipientID, invalidCount)
+			if err != nil {
+				return errors.Wrap(err, "failed sending invalid count message")
+			}
+			return nil
+		}
+		if limit > maxOrderLogLength {
+			limit = maxOrderLogLength
+		}
+	}
+
+	messages, err := h.resource.GetOrderMessages(ctx, recipientID, limit)
+	if err != nil {
+		return errors.Wrap(err, "failed getting order log")
+	}
+	if len(messages) == 0 {
+		err = h.speaker.SendDM(recipientID, emptyLog)
+		if err != nil {
+			return errors.Wrap(err, "failed sending empty order log message")
+		}
+		return nil
+	}
+
+	var msg strings.Builder
+	msg.WriteString(fmt.Sprintf(logHeader, player.MartialOrder))
+	for _, message := range messages {
+		msg.WriteString(fmt.Sprintf("Day %d %s\n", message.Day, message.Format()))
+	}
+
+	err = h.speaker.SendDM(recipientID, msg.String())
+	if err != nil {
+		return errors.Wrap(err, "failed sending order log")
+	}
+	return nil
+}
+
+// ToggleOrderChat toggles whether the player's order chat is relayed to them
+func (h *handler) ToggleOrderChat(ctx context.Context, recipientID string) error {
+	const chatOff = `
+You will no longer receive your order's chat.
+`
+	const chatOn = `
+You will now receive your order's chat. You are #%d.
+`
+
+	player, err := h.resource.GetPlayer(ctx, recipientID)
+	if err != nil {
+		return errors.Wrap(err, "failed parsing DM")
+	}
+	if player == nil {
+		return nil
+	}
+
+	receiveOrderChat, err := h.resource.ToggleOrderChat(ctx, recipientID)
+	if err != nil {
+		return errors.Wrap(err, "failed toggling order chat")
+	}
+
+	if receiveOrderChat {
+		err = h.speaker.SendDM(recipientID, fmt.Sprintf(chatOn, player.ID))
+	} else {
+		err = h.speaker.SendDM(recipientID, chatOff)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed sending toggle order chat message")
+	}
+	return nil
+}
+
+// Mute stops a member of the player's order from reaching them through order chat
+func (h *handler) Mute(ctx context.Context, recipientID string, playerNumber string) error {
+	const notFound = `
+There's nobody in your order by that number.
+`
+	const muted = `
+You will no longer hear from #%d.
+`
+
+	player, err := h.resource.GetPlayer(ctx, recipientID)
+	if err != nil {
+		return errors.Wrap(err, "failed parsing DM")
+	}
+	if player == nil {
+		return nil
+	}
+
+	mutedID, err := strconv.Atoi(strings.TrimPrefix(playerNumber, "#"))
+	found := false
+	if err == nil {
+		found, err = h.resource.MutePlayer(ctx, recipientID, int32(mutedID))
+		if err != nil {
+			return errors.Wrap(err, "failed muting player")
+		}
+	}
+
+	if found {
+		err = h.speaker.SendDM(recipientID, fmt.Sprintf(muted, mutedID))
+	} else {
+		err = h.speaker.SendDM(recipientID, notFound)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed sending mute message")
+	}
+	return nil
+}
+
+// Unmute lets a muted member of the player's order reach them again
+func (h *handler) Unmute(ctx context.Context, recipientID string, playerNumber string) error {
+	const notFound = `
+That's not a player number.
+`
+	const unmuted = `
+You will hear from #%d again.
+`
+
+	player, err := h.resource.GetPlayer(ctx, recipientID)
+	if err != nil {
+		return errors.Wrap(err, "failed parsing DM")
+	}
+	if player == nil {
+		return nil
+	}
+
+	mutedID, err := strconv.Atoi(strings.TrimPrefix(playerNumber, "#"))
+	if err != nil {
+		err = h.speaker.SendDM(recipientID, notFound)
+		if err != nil {
+			return errors.Wrap(err, "failed sending unmute message")
+		}
+		return nil
+	}
+
+	err = h.resource.UnmutePlayer(ctx, recipientID, int32(mutedID))
+	if err != nil {
+		return errors.Wrap(err, "failed unmuting player")
+	}
+
+	err = h.speaker.SendDM(recipientID, fmt.Sprintf(unmuted, mutedID))
+	if err != nil {
+		return errors.Wrap(err, "failed sending unmute message")
+	}
+	return nil
+}
+
+// Vote casts the player's vote for their order's next commander
+func (h *handler) Vote(ctx context.Context, recipientID string, playerNumber string) error {
+	const notFound = `
+There's nobody in your order by that number.
+`
+	const voted = `
+Your vote for #%d is in. It will be counted when the next commander is chosen%s.
+`
+
+	player, err := h.resource.GetPlayer(ctx, recipientID)
+	if err != nil {
+		return errors.Wrap(err, "failed parsing DM")
+	}
+	if player == nil {
+		return nil
+	}
+
+	candidateID, err := strconv.Atoi(strings.TrimPrefix(playerNumber, "#"))
+	found := false
+	if err == nil {
+		found, err = h.resource.CastVote(ctx, recipientID, int32(candidateID))
+		if err != nil {
+			return errors.Wrap(err, "failed casting vote")
+		}
+	}
+	if !found {
+		err = h.speaker.SendDM(recipientID, notFound)
+		if err != nil {
+			return errors.Wrap(err, "failed sending vote message")
+		}
+		return nil
+	}
+
+	commander, err := h.resource.GetCommander(ctx, player.MartialOrder)
+	if err != nil {
+		return errors.Wrap(err, "failed casting vote")
+	}
+	when := ""
+	if commander != nil && commander.Player.Valid {
+		when = fmt.Sprintf(" after day %d", commander.EndDay)
+	}
+
+	err = h.speaker.SendDM(recipientID, fmt.Sprintf(voted, candidateID, when))
+	if err != nil {
+		return errors.Wrap(err, "failed sending vote message")
+	}
+	return nil
+}
+
+// Rally sets the location the commander's order should gather at
+func (h *handler) Rally(ctx context.Context, recipientID string, locationString string) error {
+	const rallyCleared = `
+Your order's rally point is cleared.
+`
+	const rallySet = `
+Your order will rally at %s.
+`
+
+	player, err := h.resource.GetPlayer(ctx, recipientID)
+	if err != nil {
+		return errors.Wrap(err, "failed parsing DM")
+	}
+	if player == nil {
+		return nil
+	}
+
+	if locationString == "none" {
+		err = h.resource.SetRallyPoint(ctx, player.MartialOrder, sql.NullInt32{})
+		if err != nil {
+			return errors.Wrap(err, "failed clearing rally point")
+		}
+		err = h.speaker.SendDM(recipientID, rallyCleared)
+		if err != nil {
+			return errors.Wrap(err, "failed sending rally message")
+		}
+		return nil
+	}
+
+	locationID, ok, err := h.resolveLocation(ctx, recipientID, locationString, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed setting rally point")
+	}
+	if !ok {
+		return nil
+	}
+
+	err = h.resource.SetRallyPoint(ctx, player.MartialOrder, sql.NullInt32{Int32: locationID, Valid: true})
+	if err != nil {
+		return errors.Wrap(err, "failed setting rally point")
+	}
+	location, err := h.resource.GetLocation(ctx, locationID)
+	if err != nil {
+		return errors.Wrap(err, "failed setting rally point")
+	}
+
+	err = h.speaker.SendDM(recipientID, fmt.Sprintf(rallySet, location.Name))
+	if err != nil {
+		return errors.Wrap(err, "failed sending rally message")
+	}
+	return nil
+}
+
+// Objective sets the objective shown to the commander's whole order
+func (h *handler) Objective(ctx context.Context, recipientID string, objective string) error {
+	const objectiveCleared = `
+Your order's objective is cleared.
+`
+	const objectiveSet = `
+Your order's objective: %s
+`
+	const refused = `
+%s
+`
+
+	player, err := h.resource.GetPlayer(ctx, recipientID)
+	if err != nil {
+		return errors.Wrap(err, "failed parsing DM")
+	}
+	if player == nil {
+		return nil
+	}
+
+	if strings.EqualFold(strings.TrimSpace(objective), "none") {
+		err = h.resource.SetObjective(ctx, player.MartialOrder, "")
+		if err != nil {
+			return errors.Wrap(err, "failed clearing objective")
+		}
+		err = h.speaker.SendDM(recipientID, objectiveCleared)
+		if err != nil {
+			return errors.Wrap(err, "failed sending objective message")
+		}
+		return nil
+	}
+
+	objective, reason, err := h.moderate(ctx, player, objective)
+	if err != nil {
+		return errors.Wrap(err, "failed setting objective")
+	}
+	if reason != "" {
+		err = h.speaker.SendDM(recipientID, fmt.Sprintf(refused, reason))
+		if err != nil {
+			return errors.Wrap(err, "failed sending refused message")
+		}
+		return nil
+	}
+
+	err = h.resource.SetObjective(ctx, player.MartialOrder, objective)
+	if err != nil {
+		return errors.Wrap(err, "failed setting objective")
+	}
+
+	err = h.speaker.SendDM(recipientID, fmt.Sprintf(objectiveSet, objective))
+	if err != nil {
+		return errors.Wrap(err, "failed sending objective message")
+	}
+	return nil
+}
+
+// Broadcast sends the commander's message to every active member of their order
+func (h *handler) Broadcast(ctx context.Context, recipientID string, msg string) error {
+	const broadcastFormat = `
+[Orders from #%d] %s
+`
+	const refused = `
+%s
+`
+	const broadcasted = `
+Your orders went out to %d of your order.
+`
+
+	player, err := h.resource.GetPlayer(ctx, recipientID)
+	if err != nil {
+		return errors.Wrap(err, "failed parsing DM")
+	}
+	if player == nil {
+		return nil
+	}
+
+	msg, reason, err := h.moderate(ctx, player, msg)
+	if err != nil {
+		return errors.Wrap(err, "failed broadcasting orders")
+	}
+	if reason != "" {
+		err = h.speaker.SendDM(recipientID, fmt.Sprintf(refused, reason))
+		if err != nil {
+			return errors.Wrap(err, "failed sending refused message")
+		}
+		return nil
+	}
+
+	members, err := h.resource.GetOrderPlayers(ctx, player.MartialOrder)
+	if err != nil {
+		return errors.Wrap(err, "failed broadcasting orders")
+	}
+
+	broadcast := fmt.Sprintf(broadcastFormat, player.ID, msg)
+	sent := 0
+	for _, member := range members {
+		if member.ID == player.ID {
+			continue
+		}
+		err = h.speaker.SendDM(member.TwitterID, broadcast)
+		if err != nil {
+			return errors.Wrap(err, "failed broadcasting orders")
+		}
+		sent++
+	}
+
+	err = h.speaker.SendDM(recipientID, fmt.Sprintf(broadcasted, sent))
+	if err != nil {
+		return errors.Wrap(err, "failed sending broadcasted message")
+	}
+	return nil
+}
+
+// moderate runs a message through every moderator, stopping at the first one to
+// refuse it
+func (h *handler) moderate(ctx context.Context, player *entities.Player, msg string) (string, string, error) {
+	for _, moderator := range h.moderators {
+		var reason string
+		var err error
+		msg, reason, err = moderator.Moderate(ctx, player, msg)
+		if err != nil {
+			return "", "", errors.Wrap(err, "failed moderating message")
+		}
+		if reason != "" {
+			return "", reason, nil
+		}
+	}
+	return msg, "", nil
+}
+
 // InvalidCommand tells the player that their command wasn't recognized
 func (h *handler) InvalidCommand(ctx context.Context, recipientID string) error {
 	const invalid = `
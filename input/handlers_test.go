@@ -0,0 +1,143 @@
+package input
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/yisaj/heavens_throne/atlas"
+	"github.com/yisaj/heavens_throne/database"
+	"github.com/yisaj/heavens_throne/entities"
+	"github.com/yisaj/heavens_throne/simulation"
+)
+
+// deadResource fakes just enough of the database for a dead player's status.
+// anything else panics
+type deadResource struct {
+	database.Resource
+	locations  []entities.Location
+	eliminated bool
+}
+
+func (r *deadResource) GetPlayer(ctx context.Context, twitterID string) (*entities.Player, error) {
+	return &entities.Player{ID: 1, TwitterID: twitterID, MartialOrder: "Staghorn Sect"}, nil
+}
+
+func (r *deadResource) GetPlayerDeath(ctx context.Context, twitterID string) (*entities.Death, error) {
+	return &entities.Death{Day: 10, LocationName: "Yerk", Cause: entities.Slain}, nil
+}
+
+func (r *deadResource) IsOrderEliminated(ctx context.Context, order string) (bool, error) {
+	return r.eliminated, nil
+}
+
+func (r *deadResource) GetTempleLocation(ctx context.Context, order string) (int32, error) {
+	return 1, nil
+}
+
+func (r *deadResource) GetLocations(ctx context.Context) ([]entities.Location, error) {
+	return r.locations, nil
+}
+
+func (r *deadResource) GetLocation(ctx context.Context, locationID int32) (*entities.Location, error) {
+	for _, location := range r.locations {
+		if location.ID == locationID {
+			return &location, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (r *deadResource) GetMapGraph(ctx context.Context) (atlas.Graph, error) {
+	return atlas.Graph{1: {2}, 2: {1, 3}, 3: {2}}, nil
+}
+
+func (r *deadResource) GetDay(ctx context.Context) (int32, error) {
+	return 11, nil
+}
+
+func (r *deadResource) GetCommander(ctx context.Context, order string) (*entities.Commander, error) {
+	return nil, nil
+}
+
+func TestDeadStatus(t *testing.T) {
+	owned := func(owners ...string) []entities.Location {
+		names := []string{"Asteria", "Yerk", "Bouchard's Island"}
+		locations := make([]entities.Location, len(names))
+		for i, name := range names {
+			locations[i] = entities.Location{ID: int32(i + 1), Name: name}
+			if owners[i] != "" {
+				locations[i].Owner = sql.NullString{String: owners[i], Valid: true}
+			}
+		}
+		return locations
+	}
+
+	tests := []struct {
+		name         string
+		locations    []entities.Location
+		eliminated   bool
+		fallbackDays int32
+		expected     string
+	}{
+		{
+			name:      "temple held",
+			locations: owned("Staghorn Sect", "", ""),
+			expected:  "You'll return at your temple in Asteria with the next update.",
+		},
+		{
+			name:      "temple lost without fallback",
+			locations: owned("Order Gorgona", "", "Staghorn Sect"),
+			expected:  "You can't return there until your order retakes it.",
+		},
+		{
+			name:         "falling back later",
+			locations:    owned("Order Gorgona", "", "Staghorn Sect"),
+			fallbackDays: 3,
+			expected:     "you'll return at Bouchard's Island, the nearest ground your order holds, on day 13.",
+		},
+		{
+			name:         "falling back next update",
+			locations:    owned("", "Staghorn Sect", "Staghorn Sect"),
+			fallbackDays: 1,
+			expected:     "you'll return at Yerk, the nearest ground your order holds, with the next update.",
+		},
+		{
+			name:         "nowhere to fall back to",
+			locations:    owned("Order Gorgona", "", ""),
+			fallbackDays: 3,
+			expected:     "Your order holds no ground to fall back to",
+		},
+		{
+			name:         "order eliminated",
+			locations:    owned("Order Gorgona", "", "Staghorn Sect"),
+			eliminated:   true,
+			fallbackDays: 3,
+			expected:     "Your order is out of the war. You won't return.",
+		},
+	}
+
+	for _, test := range tests {
+		resource := &deadResource{
+			locations:  test.locations,
+			eliminated: test.eliminated,
+		}
+		speaker := &recordingSpeaker{}
+		rules := simulation.TempleRules{FallbackRespawnDays: test.fallbackDays}
+		h := newInputHandler(resource, speaker, nil, nil, nil, rules, nil, nil, nil)
+
+		err := h.Status(context.Background(), "player")
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if len(speaker.sent) != 1 {
+			t.Errorf("%s: expected one DM, got %d", test.name, len(speaker.sent))
+			continue
+		}
+		if !strings.Contains(speaker.sent[0], test.expected) {
+			t.Errorf("%s: expected %q in\n%s", test.name, test.expected, speaker.sent[0])
+		}
+	}
+}
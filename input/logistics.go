@@ -0,0 +1,126 @@
+package input
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yisaj/heavens_throne/entities"
+)
+
+// how wide the location column of the logistics table is
+const logisticsNameWidth = 16
+
+// locationLogistics is an order's view of one location: the units there now,
+// the units on their way in and out, and what's left after the next move
+type locationLogistics struct {
+	locationID     int32
+	name           string
+	current        int32
+	reinforcements int32
+	leaving        []entities.Logistic
+}
+
+// expected is the number of units that will be at the location after the next
+// move
+func (l *locationLogistics) expected() int32 {
+	expected := l.current + l.reinforcements
+	for _, logistic := range l.leaving {
+		expected -= logistic.Count
+	}
+	return expected
+}
+
+// tallyLogistics builds the per location view out of an order's movements,
+// sorted by location name
+func tallyLogistics(movements []entities.Movement) []*locationLogistics {
+	byLocation := make(map[int32]*locationLogistics)
+	get := func(locationID int32, name string) *locationLogistics {
+		logistics, ok := byLocation[locationID]
+		if !ok {
+			logistics = &locationLogistics{locationID: locationID, name: name}
+			byLocation[locationID] = logistics
+		}
+		return logistics
+	}
+
+	for _, movement := range movements {
+		source := get(movement.Source, movement.SourceName)
+		source.current += movement.Count
+		if movement.Source == movement.Destination {
+			continue
+		}
+
+		source.leaving = append(source.leaving, entities.Logistic{
+			LocationName: movement.DestinationName,
+			Count:        movement.Count,
+		})
+		get(movement.Destination, movement.DestinationName).reinforcements += movement.Count
+	}
+
+	logistics := make([]*locationLogistics, 0, len(byLocation))
+	for _, location := range byLocation {
+		sort.Slice(location.leaving, func(i int, j int) bool {
+			return location.leaving[i].LocationName < location.leaving[j].LocationName
+		})
+		logistics = append(logistics, location)
+	}
+	sort.Slice(logistics, func(i int, j int) bool {
+		return logistics[i].name < logistics[j].name
+	})
+	return logistics
+}
+
+// formatLogistics lays the logistics out in a fixed width table, with units
+// leaving a location listed under it by destination
+func formatLogistics(logistics []*locationLogistics) string {
+	const truncated = "...and %d more. Try !logistics [location].\n"
+
+	row := func(name string, current string, reinforcements string, leaving string, expected string) string {
+		line := fmt.Sprintf("%-*s %5s %5s %5s %5s", logisticsNameWidth, name, current, reinforcements, leaving, expected)
+		return strings.TrimRight(line, " ") + "\n"
+	}
+
+	var table strings.Builder
+	table.WriteString(row("Location", "Now", "In", "Out", "Next"))
+	table.WriteString(row(strings.Repeat("-", logisticsNameWidth), "-----", "-----", "-----", "-----"))
+
+	for i, location := range logistics {
+		var lines strings.Builder
+		outgoing := location.current + location.reinforcements - location.expected()
+		lines.WriteString(row(
+			truncateName(location.name, logisticsNameWidth),
+			fmt.Sprint(location.current),
+			signed(location.reinforcements),
+			signed(-outgoing),
+			fmt.Sprint(location.expected()),
+		))
+		for _, logistic := range location.leaving {
+			lines.WriteString(row("  >"+truncateName(logistic.LocationName, logisticsNameWidth-3), "", "", signed(-logistic.Count), ""))
+		}
+
+		if table.Len()+lines.Len()+len(truncated) > maxReplyLength {
+			table.WriteString(fmt.Sprintf(truncated, len(logistics)-i))
+			break
+		}
+		table.WriteString(lines.String())
+	}
+	return table.String()
+}
+
+// signed formats a count with its sign, leaving zeroes blank
+func signed(count int32) string {
+	if count == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%+d", count)
+}
+
+// truncateName shortens a name to fit a column
+func truncateName(name string, width int) string {
+	runes := []rune(name)
+	if len(runes) <= width {
+		return name
+	}
+	return string(runes[:width-2]) + ".."
+}
@@ -0,0 +1,199 @@
+package input
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/yisaj/heavens_throne/database"
+	"github.com/yisaj/heavens_throne/entities"
+	"github.com/yisaj/heavens_throne/simulation"
+	"github.com/yisaj/heavens_throne/twitspeak"
+)
+
+// logisticsResource fakes just enough of the database for the logistics
+// handler. anything else panics
+type logisticsResource struct {
+	database.Resource
+	movements []entities.Movement
+	locations []entities.Location
+	visible   []int32
+	presence  []entities.Presence
+	tallies   []entities.VoteTally
+}
+
+func (r *logisticsResource) GetPlayer(ctx context.Context, twitterID string) (*entities.Player, error) {
+	return &entities.Player{ID: 1, TwitterID: twitterID, MartialOrder: "Staghorn Sect"}, nil
+}
+
+func (r *logisticsResource) GetOrderMovements(ctx context.Context, order string) ([]entities.Movement, error) {
+	return r.movements, nil
+}
+
+func (r *logisticsResource) GetCommander(ctx context.Context, order string) (*entities.Commander, error) {
+	return nil, nil
+}
+
+func (r *logisticsResource) GetVoteTally(ctx context.Context, order string) ([]entities.VoteTally, error) {
+	return r.tallies, nil
+}
+
+func (r *logisticsResource) GetLocations(ctx context.Context) ([]entities.Location, error) {
+	return r.locations, nil
+}
+
+func (r *logisticsResource) GetLocation(ctx context.Context, locationID int32) (*entities.Location, error) {
+	for _, location := range r.locations {
+		if location.ID == locationID {
+			return &location, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (r *logisticsResource) GetVisibleLocations(ctx context.Context, order string) ([]int32, error) {
+	return r.visible, nil
+}
+
+func (r *logisticsResource) GetEnemyPresence(ctx context.Context, locationID int32, order string) ([]entities.Presence, error) {
+	return r.presence, nil
+}
+
+// recordingSpeaker keeps the DMs it's asked to send
+type recordingSpeaker struct {
+	twitspeak.TwitterSpeaker
+	sent []string
+}
+
+func (s *recordingSpeaker) SendDM(userID string, msg string) error {
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+func TestLogistics(t *testing.T) {
+	locations := []entities.Location{
+		{ID: 1, Name: "Asteria"},
+		{ID: 2, Name: "Yerk"},
+		{ID: 3, Name: "Bouchard's Island"},
+	}
+	move := func(source int32, destination int32, count int32) entities.Movement {
+		return entities.Movement{
+			Source:          source,
+			SourceName:      locations[source-1].Name,
+			Destination:     destination,
+			DestinationName: locations[destination-1].Name,
+			Count:           count,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		movements []entities.Movement
+		visible   []int32
+		presence  []entities.Presence
+		tallies   []entities.VoteTally
+		argument  string
+		expected  []string
+	}{
+		{
+			name:     "no units",
+			expected: []string{"Your order has no units on the field."},
+		},
+		{
+			name:    "votes for the next commander",
+			tallies: []entities.VoteTally{{Candidate: 12, Votes: 3}, {Candidate: 4, Votes: 1}},
+			expected: []string{
+				"Votes for the next commander:\n#12: 3\n#4: 1\n",
+				"Your order has no units on the field.",
+			},
+		},
+		{
+			name:      "staying put",
+			movements: []entities.Movement{move(1, 1, 10)},
+			expected: []string{
+				"Location           Now    In   Out  Next\n",
+				"Asteria             10                10\n",
+			},
+		},
+		{
+			name:      "leaving by destination",
+			movements: []entities.Movement{move(1, 1, 28), move(1, 2, 100), move(1, 3, 25), move(3, 1, 23)},
+			expected: []string{
+				"Asteria            153   +23  -125    51\n" +
+					"  >Bouchard's ..               -25\n" +
+					"  >Yerk                       -100\n",
+				"Bouchard's Isl..    23   +25   -23    25\n" +
+					"  >Asteria                     -23\n",
+				"Yerk                 0  +100         100\n",
+			},
+		},
+		{
+			name:      "one location",
+			movements: []entities.Movement{move(1, 2, 5), move(2, 2, 3)},
+			argument:  "yerk",
+			expected: []string{
+				"Here are the logistics for Yerk",
+				"Yerk                 3    +5           8\n",
+				"Enemy forces are hidden from your order.",
+			},
+		},
+		{
+			name:     "empty location in sight",
+			visible:  []int32{3},
+			presence: []entities.Presence{{MartialOrder: "Order Gorgona", Count: 4}},
+			argument: "bouchard",
+			expected: []string{
+				"Bouchard's Isl..     0                 0\n",
+				"Order Gorgona: 4",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		resource := &logisticsResource{
+			movements: test.movements,
+			locations: locations,
+			visible:   test.visible,
+			presence:  test.presence,
+			tallies:   test.tallies,
+		}
+		speaker := &recordingSpeaker{}
+		h := newInputHandler(resource, speaker, nil, nil, nil, simulation.TempleRules{}, nil, nil, nil)
+
+		err := h.Logistics(context.Background(), "player", test.argument)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if len(speaker.sent) != 1 {
+			t.Errorf("%s: expected one DM, got %d", test.name, len(speaker.sent))
+			continue
+		}
+		for _, expected := range test.expected {
+			if !strings.Contains(speaker.sent[0], expected) {
+				t.Errorf("%s: expected %q in\n%s", test.name, expected, speaker.sent[0])
+			}
+		}
+	}
+}
+
+func TestFormatLogisticsLimit(t *testing.T) {
+	var logistics []*locationLogistics
+	for i := int32(0); i < 200; i++ {
+		logistics = append(logistics, &locationLogistics{
+			locationID: i,
+			name:       "Somewhere Far Away",
+			current:    i,
+			leaving:    []entities.Logistic{{LocationName: "Elsewhere", Count: i}},
+		})
+	}
+
+	table := formatLogistics(logistics)
+	if len(table) > maxReplyLength {
+		t.Errorf("expected the table to fit in %d characters, got %d", maxReplyLength, len(table))
+	}
+	if !strings.Contains(table, "more. Try !logistics [location].") {
+		t.Error("expected the table to say it was cut off")
+	}
+}
@@ -0,0 +1,22 @@
+package input
+
+import (
+	"github.com/yisaj/heavens_throne/metrics"
+)
+
+var (
+	dmsTotal = metrics.NewCounter("dms_total",
+		"Player DMs parsed, by whether every command in them succeeded.", "result")
+	commandsTotal = metrics.NewCounter("commands_total",
+		"Commands run from player DMs, by command and whether they succeeded.", "command", "result")
+	commandDuration = metrics.NewHistogram("command_duration_seconds",
+		"How long each command took to run, by command.", metrics.DefaultBuckets, "command")
+)
+
+// resultLabel labels whether something failed
+func resultLabel(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "ok"
+}
@@ -0,0 +1,75 @@
+package input
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/yisaj/heavens_throne/entities"
+)
+
+// Moderator screens order chat before it's relayed. it returns the message to
+// relay, which it may have rewritten, or the reason the message was refused
+type Moderator interface {
+	Moderate(ctx context.Context, sender *entities.Player, msg string) (string, string, error)
+}
+
+// lengthLimit refuses messages too long to relay comfortably
+type lengthLimit int
+
+// Moderate refuses messages over the limit
+func (l lengthLimit) Moderate(ctx context.Context, sender *entities.Player, msg string) (string, string, error) {
+	if len([]rune(msg)) > int(l) {
+		return "", "That message is too long to pass along.", nil
+	}
+	return msg, "", nil
+}
+
+// controlFilter strips characters that could mangle the relayed DM
+type controlFilter struct{}
+
+// Moderate strips control characters, keeping line breaks. tabs and carriage
+// returns become spaces, so the words either side of them stay apart
+func (controlFilter) Moderate(ctx context.Context, sender *entities.Player, msg string) (string, string, error) {
+	msg = strings.Map(func(r rune) rune {
+		if r == '\t' || r == '\r' {
+			return ' '
+		}
+		if unicode.IsControl(r) && r != '\n' {
+			return -1
+		}
+		return r
+	}, msg)
+
+	msg = strings.TrimSpace(msg)
+	if msg == "" {
+		return "", "There's nothing to pass along.", nil
+	}
+	return msg, "", nil
+}
+
+// wordFilter censors banned words
+type wordFilter []*regexp.Regexp
+
+// newWordFilter builds a case insensitive filter for the banned words
+func newWordFilter(words []string) wordFilter {
+	var filter wordFilter
+	for _, word := range words {
+		word = strings.TrimSpace(word)
+		if word != "" {
+			filter = append(filter, regexp.MustCompile("(?i)"+regexp.QuoteMeta(word)))
+		}
+	}
+	return filter
+}
+
+// Moderate replaces any banned words with asterisks
+func (f wordFilter) Moderate(ctx context.Context, sender *entities.Player, msg string) (string, string, error) {
+	for _, banned := range f {
+		msg = banned.ReplaceAllStringFunc(msg, func(word string) string {
+			return strings.Repeat("*", len([]rune(word)))
+		})
+	}
+	return msg, "", nil
+}
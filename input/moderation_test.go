@@ -0,0 +1,33 @@
+package input
+
+import (
+	"context"
+	"testing"
+)
+
+func TestModerators(t *testing.T) {
+	tests := []struct {
+		moderator Moderator
+		msg       string
+		relayed   string
+		refused   bool
+	}{
+		{lengthLimit(5), "hello", "hello", false},
+		{lengthLimit(5), "hello!", "", true},
+		{controlFilter{}, " hold\tthe\nline\x00 ", "hold the\nline", false},
+		{controlFilter{}, "hold\rfast", "hold fast", false},
+		{controlFilter{}, "\x07", "", true},
+		{newWordFilter([]string{"heck", " ", ""}), "What the HECK, heckin' heck", "What the ****, ****in' ****", false},
+		{newWordFilter(nil), "anything goes", "anything goes", false},
+	}
+
+	for _, test := range tests {
+		relayed, reason, err := test.moderator.Moderate(context.Background(), nil, test.msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (reason != "") != test.refused || relayed != test.relayed {
+			t.Errorf("moderating %q: expected %q (refused %v), got %q (reason %q)", test.msg, test.relayed, test.refused, relayed, reason)
+		}
+	}
+}
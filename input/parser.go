@@ -2,12 +2,16 @@ package input
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
+	"github.com/yisaj/heavens_throne/config"
 	"github.com/yisaj/heavens_throne/database"
-	"github.com/yisaj/heavens_throne/simulation"
 	"github.com/yisaj/heavens_throne/twitspeak"
 
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
@@ -20,62 +24,201 @@ type DMParser interface {
 // player input parsers need to be able to get player info from the database and
 // call the appropriate handler
 type parser struct {
-	inputHandler Handler
-	logger       *logrus.Logger
+	commands   *registry
+	games      *games
+	speaker    twitspeak.TwitterSpeaker
+	moderators []Moderator
+	logger     *logrus.Logger
 }
 
-// NewDMParser constructs a new parser to parse player input
-func NewDMParser(resource database.Resource, speaker twitspeak.TwitterSpeaker, logger *logrus.Logger, simulator simulation.Simulator) DMParser {
+// players can only pack so many commands into a single DM
+const maxCommandsPerDM = 5
+
+// NewDMParser constructs a new parser to parse player input, handing each DM to
+// the game its sender is playing. the first game is the main one. order chat
+// goes through the default moderators, then any extra ones given
+func NewDMParser(conf *config.Config, speaker twitspeak.TwitterSpeaker, logger *logrus.Logger, games []Game,
+	moderators ...Moderator) DMParser {
+	moderators = append([]Moderator{
+		controlFilter{},
+		lengthLimit(maxOrderMessageLength),
+		newWordFilter(conf.ChatFilter),
+	}, moderators...)
+
 	return &parser{
-		newInputHandler(resource, speaker, simulator),
+		newCommandRegistry(conf.Admins),
+		newGames(games),
+		speaker,
+		moderators,
 		logger,
 	}
 }
 
-// ParseDM takes a player DM and executes the appropriate logic
+// ParseDM takes a player DM, executes each command in it in order, and sends
+// back all of their replies in one DM
 func (p *parser) ParseDM(ctx context.Context, recipientID string, msg string) error {
-	// look for command and tokenize the message
-	bangIndex := strings.IndexByte(msg, '!')
-	if bangIndex == -1 {
-		bangIndex = 0
+	const commandHeader = `
+%s:`
+	const commandFailed = `
+Something went wrong with that.
+`
+	const tooManyCommands = `
+Only the first %d commands were carried out.
+`
+
+	game, err := p.games.playing(ctx, recipientID)
+	if err != nil {
+		dmsTotal.Inc(resultLabel(err))
+		return errors.Wrap(err, "failed parsing DM")
+	}
+
+	// DMs from any game but the main one say which game they're from
+	speaker := p.speaker
+	if game.Name != database.MainGame {
+		speaker = twitspeak.NewLabeledSpeaker(speaker, game.Name)
+	}
+
+	// every reply is held back and sent together at the end
+	batch := newReplyBatch(speaker, recipientID)
+	inputHandler := newInputHandler(game.Resource, batch, game.Simulator, game.Forecaster, game.Dispatcher, game.Rules, p.commands, p.moderators, p.games)
+
+	starts := splitCommands(msg)
+	skipped := 0
+	if len(starts) > maxCommandsPerDM {
+		skipped = len(starts) - maxCommandsPerDM
+		starts = starts[:maxCommandsPerDM]
+	}
+
+	var result error
+	var reply strings.Builder
+	var options []twitspeak.QuickReplyOption
+	for i, start := range starts {
+		end := len(msg)
+		if i+1 < len(starts) {
+			end = starts[i+1]
+		}
+
+		// tokenize the command
+		tokenizedCommand := strings.SplitN(strings.TrimSpace(msg[start:end]), " ", 2)
+		name, argument := tokenizedCommand[0], ""
+		cmd := p.commands.find(name, recipientID)
+		if cmd != nil && cmd.rawArgument {
+			// free text swallows the rest of the DM, bangs and all
+			tokenizedCommand = strings.SplitN(strings.TrimSpace(msg[start:]), " ", 2)
+			starts = starts[:i+1]
+			skipped = 0
+		}
+		if len(tokenizedCommand) > 1 {
+			argument = tokenizedCommand[1]
+		}
+
+		p.logger.Infof("got command: `%s`, argument: `%s` from `%s`", name, argument, recipientID)
+
+		var err error
+		commandName := "invalid"
+		started := time.Now()
+		if cmd == nil {
+			err = inputHandler.InvalidCommand(ctx, recipientID)
+		} else {
+			commandName = cmd.name
+			err = p.run(ctx, game.Resource, inputHandler, batch, cmd, recipientID, argument)
+		}
+		commandDuration.Observe(time.Since(started).Seconds(), commandName)
+		commandsTotal.Inc(commandName, resultLabel(err))
+		if err != nil {
+			result = multierror.Append(result, errors.Wrapf(err, "failed running command `%s`", name))
+			batch.SendDM(recipientID, commandFailed)
+		}
+
+		// label each command's reply when there's more than one
+		commandReply := batch.take()
+		if len(starts) > 1 && commandReply != "" {
+			reply.WriteString(fmt.Sprintf(commandHeader, strings.TrimSpace(msg[start:end])))
+		}
+		reply.WriteString(commandReply)
+		// the last command to offer buttons asked the freshest question
+		if commandOptions := batch.takeOptions(); len(commandOptions) > 0 {
+			options = commandOptions
+		}
+
+		if cmd != nil && cmd.rawArgument {
+			break
+		}
+	}
+	if skipped > 0 {
+		reply.WriteString(fmt.Sprintf(tooManyCommands, maxCommandsPerDM))
+	}
+
+	if reply.Len() > 0 {
+		err := speaker.SendDMWithOptions(recipientID, reply.String(), options)
+		if err != nil {
+			result = multierror.Append(result, errors.Wrap(err, "failed sending command replies"))
+		}
 	}
-	bangString := msg[bangIndex:]
-	tokenizedCommand := strings.SplitN(bangString, " ", 2)
-	command, argument := tokenizedCommand[0], ""
-	if len(tokenizedCommand) > 1 {
-		argument = tokenizedCommand[1]
+	dmsTotal.Inc(resultLabel(result))
+	return result
+}
+
+// run checks that the player is allowed to use the command before running it
+func (p *parser) run(ctx context.Context, resource database.Resource, inputHandler Handler, speaker twitspeak.TwitterSpeaker, cmd *command, recipientID string, argument string) error {
+	const notPlaying = `
+You haven't joined the war yet. Type !join [order] to join.
+`
+	const deactivated = `
+The Gate is closed to you. At least for this cycle.
+`
+	const dead = `
+You are too dead to do that.
+`
+	const notCommander = `
+Only your order's commander can do that.
+`
+	const usage = `
+Usage: %s
+`
+
+	if cmd.requires != anyone {
+		player, err := resource.GetPlayer(ctx, recipientID)
+		if err != nil {
+			return errors.Wrap(err, "failed parsing DM")
+		}
+
+		var refusal string
+		if player == nil {
+			refusal = notPlaying
+		} else if !player.Active {
+			refusal = deactivated
+		} else if cmd.requires == alivePlayer && !player.IsAlive() {
+			refusal = dead
+		} else if cmd.requires == orderCommander {
+			commander, err := resource.GetCommander(ctx, player.MartialOrder)
+			if err != nil {
+				return errors.Wrap(err, "failed parsing DM")
+			}
+			if commander == nil || !commander.Player.Valid || commander.Player.Int32 != player.ID {
+				refusal = notCommander
+			}
+		}
+		if refusal != "" {
+			err = speaker.SendDM(recipientID, refusal)
+			if err != nil {
+				return errors.Wrap(err, "failed sending command refusal message")
+			}
+			return nil
+		}
 	}
 
-	p.logger.Infof("got command: `%s`, argument: `%s` from `%s`", command, argument, recipientID)
-
-	switch strings.ToLower(command) {
-	case "!help", "help":
-		return p.inputHandler.Help(ctx, recipientID)
-	case "!status", "status":
-		return p.inputHandler.Status(ctx, recipientID)
-	case "!logistics", "logistics":
-		return p.inputHandler.Logistics(ctx, recipientID, strings.ToLower(argument))
-	case "!join", "join":
-		return p.inputHandler.Join(ctx, recipientID, strings.ToLower(argument))
-	case "!move", "move":
-		return p.inputHandler.Move(ctx, recipientID, strings.ToLower(argument))
-	case "!advance", "advance":
-		return p.inputHandler.Advance(ctx, recipientID, strings.ToLower(argument))
-	case "!quit", "quit":
-		return p.inputHandler.Quit(ctx, recipientID)
-	case "!toggleupdates", "toggleupdates":
-		return p.inputHandler.ToggleUpdates(ctx, recipientID)
-	case "!echo", "echo":
-		return p.inputHandler.Echo(ctx, recipientID, argument)
-	case "!simulate", "simulate":
-		return p.inputHandler.Simulate(ctx, recipientID)
-	case "!tweet", "tweet":
-		return p.inputHandler.Tweet(ctx, recipientID, argument)
-	case "!reply", "reply":
-		return p.inputHandler.Reply(ctx, recipientID, argument)
-	case "!image", "image":
-		return p.inputHandler.ImageTweet(ctx, recipientID, argument)
-	default:
-		return p.inputHandler.InvalidCommand(ctx, recipientID)
+	argument = strings.TrimSpace(argument)
+	if cmd.argumentRequired && argument == "" {
+		err := speaker.SendDM(recipientID, fmt.Sprintf(usage, cmd.usage()))
+		if err != nil {
+			return errors.Wrap(err, "failed sending command usage message")
+		}
+		return nil
+	}
+	if !cmd.rawArgument {
+		argument = strings.ToLower(argument)
 	}
+
+	return cmd.run(inputHandler, ctx, recipientID, argument)
 }
@@ -0,0 +1,33 @@
+package input
+
+import (
+	"math"
+	"math/rand"
+)
+
+const (
+	// how far off a scout's estimate tends to be, relative to the real count
+	scoutNoise       = 0.4
+	rangerScoutNoise = 0.15
+)
+
+// scoutSeed fixes the noise for a player scouting a location on a given day, so
+// scouting again doesn't average the noise away
+func scoutSeed(playerID int32, locationID int32, day int32) int64 {
+	return int64(playerID)*1000003 + int64(locationID)*7919 + int64(day)
+}
+
+// estimateCount blurs a unit count. rangers make for sharper scouts
+func estimateCount(count int32, family string, seed int64) int32 {
+	noise := scoutNoise
+	if family == "ranger" {
+		noise = rangerScoutNoise
+	}
+
+	random := rand.New(rand.NewSource(seed))
+	estimate := float64(count)*(1+random.NormFloat64()*noise) + random.NormFloat64()*noise*2
+	if estimate < 0 {
+		return 0
+	}
+	return int32(math.Round(estimate))
+}
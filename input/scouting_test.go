@@ -0,0 +1,33 @@
+package input
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEstimateCount(t *testing.T) {
+	const count = 100
+	const trials = 1000
+
+	// rangers should be off by less than everyone else on average
+	errorFor := func(family string) float64 {
+		total := 0.
+		for seed := int64(0); seed < trials; seed++ {
+			estimate := estimateCount(count, family, seed)
+			if estimate < 0 {
+				t.Fatalf("got a negative estimate %d", estimate)
+			}
+			total += math.Abs(float64(estimate - count))
+		}
+		return total / trials
+	}
+
+	rangerError, infantryError := errorFor("ranger"), errorFor("infantry")
+	if rangerError >= infantryError {
+		t.Errorf("expected rangers to scout better: %f >= %f", rangerError, infantryError)
+	}
+
+	if estimateCount(count, "ranger", 42) != estimateCount(count, "ranger", 42) {
+		t.Error("expected the same seed to give the same estimate")
+	}
+}
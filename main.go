@@ -1,17 +1,98 @@
 package main
 
 import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
 	"github.com/yisaj/heavens_throne/config"
 	"github.com/yisaj/heavens_throne/database"
+	"github.com/yisaj/heavens_throne/events"
+	"github.com/yisaj/heavens_throne/input"
 	"github.com/yisaj/heavens_throne/simulation"
 	"github.com/yisaj/heavens_throne/twitlisten"
 	"github.com/yisaj/heavens_throne/twitspeak"
 
+	"github.com/pkg/errors"
 	"github.com/robfig/cron/v3"
 	"github.com/sirupsen/logrus"
 )
 
-// TODO ENGINEER: remember to take down game simulator on panic
+// how long shutting down waits on a running simulation before giving up on
+// it. a day cut off partway has to be fixed by hand, so this is generous
+const simulationShutdownTimeout = 10 * time.Minute
+
+// game is everything a single game runs on
+type game struct {
+	name       string
+	resource   database.Resource
+	simLock    *simulation.SimLock
+	dispatcher *events.Dispatcher
+	rules      simulation.TempleRules
+	forecaster simulation.Forecaster
+	scheduler  *simulation.Scheduler
+}
+
+// newGame sets up a game's simulator on its schedule. what happens in the game
+// is recorded and told by whoever subscribes to its dispatcher
+func newGame(conf *config.Config, logger *logrus.Logger, speaker twitspeak.TwitterSpeaker, name string,
+	resource database.Resource, scheduleSpec string) (*game, error) {
+	simLock := &simulation.SimLock{}
+	// the main game goes unnamed, while any other names itself in its tweets
+	// and DMs
+	var title string
+	if name != database.MainGame {
+		title = name
+		speaker = twitspeak.NewLabeledSpeaker(speaker, name)
+	}
+	storyteller := simulation.NewStoryTeller(speaker, resource, title)
+	dispatcher := events.NewDispatcher(logger)
+	dispatcher.Subscribe(simulation.NewRecorder(resource))
+	dispatcher.SubscribeBestEffort(storyteller)
+
+	rules := simulation.TempleRules{
+		FallbackRespawnDays: conf.TempleFallbackDays,
+		EliminateOrders:     conf.EliminateOrders,
+		CommanderTerm:       conf.CommanderTermDays,
+	}
+	var simulator simulation.Simulator
+	var forecaster simulation.Forecaster
+	switch conf.Simulator {
+	case "normal":
+		normalSimulator := simulation.NewNormalSimulator(logger, resource, simLock, rules, dispatcher)
+		simulator = &normalSimulator
+		forecaster = &normalSimulator
+	default:
+		phases, err := simulation.LookupBattlePhases(conf.BattlePhases)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed configuring battle phases")
+		}
+		phasedSimulator := simulation.NewPhasedSimulator(logger, resource, simLock, rules, dispatcher, phases)
+		simulator = &phasedSimulator
+		forecaster = &phasedSimulator
+	}
+
+	// the simulator runs on a schedule kept in the database, catching up on or
+	// skipping whatever it missed while the game was down
+	schedule, err := simulation.ParseSchedule(scheduleSpec, conf.TimeZone)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed configuring simulation schedule")
+	}
+	scheduler := simulation.NewScheduler(logger, name, resource, simulator, storyteller, schedule, conf.CatchUpMissedDays)
+
+	return &game{
+		name,
+		resource,
+		simLock,
+		dispatcher,
+		rules,
+		forecaster,
+		scheduler,
+	}, nil
+}
+
 func main() {
 	logger := logrus.New()
 	logger.SetFormatter(&logrus.JSONFormatter{PrettyPrint: true})
@@ -31,23 +112,89 @@ func main() {
 	// spin up twitter client
 	speaker := twitspeak.NewSpeaker(conf, logger)
 
-	// spin up game simulation cron task (one execution per day)
-	simLock := simulation.SimLock{}
-	storyteller := simulation.NewStoryTeller(speaker, resource)
-	simulator := simulation.NewNormalSimulator(logger, resource, &simLock)
-	c := cron.New()
-	c.AddFunc("0 0 * * *", func() {
-		logger.Info("running game simulator")
-		simulator.Simulate()
-		storyteller.Tell()
-	})
-	c.Start()
-	defer c.Stop()
-
-	// spin up twitter webhooks server
-	twitlisten.Listen(conf, speaker, resource, logger, &simLock, &simulator)
-
-	// stop game simulation task on exit
-
-	logger.Panic("END")
+	// spin up the main game, and any others run alongside it in schemas of
+	// their own
+	mainGame, err := newGame(conf, logger, speaker, database.MainGame, resource, conf.Schedule)
+	if err != nil {
+		logger.WithError(err).Panic("failed setting up main game")
+	}
+	games := []*game{mainGame}
+	for _, gameConf := range conf.Games {
+		gameResource, err := database.ConnectGame(conf, logger, gameConf.Name)
+		if err != nil {
+			logger.WithError(err).WithField("game", gameConf.Name).Panic("failed game database connection")
+		}
+		extraGame, err := newGame(conf, logger, speaker, gameConf.Name, gameResource, gameConf.Schedule)
+		if err != nil {
+			logger.WithError(err).WithField("game", gameConf.Name).Panic("failed setting up game")
+		}
+		games = append(games, extraGame)
+	}
+
+	// only the main game is streamed to the public
+	bus := events.NewBus()
+	mainGame.dispatcher.SubscribeBestEffort(events.Feed(bus))
+
+	inputGames := make([]input.Game, 0, len(games))
+	schedules := make(map[string]cron.Schedule, len(games))
+	for _, g := range games {
+		g.scheduler.Start()
+		schedules[g.name] = g.scheduler.Schedule()
+		inputGames = append(inputGames, input.Game{
+			Name:     g.name,
+			Resource: g.resource,
+			// days run by hand go through the scheduler, so shutting down
+			// waits on them too
+			Simulator:  g.scheduler,
+			Forecaster: g.forecaster,
+			Dispatcher: g.dispatcher,
+			Rules:      g.rules,
+		})
+	}
+
+	// shut down when told to
+	ctx, cancel := context.WithCancel(context.Background())
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+	go func() {
+		received := <-signals
+		logger.WithField("signal", received.String()).Info("shutting down")
+		cancel()
+	}()
+
+	// spin up twitter webhooks server, which runs until shutdown
+	err = twitlisten.Listen(ctx, conf, speaker, resource, logger, mainGame.simLock, mainGame.dispatcher, bus,
+		schedules, inputGames)
+	if err != nil {
+		logger.WithError(err).Error("failed listening to twitter")
+	}
+	cancel()
+
+	// no more runs are started, but ones already running are waited on, so no
+	// day is left half simulated
+	logger.Info("stopping game simulators")
+	stopped := make([]context.Context, len(games))
+	for i, g := range games {
+		stopped[i] = g.scheduler.Stop()
+	}
+	waitCtx, cancelWait := context.WithTimeout(context.Background(), simulationShutdownTimeout)
+	defer cancelWait()
+	for i, g := range games {
+		select {
+		case <-stopped[i].Done():
+		case <-waitCtx.Done():
+			logger.WithField("game", g.name).Error("gave up waiting on the game simulator")
+		}
+	}
+
+	for _, g := range games {
+		closeErr := g.resource.Close()
+		if closeErr != nil {
+			logger.WithError(closeErr).WithField("game", g.name).Error("failed closing database")
+		}
+	}
+	if err != nil {
+		os.Exit(1)
+	}
+	logger.Info("shut down")
 }
@@ -0,0 +1,94 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// every metric name starts with the game's name
+const namespace = "heavens_throne"
+
+// DefaultBuckets are histogram buckets in seconds for anything from a database
+// query to a twitter request
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// registry holds every metric the game makes. it's kept apart from the default
+// registry so only the game's own metrics are served
+var registry = prometheus.NewRegistry()
+
+// Counter is a value per set of labels that only goes up
+type Counter struct {
+	vec *prometheus.CounterVec
+}
+
+// NewCounter makes and registers a counter
+func NewCounter(name string, help string, labels ...string) *Counter {
+	vec := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      name,
+		Help:      help,
+	}, labels)
+	registry.MustRegister(vec)
+	return &Counter{vec}
+}
+
+// Add adds to the counter for the label values
+func (c *Counter) Add(value float64, labelValues ...string) {
+	c.vec.WithLabelValues(labelValues...).Add(value)
+}
+
+// Inc adds one to the counter for the label values
+func (c *Counter) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Gauge is a value per set of labels that can go up and down
+type Gauge struct {
+	vec *prometheus.GaugeVec
+}
+
+// NewGauge makes and registers a gauge
+func NewGauge(name string, help string, labels ...string) *Gauge {
+	vec := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      name,
+		Help:      help,
+	}, labels)
+	registry.MustRegister(vec)
+	return &Gauge{vec}
+}
+
+// Set sets the gauge for the label values
+func (g *Gauge) Set(value float64, labelValues ...string) {
+	g.vec.WithLabelValues(labelValues...).Set(value)
+}
+
+// Histogram counts observations per set of labels into buckets
+type Histogram struct {
+	vec *prometheus.HistogramVec
+}
+
+// NewHistogram makes and registers a histogram with the given upper bounds,
+// which must be sorted
+func NewHistogram(name string, help string, buckets []float64, labels ...string) *Histogram {
+	vec := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      name,
+		Help:      help,
+		Buckets:   buckets,
+	}, labels)
+	registry.MustRegister(vec)
+	return &Histogram{vec}
+}
+
+// Observe counts an observation for the label values
+func (h *Histogram) Observe(value float64, labelValues ...string) {
+	h.vec.WithLabelValues(labelValues...).Observe(value)
+}
+
+// Handler serves every registered metric in the prometheus text format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	counter := NewCounter("test_requests_total", "Requests.", "endpoint", "status")
+	counter.Inc("/b", "200")
+	counter.Inc("/a", "500")
+	counter.Add(2, "/b", "200")
+
+	gauge := NewGauge("test_remaining", "Remaining.", "endpoint")
+	gauge.Set(15, `say "hi"`)
+
+	histogram := NewHistogram("test_duration_seconds", "Durations.", []float64{0.1, 1})
+	histogram.Observe(0.05)
+	histogram.Observe(0.5)
+	histogram.Observe(3)
+
+	recorder := httptest.NewRecorder()
+	Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+
+	// families come out sorted by name
+	for _, expected := range []string{`# HELP heavens_throne_test_requests_total Requests.
+# TYPE heavens_throne_test_requests_total counter
+heavens_throne_test_requests_total{endpoint="/a",status="500"} 1
+heavens_throne_test_requests_total{endpoint="/b",status="200"} 3
+`, `# HELP heavens_throne_test_remaining Remaining.
+# TYPE heavens_throne_test_remaining gauge
+heavens_throne_test_remaining{endpoint="say \"hi\""} 15
+`, `# HELP heavens_throne_test_duration_seconds Durations.
+# TYPE heavens_throne_test_duration_seconds histogram
+heavens_throne_test_duration_seconds_bucket{le="0.1"} 1
+heavens_throne_test_duration_seconds_bucket{le="1"} 2
+heavens_throne_test_duration_seconds_bucket{le="+Inf"} 3
+heavens_throne_test_duration_seconds_sum 3.55
+heavens_throne_test_duration_seconds_count 3
+`} {
+		if !strings.Contains(recorder.Body.String(), expected) {
+			t.Errorf("expected\n%s\ngot\n%s", expected, recorder.Body.String())
+		}
+	}
+}
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS order_mute;
+DROP TABLE IF EXISTS order_message;
+ALTER TABLE player DROP COLUMN IF EXISTS receive_order_chat;
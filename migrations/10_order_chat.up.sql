@@ -0,0 +1,20 @@
+ALTER TABLE player ADD COLUMN receive_order_chat boolean NOT NULL DEFAULT FALSE;
+
+CREATE TABLE order_message (
+    id serial PRIMARY KEY,
+    day smallint NOT NULL,
+    martial_order martialorder NOT NULL,
+    sender integer REFERENCES player (id) ON DELETE CASCADE,
+    sender_class playerclass NOT NULL,
+    body text NOT NULL,
+    timestamp timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX order_message_martial_order_idx ON order_message (martial_order, id);
+CREATE INDEX order_message_sender_idx ON order_message (sender, timestamp);
+
+CREATE TABLE order_mute (
+    player integer REFERENCES player (id) ON DELETE CASCADE,
+    muted integer REFERENCES player (id) ON DELETE CASCADE,
+    PRIMARY KEY (player, muted)
+);
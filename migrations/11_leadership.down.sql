@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS commander;
+DROP TABLE IF EXISTS commander_vote;
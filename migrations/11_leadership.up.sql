@@ -0,0 +1,19 @@
+CREATE TABLE commander_vote (
+    voter integer PRIMARY KEY REFERENCES player (id) ON DELETE CASCADE,
+    candidate integer NOT NULL REFERENCES player (id) ON DELETE CASCADE,
+    martial_order martialorder NOT NULL,
+    day smallint NOT NULL
+);
+
+CREATE TABLE commander (
+    id serial PRIMARY KEY,
+    martial_order martialorder NOT NULL,
+    player integer REFERENCES player (id) ON DELETE SET NULL,
+    votes integer NOT NULL,
+    start_day smallint NOT NULL,
+    end_day smallint NOT NULL,
+    rally_point integer REFERENCES location (id),
+    objective text
+);
+
+CREATE INDEX commander_martial_order_idx ON commander (martial_order, id);
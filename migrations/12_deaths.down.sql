@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS death_record;
+DROP TYPE IF EXISTS deathcause;
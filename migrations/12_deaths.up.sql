@@ -0,0 +1,14 @@
+CREATE TYPE deathcause AS ENUM (
+    'slain', 'cutdown'
+);
+
+CREATE TABLE death_record (
+    id serial PRIMARY KEY,
+    day smallint NOT NULL,
+    location integer REFERENCES location (id),
+    player integer NOT NULL REFERENCES player (id) ON DELETE CASCADE,
+    cause deathcause NOT NULL,
+    revived_day smallint
+);
+
+CREATE INDEX death_record_player_idx ON death_record (player, id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS elimination;
+DROP TABLE IF EXISTS temple_record;
+DROP TYPE IF EXISTS templeevent;
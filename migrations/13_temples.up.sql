@@ -0,0 +1,16 @@
+CREATE TYPE templeevent AS ENUM (
+    'captured', 'reclaimed'
+);
+
+CREATE TABLE temple_record (
+    day smallint NOT NULL,
+    location integer REFERENCES location (id),
+    martial_order martialorder NOT NULL,
+    event templeevent NOT NULL,
+    captor martialorder
+);
+
+CREATE TABLE elimination (
+    martial_order martialorder PRIMARY KEY,
+    day smallint NOT NULL
+);
@@ -0,0 +1 @@
+ALTER TABLE combat_record DROP COLUMN IF EXISTS id;
@@ -0,0 +1 @@
+ALTER TABLE combat_record ADD COLUMN id serial PRIMARY KEY;
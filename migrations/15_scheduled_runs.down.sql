@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS scheduled_run;
+DROP TYPE IF EXISTS runstatus;
@@ -0,0 +1,11 @@
+CREATE TYPE runstatus AS ENUM (
+    'running', 'finished', 'failed', 'skipped'
+);
+
+CREATE TABLE scheduled_run (
+    scheduled_for timestamptz PRIMARY KEY,
+    status runstatus NOT NULL,
+    started_at timestamptz NOT NULL DEFAULT now(),
+    finished_at timestamptz,
+    error text
+);
@@ -0,0 +1,7 @@
+DO $$
+BEGIN
+    IF current_schema() NOT LIKE 'game\_%' THEN
+        DROP TABLE IF EXISTS game_membership;
+    END IF;
+END
+$$;
@@ -0,0 +1,12 @@
+-- every game's schema runs these migrations, but memberships are only kept
+-- alongside the main game
+DO $$
+BEGIN
+    IF current_schema() NOT LIKE 'game\_%' THEN
+        CREATE TABLE game_membership (
+            twitter_id text PRIMARY KEY,
+            game text NOT NULL
+        );
+    END IF;
+END
+$$;
@@ -0,0 +1,4 @@
+CREATE TABLE day_story (
+    day smallint PRIMARY KEY,
+    story jsonb NOT NULL
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS experience_record;
+DROP TYPE IF EXISTS experiencesource;
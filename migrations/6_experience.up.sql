@@ -0,0 +1,11 @@
+CREATE TYPE experiencesource AS ENUM (
+    'existence', 'battle', 'victory', 'survival', 'kill', 'death', 'capture'
+);
+
+CREATE TABLE experience_record (
+    day smallint NOT NULL,
+    player integer REFERENCES player (id) ON DELETE CASCADE,
+    source experiencesource NOT NULL,
+    amount smallint NOT NULL,
+    target_class playerclass
+);
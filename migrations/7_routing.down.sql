@@ -0,0 +1,18 @@
+ALTER TABLE move_record DROP COLUMN routed;
+
+DELETE FROM combat_record WHERE type = 'rout' OR result = 'routed' OR defender_class IS NULL;
+ALTER TABLE combat_record ALTER COLUMN defender_class SET NOT NULL;
+
+ALTER TYPE combatresult RENAME TO combatresult_new;
+CREATE TYPE combatresult AS ENUM (
+    'success', 'failure', 'notarget'
+);
+ALTER TABLE combat_record ALTER COLUMN result TYPE combatresult USING result::text::combatresult;
+DROP TYPE combatresult_new;
+
+ALTER TYPE combattype RENAME TO combattype_new;
+CREATE TYPE combattype AS ENUM (
+    'attack', 'counterattack', 'revive'
+);
+ALTER TABLE combat_record ALTER COLUMN type TYPE combattype USING type::text::combattype;
+DROP TYPE combattype_new;
@@ -0,0 +1,17 @@
+ALTER TYPE combattype RENAME TO combattype_old;
+CREATE TYPE combattype AS ENUM (
+    'attack', 'counterattack', 'revive', 'rout'
+);
+ALTER TABLE combat_record ALTER COLUMN type TYPE combattype USING type::text::combattype;
+DROP TYPE combattype_old;
+
+ALTER TYPE combatresult RENAME TO combatresult_old;
+CREATE TYPE combatresult AS ENUM (
+    'success', 'failure', 'notarget', 'routed'
+);
+ALTER TABLE combat_record ALTER COLUMN result TYPE combatresult USING result::text::combatresult;
+DROP TYPE combatresult_old;
+
+ALTER TABLE combat_record ALTER COLUMN defender_class DROP NOT NULL;
+
+ALTER TABLE move_record ADD COLUMN routed boolean NOT NULL DEFAULT FALSE;
@@ -0,0 +1,3 @@
+ALTER TABLE combat_record DROP COLUMN IF EXISTS attacker_stance, DROP COLUMN IF EXISTS defender_stance;
+ALTER TABLE player DROP COLUMN IF EXISTS stance, DROP COLUMN IF EXISTS target_priority;
+DROP TYPE IF EXISTS battlestance, classfamily;
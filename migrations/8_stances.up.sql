@@ -0,0 +1,8 @@
+CREATE TYPE battlestance AS ENUM ('aggressive', 'defensive', 'hold', 'support');
+CREATE TYPE classfamily AS ENUM ('recruit', 'infantry', 'cavalry', 'ranger');
+
+ALTER TABLE player ADD COLUMN stance battlestance NOT NULL DEFAULT 'aggressive';
+ALTER TABLE player ADD COLUMN target_priority classfamily;
+
+ALTER TABLE combat_record ADD COLUMN attacker_stance battlestance;
+ALTER TABLE combat_record ADD COLUMN defender_stance battlestance;
@@ -0,0 +1,4 @@
+CREATE TABLE march (
+    player integer PRIMARY KEY REFERENCES player(id) ON DELETE CASCADE,
+    route integer[] NOT NULL
+);
@@ -0,0 +1,198 @@
+package simulation
+
+import (
+	"context"
+	"sort"
+
+	"github.com/yisaj/heavens_throne/entities"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultForecastRuns is how many times each battle is fought when a
+	// forecast doesn't say
+	DefaultForecastRuns = 100
+	// MaxForecastRuns is the most times a forecast fights each battle
+	MaxForecastRuns = 1000
+)
+
+// ErrSimulating is returned by a forecast asked for while the day is being
+// simulated, since the database is only half way through it
+var ErrSimulating = errors.New("simulating")
+
+// Forecaster projects the next day without changing anything
+type Forecaster interface {
+	Forecast(ctx context.Context, options ForecastOptions) (*Forecast, error)
+}
+
+// ForecastOptions limits what a forecast takes into account
+type ForecastOptions struct {
+	// how many times each battle is fought. more runs give steadier odds
+	Runs int
+	// when set, only this order's movements are applied, and every other order
+	// is assumed to hold where it stands
+	Order string
+	// when set, only this location is forecast
+	Location int32
+}
+
+// Forecast is the projected outcome of the next day
+type Forecast struct {
+	Day     int32
+	Runs    int
+	Battles []BattleForecast
+	// locations that could change hands, most likely first
+	Captures []CaptureForecast
+}
+
+// BattleForecast is the projected outcome of a single battle, over every run
+type BattleForecast struct {
+	Location entities.Location
+	// how many units each order brings
+	Armies map[string]int
+	// the chance each order holds the field
+	Victories map[string]float64
+	// how many units each order can expect to lose to death or rout
+	Losses map[string]float64
+}
+
+// CaptureForecast is the chance a location is captured by an order
+type CaptureForecast struct {
+	Location     entities.Location
+	MartialOrder string
+	Chance       float64
+}
+
+// Forecast projects the next day, fighting every battle as many times as asked
+func (ns *NormalSimulator) Forecast(ctx context.Context, options ForecastOptions) (*Forecast, error) {
+	return ns.forecast(ctx, options, ns.SimulateBattle)
+}
+
+// Forecast projects the next day, fighting every battle in phases as many times
+// as asked
+func (ps *PhasedSimulator) Forecast(ctx context.Context, options ForecastOptions) (*Forecast, error) {
+	return ps.forecast(ctx, options, ps.SimulateBattle)
+}
+
+// forecast moves everyone as the day would and fights the battles that follow
+// against a snapshot of the database, which is left as it was
+func (ns *NormalSimulator) forecast(ctx context.Context, options ForecastOptions, simulateBattle battleSimulation) (*Forecast, error) {
+	runs := options.Runs
+	if runs <= 0 {
+		runs = DefaultForecastRuns
+	} else if runs > MaxForecastRuns {
+		runs = MaxForecastRuns
+	}
+
+	if ns.lock.Check() {
+		return nil, ErrSimulating
+	}
+	defer ns.lock.RUnlock()
+
+	day, err := ns.resource.GetDay(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed forecast")
+	}
+	players, err := ns.resource.GetAlivePlayers(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed forecast")
+	}
+	locations, err := ns.resource.GetLocations(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed forecast")
+	}
+
+	// move the players in memory, grouped by where they end up
+	playersByLocationAndOrder := make(map[int32]map[string][]entities.Player)
+	for _, player := range players {
+		location := player.Location.Int32
+		if player.NextLocation.Valid && (options.Order == "" || player.MartialOrder == options.Order) {
+			location = player.NextLocation.Int32
+		}
+		if playersByLocationAndOrder[location] == nil {
+			playersByLocationAndOrder[location] = make(map[string][]entities.Player)
+		}
+		playersByLocationAndOrder[location][player.MartialOrder] = append(playersByLocationAndOrder[location][player.MartialOrder], player)
+	}
+
+	forecast := &Forecast{Day: day + 1, Runs: runs}
+	for _, location := range locations {
+		if options.Location != 0 && location.ID != options.Location {
+			continue
+		}
+		locationPlayers := playersByLocationAndOrder[location.ID]
+
+		// an order can only capture a location it already occupies
+		var occupier string
+		if location.Occupier.Valid && (!location.Owner.Valid || location.Owner.String != location.Occupier.String) {
+			occupier = location.Occupier.String
+		}
+
+		switch len(locationPlayers) {
+		case 0:
+			continue
+		case 1:
+			if len(locationPlayers[occupier]) > 0 {
+				forecast.Captures = append(forecast.Captures, CaptureForecast{location, occupier, 1})
+			}
+			continue
+		}
+
+		battle, err := forecastBattle(location, locationPlayers, runs, simulateBattle)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed forecast")
+		}
+		forecast.Battles = append(forecast.Battles, *battle)
+		if chance := battle.Victories[occupier]; occupier != "" && chance > 0 {
+			forecast.Captures = append(forecast.Captures, CaptureForecast{location, occupier, chance})
+		}
+	}
+
+	sort.SliceStable(forecast.Captures, func(i, j int) bool {
+		return forecast.Captures[i].Chance > forecast.Captures[j].Chance
+	})
+	return forecast, nil
+}
+
+// forecastBattle fights a battle the given number of times, each on a fresh
+// copy of the armies
+func forecastBattle(location entities.Location, players map[string][]entities.Player, runs int,
+	simulateBattle battleSimulation) (*BattleForecast, error) {
+	battle := &BattleForecast{
+		location,
+		make(map[string]int),
+		make(map[string]float64),
+		make(map[string]float64),
+	}
+	for order, army := range players {
+		battle.Armies[order] = len(army)
+	}
+
+	for run := 0; run < runs; run++ {
+		armies := make(map[string][]entities.Player, len(players))
+		var occupier string
+		for order, army := range players {
+			armies[order] = append([]entities.Player(nil), army...)
+			occupier = order
+		}
+
+		result, err := simulateBattle(location.ID, armies)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed forecasting battle")
+		}
+
+		battle.Victories[battleVictor(result, occupier)]++
+		for order := range players {
+			battle.Losses[order] += float64(len(result.Fatalities[order]) + len(result.Routs[order]))
+		}
+	}
+
+	for order := range battle.Victories {
+		battle.Victories[order] /= float64(runs)
+	}
+	for order := range battle.Losses {
+		battle.Losses[order] /= float64(runs)
+	}
+	return battle, nil
+}
@@ -0,0 +1,117 @@
+package simulation
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"testing"
+
+	"github.com/yisaj/heavens_throne/database"
+	"github.com/yisaj/heavens_throne/entities"
+)
+
+// snapshotResource only answers the reads a forecast makes. anything written
+// panics on the nil resource underneath
+type snapshotResource struct {
+	database.Resource
+	players   []entities.Player
+	locations []entities.Location
+}
+
+func (r *snapshotResource) GetDay(ctx context.Context) (int32, error) {
+	return 4, nil
+}
+
+func (r *snapshotResource) GetAlivePlayers(ctx context.Context) ([]entities.Player, error) {
+	return r.players, nil
+}
+
+func (r *snapshotResource) GetLocations(ctx context.Context) ([]entities.Location, error) {
+	return r.locations, nil
+}
+
+func newSnapshotResource() *snapshotResource {
+	held := func(order string) sql.NullString {
+		return sql.NullString{String: order, Valid: true}
+	}
+	at := func(location int32) sql.NullInt32 {
+		return sql.NullInt32{Int32: location, Valid: true}
+	}
+
+	resource := &snapshotResource{locations: []entities.Location{
+		// gorgona is about to take the first
+		{ID: 1, Name: "Vessel", Owner: held("Staghorn Sect"), Occupier: held("Order Gorgona")},
+		{ID: 2, Name: "Aral", Owner: held("Staghorn Sect"), Occupier: held("Order Gorgona")},
+		{ID: 3, Name: "Reach", Owner: held("Order Gorgona"), Occupier: held("Order Gorgona")},
+	}}
+	resource.players = append(resource.players, entities.Player{ID: 0, MartialOrder: "Order Gorgona", Class: "recruit",
+		Rank: 1, Location: at(1), NextLocation: at(1)})
+	// staghorn's lone recruit holds the second against gorgona's knights
+	resource.players = append(resource.players, entities.Player{ID: 1, MartialOrder: "Staghorn Sect", Class: "recruit",
+		Rank: 1, Location: at(2), NextLocation: at(2)})
+	for i := int32(2); i < 8; i++ {
+		resource.players = append(resource.players, entities.Player{ID: i, MartialOrder: "Order Gorgona", Class: "monsterknight",
+			Rank: 1, Location: at(3), NextLocation: at(2)})
+	}
+	return resource
+}
+
+func TestForecast(t *testing.T) {
+	simulator := NewNormalSimulator(newTestLogger(), newSnapshotResource(), &SimLock{}, TempleRules{}, nil)
+	forecast, err := simulator.Forecast(context.Background(), ForecastOptions{Runs: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if forecast.Day != 5 || forecast.Runs != 20 {
+		t.Errorf("expected 20 runs of day 5, got %d of day %d", forecast.Runs, forecast.Day)
+	}
+
+	if len(forecast.Battles) != 1 || forecast.Battles[0].Location.ID != 2 {
+		t.Fatalf("expected a battle at the second location, got %v", forecast.Battles)
+	}
+	battle := forecast.Battles[0]
+	if battle.Armies["Order Gorgona"] != 6 || battle.Armies["Staghorn Sect"] != 1 {
+		t.Errorf("expected both armies at the battle, got %v", battle.Armies)
+	}
+	var total float64
+	for _, chance := range battle.Victories {
+		total += chance
+	}
+	if math.Abs(total-1) > 1e-9 {
+		t.Errorf("expected the victory chances to add up, got %v", battle.Victories)
+	}
+
+	if len(forecast.Captures) == 0 || forecast.Captures[0].Location.ID != 1 || forecast.Captures[0].Chance != 1 {
+		t.Fatalf("expected the first location to surely be captured, got %v", forecast.Captures)
+	}
+	for _, capture := range forecast.Captures[1:] {
+		if capture.Location.ID != 2 || capture.MartialOrder != "Order Gorgona" || capture.Chance != battle.Victories["Order Gorgona"] {
+			t.Errorf("expected gorgona's chance of taking the second location, got %v", capture)
+		}
+	}
+}
+
+func TestForecastOwnOrder(t *testing.T) {
+	simulator := NewNormalSimulator(newTestLogger(), newSnapshotResource(), &SimLock{}, TempleRules{}, nil)
+
+	// staghorn can't see gorgona's knights are coming
+	forecast, err := simulator.Forecast(context.Background(), ForecastOptions{Order: "Staghorn Sect", Location: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if forecast.Runs != DefaultForecastRuns || len(forecast.Battles) != 0 || len(forecast.Captures) != 0 {
+		t.Errorf("expected a quiet day at the second location, got %v", forecast)
+	}
+}
+
+func TestForecastWhileSimulating(t *testing.T) {
+	lock := &SimLock{}
+	simulator := NewNormalSimulator(newTestLogger(), newSnapshotResource(), lock, TempleRules{}, nil)
+	lock.WLock()
+	defer lock.WUnlock()
+
+	_, err := simulator.Forecast(context.Background(), ForecastOptions{})
+	if err != ErrSimulating {
+		t.Errorf("expected no forecast while simulating, got %v", err)
+	}
+}
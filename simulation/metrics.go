@@ -0,0 +1,23 @@
+package simulation
+
+import (
+	"github.com/yisaj/heavens_throne/metrics"
+)
+
+// simulations can run for minutes once the war gets big
+var simulationBuckets = []float64{0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600}
+
+var (
+	simulationDuration = metrics.NewHistogram("simulation_duration_seconds",
+		"How long each simulated day took, by whether it succeeded.", simulationBuckets, "result")
+	battlesTotal = metrics.NewCounter("battles_total",
+		"Battles fought over every simulated day.")
+	simLockWait = metrics.NewHistogram("simlock_wait_seconds",
+		"How long the simulator waited on player input to take the sim lock.", metrics.DefaultBuckets)
+	simLockHold = metrics.NewHistogram("simlock_hold_seconds",
+		"How long the simulator held the sim lock, shutting out player input.", simulationBuckets)
+	scheduledRuns = metrics.NewCounter("scheduled_runs_total",
+		"Scheduled simulations, by game and whether they finished, failed or were skipped.", "game", "status")
+	scheduleStopped = metrics.NewGauge("schedule_stopped",
+		"Whether a game's schedule is stopped at a day that failed or never finished.", "game")
+)
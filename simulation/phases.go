@@ -0,0 +1,285 @@
+package simulation
+
+import (
+	"math/rand"
+	"strings"
+
+	"github.com/yisaj/heavens_throne/database"
+	"github.com/yisaj/heavens_throne/entities"
+	"github.com/yisaj/heavens_throne/events"
+
+	"github.com/bsm/bst"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// BattleLine denotes the position in formation a unit fights from
+type BattleLine int
+
+// All the battle lines
+const (
+	FrontLine BattleLine = iota
+	BackLine
+	Flank
+)
+
+// familyLines places each class family in its line of the formation
+var familyLines = map[string]BattleLine{
+	"recruit":  FrontLine,
+	"infantry": FrontLine,
+	"ranger":   BackLine,
+	"cavalry":  Flank,
+}
+
+// BattlePhase configures a single stage of a battle
+type BattlePhase struct {
+	Name string
+	// Families are the class families that act during the phase
+	Families []string
+	// Targets are the enemy lines that can always be attacked during the phase
+	Targets []BattleLine
+	// Breakthrough are the enemy lines that can only be attacked once that
+	// enemy's front line has broken
+	Breakthrough []BattleLine
+}
+
+// DefaultBattlePhases are the stages of combat from the design notes. cavalry
+// charge, archers loose a volley, then the infantry meet in melee
+var DefaultBattlePhases = []BattlePhase{
+	{
+		Name:         "charge",
+		Families:     []string{"cavalry"},
+		Targets:      []BattleLine{Flank, FrontLine},
+		Breakthrough: []BattleLine{BackLine},
+	},
+	{
+		Name:     "volley",
+		Families: []string{"ranger"},
+		Targets:  []BattleLine{Flank, FrontLine, BackLine},
+	},
+	{
+		Name:         "melee",
+		Families:     []string{"recruit", "infantry"},
+		Targets:      []BattleLine{Flank, FrontLine},
+		Breakthrough: []BattleLine{BackLine},
+	},
+}
+
+// LookupBattlePhases returns the default battle phases with the given names, in
+// the given order
+func LookupBattlePhases(names []string) ([]BattlePhase, error) {
+	phases := make([]BattlePhase, 0, len(names))
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		found := false
+		for _, phase := range DefaultBattlePhases {
+			if phase.Name == name {
+				phases = append(phases, phase)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.Errorf("unknown battle phase: %s", name)
+		}
+	}
+
+	if len(phases) == 0 {
+		return DefaultBattlePhases, nil
+	}
+	return phases, nil
+}
+
+func (phase *BattlePhase) includes(family string) bool {
+	for _, phaseFamily := range phase.Families {
+		if phaseFamily == family {
+			return true
+		}
+	}
+	return false
+}
+
+func (phase *BattlePhase) canReach(line BattleLine, frontBroken bool) bool {
+	for _, target := range phase.Targets {
+		if target == line {
+			return true
+		}
+	}
+	if frontBroken {
+		for _, target := range phase.Breakthrough {
+			if target == line {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// PhasedSimulator resolves each battle as a sequence of phases, with units
+// fighting from their line in formation
+type PhasedSimulator struct {
+	NormalSimulator
+	phases []BattlePhase
+}
+
+// NewPhasedSimulator constructs a PhasedSimulator
+func NewPhasedSimulator(logger *logrus.Logger, resource database.Resource, lock *SimLock, rules TempleRules,
+	dispatcher *events.Dispatcher, phases []BattlePhase) PhasedSimulator {
+	return PhasedSimulator{
+		NewNormalSimulator(logger, resource, lock, rules, dispatcher),
+		phases,
+	}
+}
+
+// Simulate simulates a day and makes the appropriate changes to the database
+func (ps *PhasedSimulator) Simulate() error {
+	return ps.simulate(ps.SimulateBattle)
+}
+
+// SimulateBattle simulates a battle at a single location, running each phase in
+// turn
+func (ps *PhasedSimulator) SimulateBattle(location int32, players map[string][]entities.Player) (*BattleResult, error) {
+	deadPlayers := newGraveyard()
+	routedPlayers := newGraveyard()
+	livingPlayers := ps.calculateAttackOrder(players)
+	combatEvents := make([]entities.CombatEvent, 0, livingPlayers.Len())
+	strength := ps.countStrength(livingPlayers)
+
+	for i := range ps.phases {
+		combatEvents = ps.simulatePhase(&ps.phases[i], livingPlayers, deadPlayers, routedPlayers, strength, combatEvents)
+	}
+
+	combatEvents = ps.breakRanks(livingPlayers, routedPlayers, strength, combatEvents)
+
+	return ps.serializeBattle(livingPlayers, deadPlayers, routedPlayers, combatEvents), nil
+}
+
+// simulatePhase has every living unit that takes part in the phase act, in order
+// of initiative
+func (ps *PhasedSimulator) simulatePhase(phase *BattlePhase, livingPlayers *bst.Map, deadPlayers map[string]*bst.Map, routedPlayers map[string]*bst.Map, strength map[string]int, combatEvents []entities.CombatEvent) []entities.CombatEvent {
+	// snapshot the turn order, since players are removed from the living as
+	// they die
+	turnOrder := make([]bst.Float64, 0, livingPlayers.Len())
+	for iter := livingPlayers.Iterator(); iter.Next(); {
+		turnOrder = append(turnOrder, iter.Key().(bst.Float64))
+	}
+
+	for _, playerInitiative := range turnOrder {
+		value, alive := livingPlayers.Get(playerInitiative)
+		if !alive {
+			continue
+		}
+		player := value.(*entities.Player)
+		if !phase.includes(player.Family()) {
+			continue
+		}
+
+		if player.Class == "healer" {
+			// try to revive an ally
+			combatEvents = append(combatEvents, ps.reviveTarget(player, deadPlayers, livingPlayers))
+		}
+
+		// players in a passive stance don't always attack
+		if rand.Float64() >= player.GetStanceModifiers().AttackChance {
+			continue
+		}
+
+		numAttacks := 1
+		if player.Class == "mage" {
+			numAttacks = 3
+		}
+		for i := 0; i < numAttacks; i++ {
+			target, targetInitiative := ps.selectPhaseTarget(player, phase, livingPlayers)
+			if target == nil {
+				combatEvents = append(combatEvents, entities.CombatEvent{
+					Attacker:  player,
+					EventType: entities.Attack,
+					Result:    entities.NoTarget,
+				})
+				break
+			}
+
+			attackEvent := ps.attackTarget(player, target, ps.medicPower(target.MartialOrder, livingPlayers))
+			if attackEvent.Result == entities.Success {
+				attackEvent.Result = ps.defeatPlayer(target, targetInitiative, livingPlayers, deadPlayers, routedPlayers, strength)
+			}
+			combatEvents = append(combatEvents, attackEvent)
+
+			if attackEvent.Result == entities.Failure && target.Class == "glaivemaster" {
+				counterAttackEvent := ps.counterAttackTarget(target, player, ps.medicPower(player.MartialOrder, livingPlayers))
+				if counterAttackEvent.Result == entities.Success {
+					counterAttackEvent.Result = ps.defeatPlayer(player, playerInitiative, livingPlayers, deadPlayers, routedPlayers, strength)
+				}
+				combatEvents = append(combatEvents, counterAttackEvent)
+				if counterAttackEvent.Result != entities.Failure {
+					break
+				}
+			}
+		}
+	}
+
+	return combatEvents
+}
+
+// selectPhaseTarget picks a random aggro weighted enemy from the lines the
+// player can reach during the phase
+func (ps *PhasedSimulator) selectPhaseTarget(player *entities.Player, phase *BattlePhase, livingPlayers *bst.Map) (*entities.Player, bst.Float64) {
+	// an order's front line is broken once none of its front line units stand
+	frontStanding := make(map[string]bool)
+	for iter := livingPlayers.Iterator(); iter.Next(); {
+		candidate := iter.Value().(*entities.Player)
+		if familyLines[candidate.Family()] == FrontLine {
+			frontStanding[candidate.MartialOrder] = true
+		}
+	}
+
+	type weightedTarget struct {
+		player     *entities.Player
+		initiative bst.Float64
+		weight     int
+	}
+	var targets []weightedTarget
+	totalWeight := 0
+	for iter := livingPlayers.Iterator(); iter.Next(); {
+		candidate := iter.Value().(*entities.Player)
+		if !phase.canReach(familyLines[candidate.Family()], !frontStanding[candidate.MartialOrder]) {
+			continue
+		}
+
+		weight := targetWeight(player, candidate)
+		if weight <= 0 {
+			continue
+		}
+		targets = append(targets, weightedTarget{candidate, iter.Key().(bst.Float64), weight})
+		totalWeight += weight
+	}
+
+	if totalWeight <= 0 {
+		return nil, 0
+	}
+
+	weightLeft := rand.Intn(totalWeight)
+	for _, target := range targets {
+		weightLeft -= target.weight
+		if weightLeft < 0 {
+			return target.player, target.initiative
+		}
+	}
+	return nil, 0
+}
+
+// medicPower totals the support of the living players of an order
+func (ps *PhasedSimulator) medicPower(order string, livingPlayers *bst.Map) int {
+	power := 0
+	for iter := livingPlayers.Iterator(); iter.Next(); {
+		player := iter.Value().(*entities.Player)
+		if player.MartialOrder == order {
+			power += supportPower(player)
+		}
+	}
+	return power
+}
@@ -0,0 +1,90 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/bsm/bst"
+	"github.com/yisaj/heavens_throne/entities"
+)
+
+func TestPhaseTargetsBehindFrontLine(t *testing.T) {
+	cavalry := entities.Player{ID: 0, Class: "heavycavalry", Rank: 1, MartialOrder: "Staghorn Sect"}
+	infantry := entities.Player{ID: 1, Class: "sword", Rank: 1, MartialOrder: "Order Gorgona"}
+	archer := entities.Player{ID: 2, Class: "archer", Rank: 1, MartialOrder: "Order Gorgona"}
+
+	livingPlayers := bst.NewMap(3)
+	livingPlayers.Add(bst.Float64(-3), &cavalry)
+	livingPlayers.Add(bst.Float64(-2), &infantry)
+	livingPlayers.Add(bst.Float64(-1), &archer)
+
+	simulator := NewPhasedSimulator(newTestLogger(), nil, nil, TempleRules{}, nil, DefaultBattlePhases)
+	charge := &DefaultBattlePhases[0]
+
+	// the archer is shielded while the front line stands
+	for i := 0; i < 100; i++ {
+		target, _ := simulator.selectPhaseTarget(&cavalry, charge, livingPlayers)
+		if target != &infantry {
+			t.Fatalf("expected the charge to hit the front line, got %+v", target)
+		}
+	}
+
+	// once the front line breaks the archer is exposed
+	livingPlayers.Delete(bst.Float64(-2))
+	target, _ := simulator.selectPhaseTarget(&cavalry, charge, livingPlayers)
+	if target != &archer {
+		t.Errorf("expected the charge to reach the back line, got %+v", target)
+	}
+}
+
+func TestPhasedBattleSimulation(t *testing.T) {
+	players := initializePlayers()
+	simulator := NewPhasedSimulator(newTestLogger(), nil, nil, TempleRules{}, nil, DefaultBattlePhases)
+	result, err := simulator.SimulateBattle(0, players)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	total := 0
+	for _, survivor := range result.Survivors {
+		total += len(survivor)
+	}
+	for _, fatality := range result.Fatalities {
+		total += len(fatality)
+	}
+	for _, routed := range result.Routs {
+		total += len(routed)
+	}
+	expected := 0
+	for _, orderPlayers := range players {
+		expected += len(orderPlayers)
+	}
+	if total != expected {
+		t.Errorf("expected %d players after the battle, got %d", expected, total)
+	}
+
+	for _, event := range result.CombatEvents {
+		if event.Attacker == nil {
+			t.Errorf("combat event without an acting player: %+v", event)
+		}
+	}
+}
+
+func TestLookupBattlePhases(t *testing.T) {
+	phases, err := LookupBattlePhases([]string{"melee", " Charge "})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(phases) != 2 || phases[0].Name != "melee" || phases[1].Name != "charge" {
+		t.Errorf("unexpected phases: %+v", phases)
+	}
+
+	phases, err = LookupBattlePhases([]string{""})
+	if err != nil || len(phases) != len(DefaultBattlePhases) {
+		t.Errorf("expected the default phases, got %+v, %v", phases, err)
+	}
+
+	_, err = LookupBattlePhases([]string{"ambush"})
+	if err == nil {
+		t.Error("expected an unknown phase error")
+	}
+}
@@ -0,0 +1,51 @@
+package simulation
+
+import (
+	"context"
+
+	"github.com/yisaj/heavens_throne/database"
+	"github.com/yisaj/heavens_throne/events"
+
+	"github.com/pkg/errors"
+)
+
+// recorder keeps the game's records of battles, deaths and temples as the
+// simulator tells of them
+type recorder struct {
+	resource database.Resource
+}
+
+// NewRecorder constructs a subscriber that writes the day's records to the
+// database
+func NewRecorder(resource database.Resource) events.Subscriber {
+	return &recorder{
+		resource,
+	}
+}
+
+// Handle records the events that leave a record
+func (r *recorder) Handle(ctx context.Context, event events.Event) error {
+	switch e := event.(type) {
+	case events.BattleResolved:
+		for i := range e.CombatEvents {
+			if e.CombatEvents[i].Attacker == nil {
+				continue
+			}
+			err := r.resource.CreateCombatRecord(ctx, e.Location.ID, &e.CombatEvents[i])
+			if err != nil {
+				return errors.Wrap(err, "failed recording battle")
+			}
+		}
+	case events.PlayerKilled:
+		err := r.resource.CreateDeathRecord(ctx, e.Day, e.Location, e.Player.ID, e.Cause)
+		if err != nil {
+			return errors.Wrap(err, "failed recording death")
+		}
+	case events.TempleChanged:
+		err := r.resource.CreateTempleRecord(ctx, &e.Temple, e.Change)
+		if err != nil {
+			return errors.Wrap(err, "failed recording temple change")
+		}
+	}
+	return nil
+}
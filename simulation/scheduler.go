@@ -0,0 +1,296 @@
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yisaj/heavens_throne/database"
+	"github.com/yisaj/heavens_throne/entities"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+	"github.com/sirupsen/logrus"
+)
+
+// how late a scheduled simulation can start and still count as on time. later
+// than that it was missed, and is only run when catching up
+const lateRunTolerance = time.Hour
+
+// ParseSchedule parses a standard five field cron spec in the given time zone.
+// a blank time zone means the local one, and a spec starting with its own
+// CRON_TZ= keeps it
+func ParseSchedule(spec string, timeZone string) (cron.Schedule, error) {
+	if timeZone != "" && !strings.HasPrefix(spec, "CRON_TZ=") && !strings.HasPrefix(spec, "TZ=") {
+		_, err := time.LoadLocation(timeZone)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed loading schedule time zone")
+		}
+		spec = "CRON_TZ=" + timeZone + " " + spec
+	}
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed parsing schedule")
+	}
+	return schedule, nil
+}
+
+// Scheduler runs the simulator on a schedule, recording every run in the
+// database so that days missed while the game was down are noticed when it comes
+// back up. missed days are either simulated in order or skipped. instances
+// share an advisory lock, so only one of them runs the schedule
+type Scheduler struct {
+	logger      *logrus.Logger
+	game        string
+	resource    database.Resource
+	simulator   Simulator
+	storyteller StoryTeller
+	schedule    cron.Schedule
+	catchUp     bool
+	cron        *cron.Cron
+	// runs within the process wait on each other rather than on the database
+	lock    sync.Mutex
+	running sync.WaitGroup
+	// held while stopping, so no run starts once stopping has been waited on
+	stopLock sync.Mutex
+	stopping chan struct{}
+	now      func() time.Time
+}
+
+// NewScheduler constructs a scheduler for the named game. catching up simulates
+// every missed day, otherwise they're recorded as skipped
+func NewScheduler(logger *logrus.Logger, game string, resource database.Resource, simulator Simulator, storyteller StoryTeller,
+	schedule cron.Schedule, catchUp bool) *Scheduler {
+	return &Scheduler{
+		logger,
+		game,
+		resource,
+		simulator,
+		storyteller,
+		schedule,
+		catchUp,
+		cron.New(),
+		sync.Mutex{},
+		sync.WaitGroup{},
+		sync.Mutex{},
+		make(chan struct{}),
+		time.Now,
+	}
+}
+
+// Schedule is when the scheduler runs the simulator
+func (s *Scheduler) Schedule() cron.Schedule {
+	return s.schedule
+}
+
+// Start deals with whatever the schedule missed while the game was down, then
+// keeps to the schedule
+func (s *Scheduler) Start() {
+	s.cron.Schedule(s.schedule, s)
+	s.cron.Start()
+
+	s.running.Add(1)
+	go func() {
+		defer s.running.Done()
+		s.Run()
+	}()
+}
+
+// Stop stops keeping to the schedule. the returned context is done once a run
+// already in progress finishes. missed days not yet caught up on are left for
+// the next start
+func (s *Scheduler) Stop() context.Context {
+	s.stopLock.Lock()
+	close(s.stopping)
+	s.stopLock.Unlock()
+	cronStopped := s.cron.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-cronStopped.Done()
+		s.running.Wait()
+		cancel()
+	}()
+	return ctx
+}
+
+// Simulate runs a day right away, outside the schedule and without telling
+// it. it never overlaps a scheduled run, and stopping waits on it the same way
+func (s *Scheduler) Simulate() error {
+	s.stopLock.Lock()
+	select {
+	case <-s.stopping:
+		s.stopLock.Unlock()
+		return errors.New("scheduler stopped")
+	default:
+	}
+	s.running.Add(1)
+	s.stopLock.Unlock()
+	defer s.running.Done()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	ctx := context.Background()
+
+	unlock, err := s.resource.LockSchedule(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed manual simulation")
+	}
+	if unlock == nil {
+		return errors.New("another instance is running the schedule")
+	}
+	defer func() {
+		err := unlock()
+		if err != nil {
+			s.logger.WithError(err).WithField("game", s.game).Error("failed manual simulation")
+		}
+	}()
+
+	s.logger.WithField("game", s.game).Info("running game simulator by hand")
+	err = s.simulator.Simulate()
+	if err != nil {
+		return errors.Wrap(err, "failed manual simulation")
+	}
+	return nil
+}
+
+// Run runs every simulation due by now that hasn't been yet, stopping at the
+// first that fails. a panicking run is reported instead of taking the whole
+// game down
+func (s *Scheduler) Run() {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			s.logger.WithFields(logrus.Fields{
+				"game":  s.game,
+				"panic": fmt.Sprint(recovered),
+				"stack": string(debug.Stack()),
+			}).Error("recovered scheduler panic")
+		}
+	}()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	ctx := context.Background()
+	logger := s.logger.WithField("game", s.game)
+
+	unlock, err := s.resource.LockSchedule(ctx)
+	if err != nil {
+		logger.WithError(err).Error("failed running schedule")
+		return
+	}
+	if unlock == nil {
+		logger.Info("another instance is running the schedule")
+		return
+	}
+	defer func() {
+		err := unlock()
+		if err != nil {
+			logger.WithError(err).Error("failed running schedule")
+		}
+	}()
+
+	due, err := s.due(ctx, s.now())
+	if err != nil {
+		logger.WithError(err).Error("failed running schedule")
+		return
+	}
+	for _, scheduledFor := range due {
+		select {
+		case <-s.stopping:
+			return
+		default:
+		}
+
+		err = s.runScheduled(ctx, scheduledFor)
+		if err != nil {
+			logger.WithError(err).Error("failed running schedule")
+			return
+		}
+	}
+}
+
+// due lists the simulations scheduled by now that haven't been run or skipped,
+// oldest first. with no runs recorded, only one that's just come due is. a day
+// that failed or was cut off partway is left half applied, so nothing is due
+// after it until someone has looked at it and marked it finished or skipped
+func (s *Scheduler) due(ctx context.Context, now time.Time) ([]time.Time, error) {
+	last, err := s.resource.GetLastScheduledRun(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed finding due simulations")
+	}
+
+	from := now.Add(-lateRunTolerance)
+	if last != nil {
+		switch last.Status {
+		case entities.RunRunning:
+			scheduleStopped.Set(1, s.game)
+			return nil, errors.Errorf("scheduled simulation for %s never finished", last.ScheduledFor)
+		case entities.RunFailed:
+			scheduleStopped.Set(1, s.game)
+			return nil, errors.Errorf("scheduled simulation for %s failed", last.ScheduledFor)
+		}
+		from = last.ScheduledFor
+	}
+	scheduleStopped.Set(0, s.game)
+
+	var due []time.Time
+	for next := s.schedule.Next(from); !next.IsZero() && !next.After(now); next = s.schedule.Next(next) {
+		due = append(due, next)
+	}
+	return due, nil
+}
+
+// runScheduled runs or skips a single scheduled simulation, recording the
+// outcome
+func (s *Scheduler) runScheduled(ctx context.Context, scheduledFor time.Time) error {
+	logger := s.logger.WithFields(logrus.Fields{
+		"game":          s.game,
+		"scheduled_for": scheduledFor,
+	})
+
+	if !s.catchUp && s.now().Sub(scheduledFor) > lateRunTolerance {
+		_, err := s.resource.StartScheduledRun(ctx, scheduledFor, entities.RunSkipped)
+		if err != nil {
+			return errors.Wrap(err, "failed skipping missed simulation")
+		}
+		scheduledRuns.Inc(s.game, string(entities.RunSkipped))
+		logger.Warn("skipped missed simulation")
+		return nil
+	}
+
+	started, err := s.resource.StartScheduledRun(ctx, scheduledFor, entities.RunRunning)
+	if err != nil {
+		return errors.Wrap(err, "failed starting scheduled simulation")
+	}
+	if !started {
+		return nil
+	}
+
+	logger.Info("running game simulator")
+	status := entities.RunFinished
+	simErr := s.simulator.Simulate()
+	if simErr != nil {
+		status = entities.RunFailed
+	}
+	scheduledRuns.Inc(s.game, string(status))
+	err = s.resource.FinishScheduledRun(ctx, scheduledFor, status, simErr)
+	if err != nil {
+		return errors.Wrap(err, "failed finishing scheduled simulation")
+	}
+
+	// a day that didn't finish isn't told, and nothing more is simulated on
+	// top of it
+	if simErr != nil {
+		scheduleStopped.Set(1, s.game)
+		return errors.Wrap(simErr, "failed scheduled simulation")
+	}
+
+	err = s.storyteller.Tell()
+	if err != nil {
+		logger.WithError(err).Error("failed telling the day's story")
+	}
+	return nil
+}
@@ -0,0 +1,291 @@
+package simulation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yisaj/heavens_throne/database"
+	"github.com/yisaj/heavens_throne/entities"
+	"github.com/yisaj/heavens_throne/events"
+
+	"github.com/pkg/errors"
+)
+
+// scheduleResource fakes the scheduled run records
+type scheduleResource struct {
+	database.Resource
+	locked bool
+	runs   []entities.ScheduledRun
+}
+
+func (r *scheduleResource) LockSchedule(ctx context.Context) (func() error, error) {
+	if r.locked {
+		return nil, nil
+	}
+	r.locked = true
+	return func() error {
+		r.locked = false
+		return nil
+	}, nil
+}
+
+func (r *scheduleResource) GetLastScheduledRun(ctx context.Context) (*entities.ScheduledRun, error) {
+	if len(r.runs) == 0 {
+		return nil, nil
+	}
+	return &r.runs[len(r.runs)-1], nil
+}
+
+func (r *scheduleResource) StartScheduledRun(ctx context.Context, scheduledFor time.Time, status entities.RunStatus) (bool, error) {
+	r.runs = append(r.runs, entities.ScheduledRun{ScheduledFor: scheduledFor, Status: status})
+	return true, nil
+}
+
+func (r *scheduleResource) FinishScheduledRun(ctx context.Context, scheduledFor time.Time, status entities.RunStatus, runErr error) error {
+	r.runs[len(r.runs)-1].Status = status
+	return nil
+}
+
+// countingSimulator counts the days it simulates and told about, failing the
+// given day
+type countingSimulator struct {
+	days   int
+	tells  int
+	failOn int
+}
+
+func (s *countingSimulator) Simulate() error {
+	s.days++
+	if s.days == s.failOn {
+		return errors.New("failed")
+	}
+	return nil
+}
+
+func (s *countingSimulator) Handle(ctx context.Context, event events.Event) error {
+	return nil
+}
+
+func (s *countingSimulator) Tell() error {
+	s.tells++
+	return nil
+}
+
+func newTestScheduler(t *testing.T, resource *scheduleResource, now time.Time, catchUp bool) (*Scheduler, *countingSimulator) {
+	schedule, err := ParseSchedule("0 0 * * *", "UTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	simulator := &countingSimulator{}
+	scheduler := NewScheduler(newTestLogger(), "test", resource, simulator, simulator, schedule, catchUp)
+	scheduler.now = func() time.Time {
+		return now
+	}
+	return scheduler, simulator
+}
+
+func TestSchedulerCatchUp(t *testing.T) {
+	midnight := time.Date(2020, 6, 5, 0, 0, 0, 0, time.UTC)
+	for _, catchUp := range []bool{true, false} {
+		// down for the last three days, and back up just after midnight
+		resource := &scheduleResource{runs: []entities.ScheduledRun{
+			{ScheduledFor: midnight.Add(-72 * time.Hour), Status: entities.RunFinished},
+		}}
+		scheduler, simulator := newTestScheduler(t, resource, midnight.Add(10*time.Minute), catchUp)
+		scheduler.Run()
+
+		expected := []entities.RunStatus{entities.RunFinished, entities.RunSkipped, entities.RunSkipped, entities.RunFinished}
+		if catchUp {
+			expected[1], expected[2] = entities.RunFinished, entities.RunFinished
+		}
+		if len(resource.runs) != len(expected) {
+			t.Fatalf("expected %d runs catching up %t, got %v", len(expected), catchUp, resource.runs)
+		}
+		for i, run := range resource.runs {
+			if run.Status != expected[i] || !run.ScheduledFor.Equal(midnight.Add(time.Duration(i-3)*24*time.Hour)) {
+				t.Errorf("expected run %d to be %s, got %v", i, expected[i], run)
+			}
+		}
+		days := 1
+		if catchUp {
+			days = 3
+		}
+		if simulator.days != days || simulator.tells != days {
+			t.Errorf("expected %d days simulated catching up %t, got %d", days, catchUp, simulator.days)
+		}
+
+		// nothing's due the second time around
+		scheduler.Run()
+		if simulator.days != days {
+			t.Errorf("expected no more days simulated, got %d", simulator.days)
+		}
+	}
+}
+
+func TestSchedulerFirstRun(t *testing.T) {
+	midnight := time.Date(2020, 6, 5, 0, 0, 0, 0, time.UTC)
+
+	// with nothing recorded, days long gone aren't owed
+	resource := &scheduleResource{}
+	scheduler, simulator := newTestScheduler(t, resource, midnight.Add(-2*time.Hour), true)
+	scheduler.Run()
+	if simulator.days != 0 || len(resource.runs) != 0 {
+		t.Errorf("expected nothing to run, got %v", resource.runs)
+	}
+
+	// but one just come due is
+	scheduler, simulator = newTestScheduler(t, resource, midnight, true)
+	scheduler.Run()
+	if simulator.days != 1 || len(resource.runs) != 1 {
+		t.Errorf("expected the day to run, got %v", resource.runs)
+	}
+}
+
+func TestSchedulerFailure(t *testing.T) {
+	midnight := time.Date(2020, 6, 5, 0, 0, 0, 0, time.UTC)
+	resource := &scheduleResource{runs: []entities.ScheduledRun{
+		{ScheduledFor: midnight.Add(-72 * time.Hour), Status: entities.RunFinished},
+	}}
+	scheduler, simulator := newTestScheduler(t, resource, midnight.Add(10*time.Minute), true)
+	simulator.failOn = 2
+
+	// catching up stops at the failed day, which isn't told
+	scheduler.Run()
+	expected := []entities.RunStatus{entities.RunFinished, entities.RunFinished, entities.RunFailed}
+	if len(resource.runs) != len(expected) {
+		t.Fatalf("expected %d runs, got %v", len(expected), resource.runs)
+	}
+	for i, run := range resource.runs {
+		if run.Status != expected[i] {
+			t.Errorf("expected run %d to be %s, got %v", i, expected[i], run)
+		}
+	}
+	if simulator.days != 2 || simulator.tells != 1 {
+		t.Errorf("expected 2 days simulated and 1 told, got %d and %d", simulator.days, simulator.tells)
+	}
+
+	// and nothing more is simulated on top of it
+	scheduler.Run()
+	if simulator.days != 2 || len(resource.runs) != len(expected) {
+		t.Errorf("expected the schedule to stay stopped, got %v", resource.runs)
+	}
+}
+
+func TestSchedulerUnfinished(t *testing.T) {
+	midnight := time.Date(2020, 6, 5, 0, 0, 0, 0, time.UTC)
+	// the last run was cut off partway
+	resource := &scheduleResource{runs: []entities.ScheduledRun{
+		{ScheduledFor: midnight.Add(-24 * time.Hour), Status: entities.RunRunning},
+	}}
+	scheduler, simulator := newTestScheduler(t, resource, midnight, true)
+	scheduler.Run()
+	if simulator.days != 0 || len(resource.runs) != 1 {
+		t.Errorf("expected nothing to run after an unfinished day, got %v", resource.runs)
+	}
+}
+
+func TestSchedulerLocked(t *testing.T) {
+	midnight := time.Date(2020, 6, 5, 0, 0, 0, 0, time.UTC)
+	resource := &scheduleResource{locked: true}
+	scheduler, simulator := newTestScheduler(t, resource, midnight, true)
+	scheduler.Run()
+	if simulator.days != 0 {
+		t.Error("expected another instance holding the lock to run the day")
+	}
+}
+
+func TestParseSchedule(t *testing.T) {
+	schedule, err := ParseSchedule("30 6 * * *", "America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	next := schedule.Next(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	if expected := time.Date(2020, 1, 1, 11, 30, 0, 0, time.UTC); !next.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, next)
+	}
+
+	// a game's own time zone wins
+	schedule, err = ParseSchedule("CRON_TZ=Asia/Tokyo 0 9 * * *", "America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	next = schedule.Next(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	if expected := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC); !next.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, next)
+	}
+
+	_, err = ParseSchedule("0 0 * * *", "Nowhere/Special")
+	if err == nil {
+		t.Error("expected an unknown time zone to fail")
+	}
+}
+
+// blockingSimulator simulates a day once it's let go
+type blockingSimulator struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (s *blockingSimulator) Simulate() error {
+	close(s.started)
+	<-s.release
+	return nil
+}
+
+func TestSchedulerManualRun(t *testing.T) {
+	midnight := time.Date(2020, 6, 5, 0, 0, 0, 0, time.UTC)
+	resource := &scheduleResource{}
+	scheduler, simulator := newTestScheduler(t, resource, midnight.Add(12*time.Hour), true)
+
+	// a day run by hand isn't part of the schedule, and isn't told
+	err := scheduler.Simulate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if simulator.days != 1 || simulator.tells != 0 || len(resource.runs) != 0 {
+		t.Errorf("expected an unrecorded, untold day, got %d days and %v", simulator.days, resource.runs)
+	}
+
+	resource.locked = true
+	err = scheduler.Simulate()
+	if err == nil || simulator.days != 1 {
+		t.Error("expected another instance holding the lock to stop the day")
+	}
+}
+
+func TestSchedulerStopWaitsOnManualRun(t *testing.T) {
+	schedule, err := ParseSchedule("0 0 * * *", "UTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	simulator := &blockingSimulator{make(chan struct{}), make(chan struct{})}
+	scheduler := NewScheduler(newTestLogger(), "test", &scheduleResource{}, simulator, &countingSimulator{}, schedule, true)
+
+	finished := make(chan error)
+	go func() {
+		finished <- scheduler.Simulate()
+	}()
+	<-simulator.started
+
+	stopped := scheduler.Stop()
+	select {
+	case <-stopped.Done():
+		t.Fatal("expected stopping to wait on the day being run")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(simulator.release)
+	if err := <-finished; err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-stopped.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected stopping to finish with the day")
+	}
+
+	if scheduler.Simulate() == nil {
+		t.Error("expected no days to be run once stopped")
+	}
+}
@@ -2,49 +2,66 @@ package simulation
 
 import (
 	"context"
-	"fmt"
 	"math/rand"
+	"runtime/debug"
 	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
+	"github.com/yisaj/heavens_throne/atlas"
 	"github.com/yisaj/heavens_throne/database"
 	"github.com/yisaj/heavens_throne/entities"
+	"github.com/yisaj/heavens_throne/events"
 
 	"github.com/bsm/bst"
 	"github.com/pkg/errors"
 )
 
 const (
-	speedStdDev       float64 = 10
-	attackStdDev      float64 = 40
-	spearAttackBonus  int     = 10
-	spearDefenseBonus int     = 10
-	experienceStdDev  float64 = 5
-	killExperience    float64 = 30
-	deathExperience   float64 = 50
-	battleExperience  float64 = 20
+	speedStdDev          float64 = 10
+	attackStdDev         float64 = 40
+	spearAttackBonus     int     = 10
+	spearDefenseBonus    int     = 10
+	experienceStdDev     float64 = 5
+	killExperience       float64 = 30
+	deathExperience      float64 = 50
+	battleExperience     float64 = 20
+	victoryExperience    float64 = 20
+	survivalExperience   float64 = 10
+	captureExperience    float64 = 15
+	existenceExperience  float64 = 5
+	routMorale           float64 = 0.5
+	routChance           float64 = 0.3
+	cavalryRoutFactor    float64 = 2
+	targetPriorityFactor float64 = 3
+	defaultCommanderTerm int32   = 7
 )
 
 // SimLock provides mutual exclusion in the database between the simulator and
 // twitlisten player input
 type SimLock struct {
-	held     bool
-	holdLock sync.Mutex
-	longLock sync.RWMutex
+	held      bool
+	heldSince time.Time
+	holdLock  sync.Mutex
+	longLock  sync.RWMutex
 }
 
 // WLock gets the write lock for when the simulator starts running
 func (sl *SimLock) WLock() {
+	waiting := time.Now()
 	sl.holdLock.Lock()
 	sl.held = true
 	sl.longLock.Lock()
+	sl.heldSince = time.Now()
 	sl.holdLock.Unlock()
+	simLockWait.Observe(sl.heldSince.Sub(waiting).Seconds())
 }
 
 // WUnlock releases the write lock for when the simulator finishes running
 func (sl *SimLock) WUnlock() {
 	sl.holdLock.Lock()
 	sl.held = false
+	simLockHold.Observe(time.Since(sl.heldSince).Seconds())
 	sl.longLock.Unlock()
 	sl.holdLock.Unlock()
 }
@@ -71,44 +88,108 @@ type Simulator interface {
 	Simulate() error
 }
 
+// TempleRules configures what happens to an order that loses its temple, and
+// how long its commanders lead it
+type TempleRules struct {
+	// how many days the dead wait on their temple before returning at the
+	// nearest location their order holds instead. zero means they wait forever
+	FallbackRespawnDays int32
+	// whether an order with no temple and no living units is out of the war
+	EliminateOrders bool
+	// how many days an elected commander leads their order. zero means the
+	// default term
+	CommanderTerm int32
+}
+
 // NormalSimulator is the first, most natural implementation of a simulator
 type NormalSimulator struct {
-	logger   *logrus.Logger
-	resource database.Resource
-	lock     *SimLock
+	logger     *logrus.Logger
+	resource   database.Resource
+	lock       *SimLock
+	rules      TempleRules
+	dispatcher *events.Dispatcher
 }
 
-// NewNormalSimulator constructs a NormalSimulator
-func NewNormalSimulator(logger *logrus.Logger, resource database.Resource, lock *SimLock) NormalSimulator {
+// NewNormalSimulator constructs a NormalSimulator. what happens over the day is
+// published to the dispatcher, whose subscribers keep the records
+func NewNormalSimulator(logger *logrus.Logger, resource database.Resource, lock *SimLock, rules TempleRules,
+	dispatcher *events.Dispatcher) NormalSimulator {
 	return NormalSimulator{
 		logger,
 		resource,
 		lock,
+		rules,
+		dispatcher,
 	}
 }
 
-type LocationEventType int
-
-const (
-	Battle LocationEventType = iota
-	NoContest
-)
-
-// LocationEvent denotes what happened during an entire battle at a location
-type LocationEvent struct {
-	eventType      LocationEventType
-	survivors      map[string][]*entities.Player
-	fatalities     map[string][]*entities.Player
-	locationBefore entities.Location
-	locationAfter  entities.Location
+// BattleResult details the outcome of a battle at a single location, with the
+// players grouped by order
+type BattleResult struct {
+	Survivors    map[string][]*entities.Player
+	Fatalities   map[string][]*entities.Player
+	Routs        map[string][]*entities.Player
+	CombatEvents []entities.CombatEvent
 }
 
+// battleSimulation resolves a battle at a single location
+type battleSimulation func(location int32, players map[string][]entities.Player) (*BattleResult, error)
+
 // Simulate simulates a day and makes the appropriate changes to the database
 func (ns *NormalSimulator) Simulate() error {
+	return ns.simulate(ns.SimulateBattle)
+}
+
+// simulate simulates a day, resolving every battle with the given battle
+// simulation
+func (ns *NormalSimulator) simulate(simulateBattle battleSimulation) (err error) {
+	// observers only hear the day is over once the database can be read again
+	var day int32
+	started := time.Now()
+	defer func() {
+		result := "ok"
+		// a panic is reported like any other failed day, rather than taking the
+		// whole game down halfway through it
+		if recovered := recover(); recovered != nil {
+			err = errors.Errorf("panicked simulating: %v", recovered)
+			ns.logger.WithError(err).WithField("stack", string(debug.Stack())).Error("recovered simulation panic")
+			result = "panic"
+		} else if err != nil {
+			result = "error"
+		}
+		simulationDuration.Observe(time.Since(started).Seconds(), result)
+
+		finishErr := ns.dispatcher.Publish(context.TODO(), events.SimulationFinished{Day: day, Err: err})
+		if finishErr != nil {
+			ns.logger.WithError(finishErr).Error("failed finishing simulation")
+		}
+	}()
+
 	ns.lock.WLock()
+	defer ns.lock.WUnlock()
 
 	// increment the day
-	err := ns.resource.IncrementDay(context.TODO())
+	err = ns.resource.IncrementDay(context.TODO())
+	if err != nil {
+		return errors.Wrap(err, "failed simulation")
+	}
+	day, err = ns.resource.GetDay(context.TODO())
+	if err != nil {
+		return errors.Wrap(err, "failed simulation")
+	}
+	err = ns.dispatcher.Publish(context.TODO(), events.SimulationStarted{Day: day})
+	if err != nil {
+		return errors.Wrap(err, "failed simulation")
+	}
+
+	// orders whose commander's term is up choose a new one
+	err = ns.holdElections()
+	if err != nil {
+		return errors.Wrap(err, "failed simulation")
+	}
+
+	// remember who held the temples, to tell who lost or won them back today
+	templesBefore, err := ns.resource.GetTemples(context.TODO())
 	if err != nil {
 		return errors.Wrap(err, "failed simulation")
 	}
@@ -125,24 +206,23 @@ func (ns *NormalSimulator) Simulate() error {
 		return errors.Wrap(err, "failed simulation")
 	}
 
-	for _, player := range players {
-		ns.logger.Debugf("SIMMM %s", player.TwitterID)
+	// every living player gains a little experience just for existing
+	experienceGains := make([]entities.ExperienceGain, 0, len(players))
+	for i := range players {
+		experienceGains = append(experienceGains, ns.giveExperience(&players[i], entities.ExistenceExperience, existenceExperience, ""))
 	}
 
 	// process all players into a map grouped by location
 	playersByLocationAndOrder := make(map[int32]map[string][]entities.Player)
 	for _, player := range players {
-		ns.logger.Debugf("BETA: %+v", player)
 		if playersByLocationAndOrder[player.Location.Int32] == nil {
 			playersByLocationAndOrder[player.Location.Int32] = make(map[string][]entities.Player)
 		}
 		playersByLocationAndOrder[player.Location.Int32][player.MartialOrder] = append(playersByLocationAndOrder[player.Location.Int32][player.MartialOrder], player)
-		ns.logger.Debugf("DELTA: %+v", playersByLocationAndOrder)
 	}
 
-	ns.logger.Debugf("ALPHA %+v", playersByLocationAndOrder)
-
 	// for each location simulate a battle
+	battleLocations := make(map[int32]bool)
 	for locationID, locationPlayers := range playersByLocationAndOrder {
 		// Count how many armies are present
 		numArmies := 0
@@ -154,45 +234,39 @@ func (ns *NormalSimulator) Simulate() error {
 			}
 		}
 
+		var result *BattleResult
 		if numArmies >= 2 {
 			// battle occurs
-			survivors, fatalities, combatEvents, err := ns.SimulateBattle(locationID, locationPlayers)
+			battleLocations[locationID] = true
+			battlesTotal.Inc()
+			result, err = simulateBattle(locationID, locationPlayers)
 			if err != nil {
 				return errors.Wrap(err, "failed simulation")
 			}
 
 			// kill all dead players in the database
-			for _, dead := range fatalities {
+			for _, dead := range result.Fatalities {
 				for _, fatality := range dead {
-					err := ns.resource.KillPlayer(context.TODO(), fatality.TwitterID)
+					err = ns.killPlayer(day, locationID, fatality, entities.Slain)
 					if err != nil {
 						return errors.Wrap(err, "failed simulation")
 					}
 				}
 			}
 
-			// dole out player experience
-			for _, event := range combatEvents {
-				ns.giveCombatExperience(&event)
+			// push routed players back to a friendly location
+			err = ns.retreatPlayers(day, locationID, result.Routs)
+			if err != nil {
+				return errors.Wrap(err, "failed simulation")
 			}
 
-			// create records for each combat
-			for _, event := range combatEvents {
-				err = ns.resource.CreateCombatRecord(context.TODO(), locationID, &event)
-				if err != nil {
-					return errors.Wrap(err, "failed inserting combat records")
-				}
-			}
+			occupier = battleVictor(result, occupier)
 
-			// TODO ENGINEER: deal with ties, as well as 3 battle configurations (count losers maybe?)
-			// calculate the occupier
-			var max int
-			for order, array := range survivors {
-				if len(array) > max {
-					occupier = order
-					max = len(array)
-				}
+			// dole out player experience
+			for _, event := range result.CombatEvents {
+				experienceGains = append(experienceGains, ns.giveCombatExperience(&event)...)
 			}
+			experienceGains = append(experienceGains, ns.giveBattleExperience(result, occupier)...)
 		}
 
 		// check if ownership of the location has changed
@@ -201,6 +275,22 @@ func (ns *NormalSimulator) Simulate() error {
 			return errors.Wrap(err, "failed simulation")
 		}
 
+		if result != nil {
+			err = ns.dispatcher.Publish(context.TODO(), events.BattleResolved{
+				Day:          day,
+				Location:     *location,
+				Victor:       occupier,
+				Combatants:   locationPlayers,
+				Survivors:    result.Survivors,
+				Fatalities:   result.Fatalities,
+				Routs:        result.Routs,
+				CombatEvents: result.CombatEvents,
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed simulation")
+			}
+		}
+
 		if location.Occupier.Valid && location.Occupier.String == occupier {
 			if !location.Owner.Valid || location.Owner.String != occupier {
 				// change the owner to the new occupier
@@ -208,6 +298,17 @@ func (ns *NormalSimulator) Simulate() error {
 				if err != nil {
 					return errors.Wrap(err, "failed simulation")
 				}
+				err = ns.dispatcher.Publish(context.TODO(), events.LocationCaptured{Day: day, Location: *location, MartialOrder: occupier})
+				if err != nil {
+					return errors.Wrap(err, "failed simulation")
+				}
+
+				// reward the capturing army, leaving out whoever fell or fled in
+				// the battle for it
+				for _, capturer := range capturers(locationPlayers[occupier], result, occupier) {
+					experienceGains = append(experienceGains,
+						ns.giveExperience(capturer, entities.CaptureExperience, captureExperience, ""))
+				}
 			}
 		} else {
 			// change the occupier to the new ocuppier
@@ -218,53 +319,509 @@ func (ns *NormalSimulator) Simulate() error {
 		}
 	}
 
+	// record and hand out all the experience earned today
+	err = ns.resource.AwardExperience(context.TODO(), experienceGains)
+	if err != nil {
+		return errors.Wrap(err, "failed simulation")
+	}
+
+	// send marching players on to their next step
+	err = ns.advanceMarches(battleLocations)
+	if err != nil {
+		return errors.Wrap(err, "failed simulation")
+	}
+
+	// tell of any temples that changed hands
+	temples, err := ns.templeChanges(day, templesBefore)
+	if err != nil {
+		return errors.Wrap(err, "failed simulation")
+	}
+
 	// revive all players
 	err = ns.resource.RevivePlayers(context.TODO())
 	if err != nil {
 		return errors.Wrap(err, "failed simulation")
 	}
 
+	// the long dead of orders without their temple return elsewhere
+	if ns.rules.FallbackRespawnDays > 0 {
+		err = ns.fallbackRespawn(temples)
+		if err != nil {
+			return errors.Wrap(err, "failed simulation")
+		}
+	}
+
+	// tell of everyone who came back from an earlier day
+	returns, err := ns.resource.GetDayReturns(context.TODO(), day)
+	if err != nil {
+		return errors.Wrap(err, "failed simulation")
+	}
+	for _, ret := range returns {
+		err = ns.dispatcher.Publish(context.TODO(), events.PlayerRevived{Day: day, Return: ret})
+		if err != nil {
+			return errors.Wrap(err, "failed simulation")
+		}
+	}
+
+	// orders with nothing left are out of the war
+	if ns.rules.EliminateOrders {
+		eliminated, err := ns.resource.EliminateDefeatedOrders(context.TODO())
+		if err != nil {
+			return errors.Wrap(err, "failed simulation")
+		}
+		for _, order := range eliminated {
+			ns.logger.WithField("order", order).Info("order eliminated")
+		}
+	}
+
 	// TODO ENGINEER: check if game is over
 
-	ns.lock.WUnlock()
+	err = ns.dispatcher.Publish(context.TODO(), events.DayAdvanced{Day: day})
+	if err != nil {
+		return errors.Wrap(err, "failed simulation")
+	}
+	return nil
+}
+
+// battleVictor is the order left holding the field after a battle, the one with
+// the most survivors. with no survivors at all, the fallback holds it
+func battleVictor(result *BattleResult, fallback string) string {
+	// TODO ENGINEER: deal with ties, as well as 3 battle configurations (count losers maybe?)
+	victor := fallback
+	var max int
+	for order, array := range result.Survivors {
+		if len(array) > max {
+			victor = order
+			max = len(array)
+		}
+	}
+	return victor
+}
+
+// capturers are the players of an order still standing at a location once any
+// battle there is over
+func capturers(players []entities.Player, result *BattleResult, order string) []*entities.Player {
+	if result != nil {
+		return result.Survivors[order]
+	}
+	standing := make([]*entities.Player, len(players))
+	for i := range players {
+		standing[i] = &players[i]
+	}
+	return standing
+}
+
+// killPlayer kills a player in the database and tells of their death
+func (ns *NormalSimulator) killPlayer(day int32, locationID int32, player *entities.Player, cause entities.DeathCause) error {
+	err := ns.resource.KillPlayer(context.TODO(), player.TwitterID)
+	if err != nil {
+		return errors.Wrap(err, "failed killing player")
+	}
+	err = ns.dispatcher.Publish(context.TODO(), events.PlayerKilled{Day: day, Location: locationID, Player: player, Cause: cause})
+	if err != nil {
+		return errors.Wrap(err, "failed killing player")
+	}
 	return nil
 }
 
-func (ns *NormalSimulator) giveCombatExperience(event *entities.CombatEvent) {
-	// TODO DESIGN: do this
-	/*
-		if event.Result == Success {
-			if event.Attacker.ID == playerID {
-				event.Attacker.Experience += int16(rand.NormFloat64()*experienceStdDev + killExperience)
+// templeChanges tells of every temple that was captured or reclaimed since the
+// given snapshot, and returns the temples as they are now
+func (ns *NormalSimulator) templeChanges(day int32, before []entities.Temple) ([]entities.Temple, error) {
+	after, err := ns.resource.GetTemples(context.TODO())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed finding temple changes")
+	}
+
+	held := make(map[string]bool)
+	for _, temple := range before {
+		held[temple.MartialOrder] = temple.Held()
+	}
+	for _, temple := range after {
+		wasHeld, ok := held[temple.MartialOrder]
+		if !ok || wasHeld == temple.Held() {
+			continue
+		}
+
+		change := entities.TempleCaptured
+		if temple.Held() {
+			change = entities.TempleReclaimed
+		}
+		err = ns.dispatcher.Publish(context.TODO(), events.TempleChanged{Day: day, Temple: temple, Change: change})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed finding temple changes")
+		}
+	}
+	return after, nil
+}
+
+// fallbackRespawn brings back the dead who've waited long enough on a lost
+// temple, at the location nearest the temple that their order still holds
+func (ns *NormalSimulator) fallbackRespawn(temples []entities.Temple) error {
+	day, err := ns.resource.GetDay(context.TODO())
+	if err != nil {
+		return errors.Wrap(err, "failed fallback respawn")
+	}
+	graph, err := ns.resource.GetMapGraph(context.TODO())
+	if err != nil {
+		return errors.Wrap(err, "failed fallback respawn")
+	}
+	locations, err := ns.resource.GetLocations(context.TODO())
+	if err != nil {
+		return errors.Wrap(err, "failed fallback respawn")
+	}
+	owners := make(map[int32]string)
+	for _, location := range locations {
+		owners[location.ID] = location.Owner.String
+	}
+
+	for _, temple := range temples {
+		if temple.Held() {
+			continue
+		}
+		order := temple.MartialOrder
+
+		// orders out of the war stay out, whatever they were left holding
+		eliminated, err := ns.resource.IsOrderEliminated(context.TODO(), order)
+		if err != nil {
+			return errors.Wrap(err, "failed fallback respawn")
+		}
+		if eliminated {
+			continue
+		}
+
+		fallback, ok := graph.Nearest(temple.Location, func(location int32) bool {
+			return owners[location] == order
+		})
+		if !ok {
+			continue
+		}
+
+		err = ns.resource.ReviveOrderPlayers(context.TODO(), order, fallback, day-ns.rules.FallbackRespawnDays)
+		if err != nil {
+			return errors.Wrap(err, "failed fallback respawn")
+		}
+	}
+	return nil
+}
+
+// holdElections elects a new commander for every order without one
+func (ns *NormalSimulator) holdElections() error {
+	term := ns.rules.CommanderTerm
+	if term <= 0 {
+		term = defaultCommanderTerm
+	}
+	for _, order := range entities.MartialOrders {
+		commander, err := ns.resource.GetCommander(context.TODO(), order)
+		if err != nil {
+			return errors.Wrap(err, "failed holding elections")
+		}
+		if commander != nil && commander.Player.Valid {
+			continue
+		}
+
+		commander, err = ns.resource.HoldElection(context.TODO(), order, term)
+		if err != nil {
+			return errors.Wrap(err, "failed holding elections")
+		}
+		if commander != nil {
+			ns.logger.Infof("%s elected commander #%d with %d votes", order, commander.Player.Int32, commander.Votes)
+		}
+	}
+	return nil
+}
+
+// advanceMarches points every marching player at the next step of their route.
+// marches end when the player arrives, dies, gets caught in a battle, or can no
+// longer reach their destination
+func (ns *NormalSimulator) advanceMarches(battleLocations map[int32]bool) error {
+	marches, err := ns.resource.GetMarches(context.TODO())
+	if err != nil {
+		return errors.Wrap(err, "failed advancing marches")
+	}
+	if len(marches) == 0 {
+		return nil
+	}
+
+	graph, err := ns.resource.GetMapGraph(context.TODO())
+	if err != nil {
+		return errors.Wrap(err, "failed advancing marches")
+	}
+
+	for _, march := range marches {
+		route := nextRoute(graph, &march, battleLocations)
+		if len(route) == 0 {
+			err = ns.resource.CancelPlayerMarch(context.TODO(), march.TwitterID)
+		} else {
+			err = ns.resource.SetPlayerMarch(context.TODO(), march.TwitterID, route)
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed advancing marches")
+		}
+	}
+	return nil
+}
+
+// nextRoute returns what's left of a march after today's movement, or nothing if
+// the march is over
+func nextRoute(graph atlas.Graph, march *entities.March, battleLocations map[int32]bool) []int32 {
+	if !march.Location.Valid || battleLocations[march.Location.Int32] || len(march.Route) == 0 {
+		return nil
+	}
+	location := march.Location.Int32
+
+	route := march.Route
+	if route[0] == location {
+		route = route[1:]
+	}
+	if len(route) == 0 {
+		return nil
+	}
+
+	// players knocked off course find a new way to their destination
+	if !graph.IsAdjacent(location, route[0]) {
+		route = graph.ShortestPath(location, march.Destination())
+	}
+	return route
+}
+
+// retreatPlayers moves routed players to a random adjacent location held by
+// their order. players with nowhere to run are cut down instead
+func (ns *NormalSimulator) retreatPlayers(day int32, locationID int32, routs map[string][]*entities.Player) error {
+	for order, routed := range routs {
+		if len(routed) == 0 {
+			continue
+		}
+
+		retreats, err := ns.resource.GetRetreatLocations(context.TODO(), locationID, order)
+		if err != nil {
+			return errors.Wrap(err, "failed getting retreat locations")
+		}
+
+		for _, player := range routed {
+			if len(retreats) == 0 {
+				err = ns.killPlayer(day, locationID, player, entities.CutDown)
 			} else {
-				event.Attacker.Experience += int16(rand.NormFloat64()*experienceStdDev + deathExperience)
+				err = ns.resource.RoutPlayer(context.TODO(), player.TwitterID, retreats[rand.Intn(len(retreats))])
+			}
+			if err != nil {
+				return errors.Wrap(err, "failed retreating routed player")
 			}
-		} else {
-			event.Attacker.Experience += int16(rand.NormFloat64()*experienceStdDev + battleExperience)
 		}
-	*/
+	}
+	return nil
 }
 
-// SimulateBattle simulates a battle at a single location
-func (ns *NormalSimulator) SimulateBattle(location int32, players map[string][]entities.Player) (map[string][]*entities.Player, map[string][]*entities.Player, []entities.CombatEvent, error) {
-	deadPlayers := map[string]*bst.Map{
+// giveExperience rolls an experience gain of a source around the given mean
+func (ns *NormalSimulator) giveExperience(player *entities.Player, source entities.ExperienceSource, mean float64, targetClass string) entities.ExperienceGain {
+	amount := int16(rand.NormFloat64()*experienceStdDev + mean)
+	if amount < 0 {
+		amount = 0
+	}
+	return entities.ExperienceGain{
+		PlayerID:    player.ID,
+		Source:      source,
+		Amount:      amount,
+		TargetClass: targetClass,
+	}
+}
+
+// giveCombatExperience rewards the attacker of a successful attack or counter
+// attack for slaying their target
+func (ns *NormalSimulator) giveCombatExperience(event *entities.CombatEvent) []entities.ExperienceGain {
+	if event.Result != entities.Success || event.Attacker == nil || event.Defender == nil {
+		return nil
+	}
+	if event.EventType != entities.Attack && event.EventType != entities.CounterAttack {
+		return nil
+	}
+	return []entities.ExperienceGain{
+		ns.giveExperience(event.Attacker, entities.KillExperience, killExperience, event.Defender.Class),
+	}
+}
+
+// giveBattleExperience rewards everyone who took part in a battle, with extra
+// experience for surviving it and for being on the winning side
+func (ns *NormalSimulator) giveBattleExperience(result *BattleResult, victor string) []entities.ExperienceGain {
+	var gains []entities.ExperienceGain
+	for order, players := range result.Survivors {
+		for _, player := range players {
+			gains = append(gains, ns.giveExperience(player, entities.BattleExperience, battleExperience, ""))
+			gains = append(gains, ns.giveExperience(player, entities.SurvivalExperience, survivalExperience, ""))
+			if order == victor {
+				gains = append(gains, ns.giveExperience(player, entities.VictoryExperience, victoryExperience, ""))
+			}
+		}
+	}
+	for _, players := range result.Fatalities {
+		for _, player := range players {
+			gains = append(gains, ns.giveExperience(player, entities.BattleExperience, battleExperience, ""))
+			gains = append(gains, ns.giveExperience(player, entities.DeathExperience, deathExperience, ""))
+		}
+	}
+	for _, players := range result.Routs {
+		for _, player := range players {
+			gains = append(gains, ns.giveExperience(player, entities.BattleExperience, battleExperience, ""))
+		}
+	}
+	return gains
+}
+
+// newGraveyard constructs the per order maps that hold players who die during a
+// battle, keyed by their initiative
+func newGraveyard() map[string]*bst.Map {
+	return map[string]*bst.Map{
 		"Staghorn Sect": bst.NewMap(10),
 		"Order Gorgona": bst.NewMap(10),
 		"The Baaturate": bst.NewMap(10),
 	}
+}
+
+// serializeBattle collects the survivors, fatalities, and routs of a finished
+// battle by order
+func (ns *NormalSimulator) serializeBattle(livingPlayers *bst.Map, deadPlayers map[string]*bst.Map, routedPlayers map[string]*bst.Map, combatEvents []entities.CombatEvent) *BattleResult {
+	result := &BattleResult{
+		Survivors:    make(map[string][]*entities.Player),
+		Fatalities:   make(map[string][]*entities.Player),
+		Routs:        make(map[string][]*entities.Player),
+		CombatEvents: combatEvents,
+	}
+
+	// serialize the dead and routed players
+	for order, dead := range deadPlayers {
+		result.Fatalities[order] = make([]*entities.Player, 0, dead.Len())
+		for iter := dead.Iterator(); iter.Next(); {
+			result.Fatalities[order] = append(result.Fatalities[order], iter.Value().(*entities.Player))
+		}
+	}
+	for order, routed := range routedPlayers {
+		result.Routs[order] = make([]*entities.Player, 0, routed.Len())
+		for iter := routed.Iterator(); iter.Next(); {
+			result.Routs[order] = append(result.Routs[order], iter.Value().(*entities.Player))
+		}
+	}
+
+	// serialize the living players
+	for iter := livingPlayers.Iterator(); iter.Next(); {
+		player := iter.Value().(*entities.Player)
+		result.Survivors[player.MartialOrder] = append(result.Survivors[player.MartialOrder], player)
+	}
+
+	return result
+}
+
+// countStrength counts the players each order brings to a battle
+func (ns *NormalSimulator) countStrength(livingPlayers *bst.Map) map[string]int {
+	strength := make(map[string]int)
+	for iter := livingPlayers.Iterator(); iter.Next(); {
+		strength[iter.Value().(*entities.Player).MartialOrder]++
+	}
+	return strength
+}
+
+// breaks rolls whether a bested player routs. an order only starts to break once
+// it has lost enough of its strength, and cavalry break more easily than others
+func (ns *NormalSimulator) breaks(player *entities.Player, livingPlayers *bst.Map, strength map[string]int) bool {
+	if strength[player.MartialOrder] == 0 || player.GetStanceModifiers().Steadfast {
+		return false
+	}
+
+	standing := 0
+	for iter := livingPlayers.Iterator(); iter.Next(); {
+		if iter.Value().(*entities.Player).MartialOrder == player.MartialOrder {
+			standing++
+		}
+	}
+	morale := float64(standing) / float64(strength[player.MartialOrder])
+	if morale >= routMorale {
+		return false
+	}
+
+	chance := routChance
+	if player.Family() == "cavalry" {
+		chance *= cavalryRoutFactor
+	}
+	return rand.Float64() < chance
+}
+
+// defeatPlayer takes a bested player out of the battle, either routing them or
+// moving them to the graveyard, and returns the result of the blow
+func (ns *NormalSimulator) defeatPlayer(player *entities.Player, initiative bst.Float64, livingPlayers *bst.Map, deadPlayers map[string]*bst.Map, routedPlayers map[string]*bst.Map, strength map[string]int) entities.CombatResult {
+	result := entities.Success
+	if ns.breaks(player, livingPlayers, strength) {
+		routedPlayers[player.MartialOrder].Add(initiative, player)
+		result = entities.Routed
+	} else {
+		deadPlayers[player.MartialOrder].Add(initiative, player)
+	}
+	livingPlayers.Delete(initiative)
+	return result
+}
+
+// breakRanks has the beaten survivors of a battle roll to rout from the field
+func (ns *NormalSimulator) breakRanks(livingPlayers *bst.Map, routedPlayers map[string]*bst.Map, strength map[string]int, combatEvents []entities.CombatEvent) []entities.CombatEvent {
+	// the order with the most units left standing holds the field
+	standing := ns.countStrength(livingPlayers)
+	var victor string
+	var max int
+	for order, count := range standing {
+		if count > max {
+			victor = order
+			max = count
+		}
+	}
+
+	type brokenPlayer struct {
+		player     *entities.Player
+		initiative bst.Float64
+	}
+	var broken []brokenPlayer
+	for iter := livingPlayers.Iterator(); iter.Next(); {
+		player := iter.Value().(*entities.Player)
+		if player.MartialOrder != victor && ns.breaks(player, livingPlayers, strength) {
+			broken = append(broken, brokenPlayer{player, iter.Key().(bst.Float64)})
+		}
+	}
+
+	for _, b := range broken {
+		routedPlayers[b.player.MartialOrder].Add(b.initiative, b.player)
+		livingPlayers.Delete(b.initiative)
+		combatEvents = append(combatEvents, entities.CombatEvent{
+			Attacker:  b.player,
+			EventType: entities.Rout,
+			Result:    entities.Routed,
+		})
+	}
+	return combatEvents
+}
+
+// SimulateBattle simulates a battle at a single location in one pass, with
+// every unit acting in order of initiative
+func (ns *NormalSimulator) SimulateBattle(location int32, players map[string][]entities.Player) (*BattleResult, error) {
+	deadPlayers := newGraveyard()
+	routedPlayers := newGraveyard()
 
 	// calculate attack order
 	livingPlayers := ns.calculateAttackOrder(players)
 	combatEvents := make([]entities.CombatEvent, 0, livingPlayers.Len())
+	strength := ns.countStrength(livingPlayers)
 
-	// calculate total aggros
-	totalAggros, medicPowers := ns.calculateTotalAggros(livingPlayers)
+	// calculate medic totals
+	medicPowers := ns.calculateMedicPowers(livingPlayers)
 
-	// for each player take an action
+	// snapshot the turn order, since players are removed from the living as
+	// they die
+	turnOrder := make([]bst.Float64, 0, livingPlayers.Len())
 	for iter := livingPlayers.Iterator(); iter.Next(); {
-		player := iter.Value().(*entities.Player)
-		playerStats := player.GetStats()
-		playerInitiative := iter.Key().(bst.Float64)
+		turnOrder = append(turnOrder, iter.Key().(bst.Float64))
+	}
+
+	// for each player take an action
+	for _, playerInitiative := range turnOrder {
+		value, alive := livingPlayers.Get(playerInitiative)
+		if !alive {
+			continue
+		}
+		player := value.(*entities.Player)
 
 		if player.Class == "healer" {
 			// try to revive an ally
@@ -272,130 +829,120 @@ func (ns *NormalSimulator) SimulateBattle(location int32, players map[string][]e
 			combatEvents = append(combatEvents, reviveEvent)
 		}
 
+		// players in a passive stance don't always attack
+		if rand.Float64() >= player.GetStanceModifiers().AttackChance {
+			continue
+		}
+
 		// attack a random enemy
 		numAttacks := 1
 		if player.Class == "mage" {
 			numAttacks = 3
 		}
 		for i := 0; i < numAttacks; i++ {
-			// calculate total enemy aggro
-			totalEnemyAggro := ns.calculateEnemyAggro(player, totalAggros)
-			ns.logger.Debugf("totalEnemyAggro: %d", totalEnemyAggro)
 			// select target
-			target, targetInitiative := ns.selectTarget(player, livingPlayers, totalEnemyAggro)
-			targetStats := target.GetStats()
-
+			target, targetInitiative := ns.selectTarget(player, livingPlayers)
 			if target == nil {
-				attackEvent := entities.CombatEvent{nil, player, entities.Attack, entities.NoTarget}
+				attackEvent := entities.CombatEvent{
+					Attacker:  player,
+					EventType: entities.Attack,
+					Result:    entities.NoTarget,
+				}
 				combatEvents = append(combatEvents, attackEvent)
 				continue
 			}
 
-			// BUG: dead players can attack somehow
 			// decide what to do
 			attackEvent := ns.attackTarget(player, target, medicPowers[target.MartialOrder])
-			combatEvents = append(combatEvents, attackEvent)
 			if attackEvent.Result == entities.Success {
-				// move target to graveyard
-				deadPlayers[target.MartialOrder].Add(bst.Float64(targetInitiative), target)
-				livingPlayers.Delete(bst.Float64(targetInitiative))
-
-				// make sure aggro and medic counts are correct
-				if target.Class != "monsterknight" {
-					totalAggros["standard"][target.MartialOrder] -= targetStats.Aggro
-					totalAggros["horsearcher"][target.MartialOrder]--
-				}
-				totalAggros["ranged"][target.MartialOrder] -= targetStats.Aggro
-				if target.Class == "medic" || target.Class == "healer" {
-					medicPowers[target.MartialOrder] -= targetStats.Potency
-				}
+				// move target to graveyard or off the field
+				attackEvent.Result = ns.defeatPlayer(target, targetInitiative, livingPlayers, deadPlayers, routedPlayers, strength)
+				combatEvents = append(combatEvents, attackEvent)
+
+				// make sure medic counts are correct
+				medicPowers[target.MartialOrder] -= supportPower(target)
 
 			} else {
+				combatEvents = append(combatEvents, attackEvent)
 				if target.Class == "glaivemaster" {
 					counterAttackEvent := ns.counterAttackTarget(target, player, medicPowers[player.MartialOrder])
-					combatEvents = append(combatEvents, counterAttackEvent)
-					if counterAttackEvent.Result == entities.Success {
-						// move player to graveyard
-						deadPlayers[player.MartialOrder].Add(bst.Float64(playerInitiative), player)
-						livingPlayers.Delete(bst.Float64(playerInitiative))
-
-						// make sure aggro and medic counts are correct
-						if player.Class != "monsterknight" {
-							totalAggros["standard"][player.MartialOrder] -= playerStats.Aggro
-							totalAggros["horsearcher"][player.MartialOrder]--
-						}
-						totalAggros["ranged"][player.MartialOrder] -= playerStats.Aggro
-						if player.Class == "medic" || player.Class == "healer" {
-							medicPowers[player.MartialOrder] -= playerStats.Potency
-						}
+					if counterAttackEvent.Result != entities.Success {
+						combatEvents = append(combatEvents, counterAttackEvent)
+					} else {
+						// the attacker can't keep attacking from the grave
+						i = numAttacks
+						// move player to graveyard or off the field
+						counterAttackEvent.Result = ns.defeatPlayer(player, playerInitiative, livingPlayers, deadPlayers, routedPlayers, strength)
+						combatEvents = append(combatEvents, counterAttackEvent)
+
+						// make sure medic counts are correct
+						medicPowers[player.MartialOrder] -= supportPower(player)
 					}
 				}
 			}
 		}
 	}
 
-	// serialize the dead players
-	fatalities := make(map[string][]*entities.Player)
-	for order, dead := range deadPlayers {
-		fatalities[order] = make([]*entities.Player, 0, dead.Len())
-		for iter := dead.Iterator(); iter.Next(); {
-			player := iter.Value().(*entities.Player)
-			ns.logger.Debugf("DEADER: %+v", *player)
-			fatalities[order] = append(fatalities[order], player)
-		}
-	}
+	combatEvents = ns.breakRanks(livingPlayers, routedPlayers, strength, combatEvents)
 
-	// serialize the living players
-	survivors := make(map[string][]*entities.Player)
+	return ns.serializeBattle(livingPlayers, deadPlayers, routedPlayers, combatEvents), nil
+}
+
+// selectTarget picks a random enemy weighted by how likely the player is to
+// single them out
+func (ns *NormalSimulator) selectTarget(player *entities.Player, livingPlayers *bst.Map) (*entities.Player, bst.Float64) {
+	totalWeight := 0
 	for iter := livingPlayers.Iterator(); iter.Next(); {
-		player := iter.Value().(*entities.Player)
-		survivors[player.MartialOrder] = append(survivors[player.MartialOrder], player)
+		totalWeight += targetWeight(player, iter.Value().(*entities.Player))
+	}
+	if totalWeight <= 0 {
+		return nil, 0
 	}
 
-	return survivors, fatalities, combatEvents, nil
-}
-
-func (ns *NormalSimulator) selectTarget(player *entities.Player, livingPlayers *bst.Map, totalEnemyAggro int) (*entities.Player, bst.Float64) {
-	aggroLeft := rand.Intn(totalEnemyAggro)
-	var target *entities.Player
-	var targetInitiative bst.Float64
+	weightLeft := rand.Intn(totalWeight)
 	for iter := livingPlayers.Iterator(); iter.Next(); {
-		target = iter.Value().(*entities.Player)
-		targetInitiative = iter.Key().(bst.Float64)
-		if target.MartialOrder == player.MartialOrder || (target.Class == "monsterknight" && !player.IsRanged()) {
-			continue
+		target := iter.Value().(*entities.Player)
+		weightLeft -= targetWeight(player, target)
+		if weightLeft < 0 {
+			return target, iter.Key().(bst.Float64)
 		}
+	}
+	return nil, 0
+}
 
-		if player.Class == "horsearcher" {
-			aggroLeft--
-		} else {
-			aggroLeft -= target.GetStats().Aggro
-		}
-		if aggroLeft <= 0 {
-			break
-		}
+// targetWeight is how likely an attacker is to single out a target, from the
+// target's aggro and stance and the attacker's target priority. targets with no
+// weight can't be attacked at all
+func targetWeight(attacker *entities.Player, target *entities.Player) int {
+	if target.MartialOrder == attacker.MartialOrder || (target.Class == "monsterknight" && !attacker.IsRanged()) {
+		return 0
+	}
+
+	// horse archers ignore aggro
+	weight := 1.
+	if attacker.Class != "horsearcher" {
+		weight = float64(target.GetStats().Aggro) * target.GetStanceModifiers().AggroFactor
+	}
+	if attacker.TargetPriority.Valid && attacker.TargetPriority.String == target.Family() {
+		weight *= targetPriorityFactor
 	}
-	return target, targetInitiative
+
+	if weight < 1 {
+		return 1
+	}
+	return int(weight)
 }
 
-func (ns *NormalSimulator) calculateTotalAggros(attackOrder *bst.Map) (map[string]map[string]int, map[string]int) {
-	totalAggros := map[string]map[string]int{
-		"standard": {
-			"Staghorn Sect": 0,
-			"Order Gorgona": 0,
-			"The Baaturate": 0,
-		},
-		"ranged": {
-			"Staghorn Sect": 0,
-			"Order Gorgona": 0,
-			"The Baaturate": 0,
-		},
-		"horsearcher": {
-			"Staghorn Sect": 0,
-			"Order Gorgona": 0,
-			"The Baaturate": 0,
-		},
+// supportPower is how much a player bolsters the defense of their allies
+func supportPower(player *entities.Player) int {
+	power := player.GetStanceModifiers().Support
+	if player.Class == "medic" || player.Class == "healer" {
+		power += player.GetStats().Potency
 	}
+	return power
+}
+
+func (ns *NormalSimulator) calculateMedicPowers(attackOrder *bst.Map) map[string]int {
 	medicPowers := map[string]int{
 		"Staghorn Sect": 0,
 		"Order Gorgona": 0,
@@ -404,48 +951,9 @@ func (ns *NormalSimulator) calculateTotalAggros(attackOrder *bst.Map) (map[strin
 
 	for iter := attackOrder.Iterator(); iter.Next(); {
 		player := iter.Value().(*entities.Player)
-		stats := player.GetStats()
-		ns.logger.Debugf("PLAYER: %s %s", player.MartialOrder, player.TwitterID)
-
-		// calculate total aggros
-		if player.Class != "monsterknight" {
-			totalAggros["standard"][player.MartialOrder] += stats.Aggro
-			totalAggros["horsearcher"][player.MartialOrder]++
-		}
-		totalAggros["ranged"][player.MartialOrder] += stats.Aggro
-
-		// calculate medic totals
-		if player.Class == "medic" || player.Class == "healer" {
-			medicPowers[player.MartialOrder] += stats.Potency
-		}
+		medicPowers[player.MartialOrder] += supportPower(player)
 	}
-	ns.logger.Debugf("aggros: %d %d %d", totalAggros["standard"]["The Baaturate"], totalAggros["standard"]["Staghorn Sect"], totalAggros["standard"]["Order Gorgona"])
-	return totalAggros, medicPowers
-}
-
-func (ns *NormalSimulator) calculateEnemyAggro(player *entities.Player, totalAggros map[string]map[string]int) int {
-	enemyAggro := 0
-	if player.IsRanged() {
-		for order, aggro := range totalAggros["ranged"] {
-			if order != player.MartialOrder {
-				enemyAggro += aggro
-			}
-		}
-		return enemyAggro
-	} else if player.Class == "horsearcher" {
-		for order, aggro := range totalAggros["horsearcher"] {
-			if order != player.MartialOrder {
-				enemyAggro += aggro
-			}
-		}
-		return enemyAggro
-	}
-	for order, aggro := range totalAggros["standard"] {
-		if order != player.MartialOrder {
-			enemyAggro += aggro
-		}
-	}
-	return enemyAggro
+	return medicPowers
 }
 
 // reviveTarget attempts to return an allied player from the dead and back to the battle
@@ -461,24 +969,24 @@ func (ns *NormalSimulator) reviveTarget(player *entities.Player, deadPlayers map
 		if rand.Intn(2) > 0 {
 			attackOrder.Add(initiative, target)
 			myDead.Delete(initiative)
-			return entities.CombatEvent{target, player, entities.Revive, entities.Success}
+			return entities.CombatEvent{Attacker: player, Defender: target, EventType: entities.Revive, Result: entities.Success}
 		}
-		return entities.CombatEvent{target, player, entities.Revive, entities.Failure}
+		return entities.CombatEvent{Attacker: player, Defender: target, EventType: entities.Revive, Result: entities.Failure}
 	}
-	return entities.CombatEvent{nil, player, entities.Revive, entities.NoTarget}
+	return entities.CombatEvent{Attacker: player, EventType: entities.Revive, Result: entities.NoTarget}
 }
 
 func (ns *NormalSimulator) calculateAttackOrder(players map[string][]entities.Player) *bst.Map {
 	attackOrder := bst.NewMap(len(players))
 	for order := range players {
-		for _, player := range players[order] {
+		for i := range players[order] {
+			player := &players[order][i]
 			playerStats := player.GetStats()
-			ns.logger.Debugf("PLAYER CALC: %s", player.TwitterID)
 			for {
 				// initiative is negated, since the map sorts in ascending order
 				initiative := bst.Float64(-(rand.NormFloat64()*speedStdDev + float64(playerStats.Speed)))
 				if !attackOrder.Exists(initiative) {
-					attackOrder.Add(initiative, &player)
+					attackOrder.Add(initiative, player)
 					break
 				}
 			}
@@ -506,25 +1014,24 @@ func (ns *NormalSimulator) attackTarget(attacker *entities.Player, defender *ent
 	defenderIsSpear := defender.Class == "spear" || defender.Class == "glaivemaster"
 
 	// calculate outcome
-	attackPower := attackerStats.Potency
-	defensePower := defenderStats.Defense
+	attackPower := attackerStats.Potency + attacker.GetStanceModifiers().Attack
+	defensePower := defenderStats.Defense + defender.GetStanceModifiers().Defense
 
-	// medic bonus
-	// TODO DESIGN: determine scaling of medic bonus
-	defense := float64(defensePower) + float64(medicBonus)/1000.*10
 	// spear bonus
 	if attackerIsCavalry && defenderIsSpear {
 		defensePower += spearDefenseBonus
 	} else if attackerIsSpear && defenderIsCavalry {
 		attackPower += spearAttackBonus
 	}
+	// medic bonus
+	// TODO DESIGN: determine scaling of medic bonus
+	defense := float64(defensePower) + float64(medicBonus)/1000.*10
 	// TODO DESIGN: implement defender's bonus
 
 	attack := rand.NormFloat64()*attackStdDev + float64(attackPower)
 
-	fmt.Printf("%f, %f", attack, defense)
 	if attack > defense {
-		return entities.CombatEvent{attacker, defender, entities.Attack, entities.Success}
+		return entities.CombatEvent{Attacker: attacker, Defender: defender, EventType: entities.Attack, Result: entities.Success}
 	}
-	return entities.CombatEvent{attacker, defender, entities.Attack, entities.Failure}
+	return entities.CombatEvent{Attacker: attacker, Defender: defender, EventType: entities.Attack, Result: entities.Failure}
 }
@@ -1,13 +1,21 @@
 package simulation
 
 import (
+	"context"
+	"database/sql"
+	"io/ioutil"
 	"math/rand"
 	"os"
+	"reflect"
 	"testing"
 	"time"
 
 	"github.com/bsm/bst"
+	"github.com/sirupsen/logrus"
+	"github.com/yisaj/heavens_throne/atlas"
+	"github.com/yisaj/heavens_throne/database"
 	"github.com/yisaj/heavens_throne/entities"
+	"github.com/yisaj/heavens_throne/events"
 )
 
 func TestMain(m *testing.M) {
@@ -16,6 +24,12 @@ func TestMain(m *testing.M) {
 	os.Exit(code)
 }
 
+func newTestLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(ioutil.Discard)
+	return logger
+}
+
 func initializePlayers() map[string][]entities.Player {
 	players := make(map[string][]entities.Player)
 
@@ -51,14 +65,14 @@ func initializePlayers() map[string][]entities.Player {
 
 func TestCalculateAttackOrder(t *testing.T) {
 	players := initializePlayers()
-	sim := NewNormalSimulator(nil, nil, nil)
+	sim := NewNormalSimulator(newTestLogger(), nil, nil, TempleRules{}, nil)
 	attackOrder := sim.calculateAttackOrder(players)
 
 	for it := attackOrder.Iterator(); it.Next(); {
 		found := false
 		orderedPlayer := it.Value().(*entities.Player)
-		for _, player := range players[orderedPlayer.MartialOrder] {
-			if &player == orderedPlayer {
+		for i := range players[orderedPlayer.MartialOrder] {
+			if &players[orderedPlayer.MartialOrder][i] == orderedPlayer {
 				found = true
 				break
 			}
@@ -89,26 +103,576 @@ func TestAttackTarget(t *testing.T) {
 		MartialOrder: "The Baaturate",
 	}
 
-	sim := NewNormalSimulator(nil, nil, nil)
+	sim := NewNormalSimulator(newTestLogger(), nil, nil, TempleRules{}, nil)
 	event := sim.attackTarget(&attacker, &defender, 0)
 	t.Logf("%+v\n", event)
 }
 
 func TestBattleSimulation(t *testing.T) {
 	players := initializePlayers()
-	simulator := NewNormalSimulator(nil, nil, nil)
-	survivors, fatalities, combatEvents, err := simulator.SimulateBattle(0, players)
+	simulator := NewNormalSimulator(newTestLogger(), nil, nil, TempleRules{}, nil)
+	result, err := simulator.SimulateBattle(0, players)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
 
-	for _, survivor := range survivors {
+	for _, survivor := range result.Survivors {
 		t.Logf("%+v\n", survivor)
 	}
-	for _, fatality := range fatalities {
+	for _, fatality := range result.Fatalities {
 		t.Logf("%+v\n", fatality)
 	}
-	for _, event := range combatEvents {
+	for _, routed := range result.Routs {
+		t.Logf("%+v\n", routed)
+	}
+	for _, event := range result.CombatEvents {
 		t.Logf("%+v\n", event)
 	}
 }
+
+func TestBattleExperience(t *testing.T) {
+	victor := entities.Player{ID: 0, Class: "sword", Rank: 1, MartialOrder: "Order Gorgona"}
+	loser := entities.Player{ID: 1, Class: "archer", Rank: 1, MartialOrder: "The Baaturate"}
+	fallen := entities.Player{ID: 2, Class: "spear", Rank: 1, MartialOrder: "The Baaturate"}
+
+	result := &BattleResult{
+		Survivors: map[string][]*entities.Player{
+			"Order Gorgona": {&victor},
+			"The Baaturate": {&loser},
+		},
+		Fatalities: map[string][]*entities.Player{
+			"The Baaturate": {&fallen},
+		},
+	}
+
+	simulator := NewNormalSimulator(newTestLogger(), nil, nil, TempleRules{}, nil)
+	gains := simulator.giveBattleExperience(result, "Order Gorgona")
+
+	sources := make(map[int32][]entities.ExperienceSource)
+	for _, gain := range gains {
+		if gain.Amount < 0 {
+			t.Errorf("negative experience gain: %+v", gain)
+		}
+		sources[gain.PlayerID] = append(sources[gain.PlayerID], gain.Source)
+	}
+
+	expected := map[int32][]entities.ExperienceSource{
+		victor.ID: {entities.BattleExperience, entities.SurvivalExperience, entities.VictoryExperience},
+		loser.ID:  {entities.BattleExperience, entities.SurvivalExperience},
+		fallen.ID: {entities.BattleExperience, entities.DeathExperience},
+	}
+	for id, want := range expected {
+		got := sources[id]
+		if len(got) != len(want) {
+			t.Errorf("player %d: expected sources %v, got %v", id, want, got)
+			continue
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("player %d: expected sources %v, got %v", id, want, got)
+				break
+			}
+		}
+	}
+}
+
+func TestCombatExperience(t *testing.T) {
+	attacker := entities.Player{ID: 0, Class: "sword", Rank: 1, MartialOrder: "Order Gorgona"}
+	defender := entities.Player{ID: 1, Class: "spear", Rank: 1, MartialOrder: "The Baaturate"}
+
+	simulator := NewNormalSimulator(newTestLogger(), nil, nil, TempleRules{}, nil)
+
+	gains := simulator.giveCombatExperience(&entities.CombatEvent{
+		Attacker:  &attacker,
+		Defender:  &defender,
+		EventType: entities.Attack,
+		Result:    entities.Success,
+	})
+	if len(gains) != 1 || gains[0].PlayerID != attacker.ID || gains[0].Source != entities.KillExperience ||
+		gains[0].TargetClass != "spear" {
+		t.Errorf("expected a kill experience gain for the attacker, got %+v", gains)
+	}
+
+	gains = simulator.giveCombatExperience(&entities.CombatEvent{
+		Attacker:  &attacker,
+		Defender:  &defender,
+		EventType: entities.Attack,
+		Result:    entities.Failure,
+	})
+	if len(gains) != 0 {
+		t.Errorf("expected no experience for a failed attack, got %+v", gains)
+	}
+}
+
+func TestBreakRanks(t *testing.T) {
+	victors := []entities.Player{
+		{ID: 0, Class: "sword", Rank: 1, MartialOrder: "Order Gorgona"},
+		{ID: 1, Class: "sword", Rank: 1, MartialOrder: "Order Gorgona"},
+	}
+	beaten := entities.Player{ID: 2, Class: "lightcavalry", Rank: 1, MartialOrder: "The Baaturate"}
+
+	livingPlayers := bst.NewMap(3)
+	livingPlayers.Add(bst.Float64(-3), &victors[0])
+	livingPlayers.Add(bst.Float64(-2), &victors[1])
+	livingPlayers.Add(bst.Float64(-1), &beaten)
+
+	simulator := NewNormalSimulator(newTestLogger(), nil, nil, TempleRules{}, nil)
+
+	// a beaten army at full strength holds its nerve
+	routedPlayers := newGraveyard()
+	strength := simulator.countStrength(livingPlayers)
+	events := simulator.breakRanks(livingPlayers, routedPlayers, strength, nil)
+	if len(events) != 0 || livingPlayers.Len() != 3 {
+		t.Errorf("expected nobody to rout, got %+v", events)
+	}
+
+	// the victors never rout, no matter how battered
+	for i := 0; i < 100; i++ {
+		simulator.breakRanks(livingPlayers, routedPlayers, map[string]int{"Order Gorgona": 100, "The Baaturate": 1}, nil)
+	}
+	if routedPlayers["Order Gorgona"].Len() != 0 {
+		t.Errorf("expected the victors to hold the field")
+	}
+
+	// a shattered army eventually breaks
+	strength["The Baaturate"] = 100
+	for i := 0; i < 100 && livingPlayers.Len() == 3; i++ {
+		events = simulator.breakRanks(livingPlayers, routedPlayers, strength, nil)
+	}
+	if routedPlayers["The Baaturate"].Len() != 1 || len(events) != 1 || events[0].EventType != entities.Rout ||
+		events[0].Result != entities.Routed || events[0].Attacker != &beaten {
+		t.Errorf("expected the beaten cavalry to rout, got %+v", events)
+	}
+}
+
+func TestTargetWeight(t *testing.T) {
+	archer := entities.Player{Class: "archer", Rank: 1, MartialOrder: "Order Gorgona"}
+	sword := entities.Player{Class: "sword", Rank: 1, MartialOrder: "Order Gorgona"}
+	spear := entities.Player{Class: "spear", Rank: 1, MartialOrder: "The Baaturate", Stance: "aggressive"}
+	knight := entities.Player{Class: "monsterknight", Rank: 1, MartialOrder: "The Baaturate"}
+
+	if weight := targetWeight(&sword, &archer); weight != 0 {
+		t.Errorf("expected allies to have no weight, got %d", weight)
+	}
+	if weight := targetWeight(&sword, &knight); weight != 0 {
+		t.Errorf("expected monster knights to be out of melee reach, got %d", weight)
+	}
+	if weight := targetWeight(&archer, &knight); weight <= 0 {
+		t.Errorf("expected monster knights to be in ranged reach, got %d", weight)
+	}
+
+	aggressive := targetWeight(&sword, &spear)
+	spear.Stance = "support"
+	if supporting := targetWeight(&sword, &spear); supporting >= aggressive {
+		t.Errorf("expected a supporting stance to draw less aggro: %d >= %d", supporting, aggressive)
+	}
+
+	unfocused := targetWeight(&sword, &spear)
+	sword.TargetPriority = sql.NullString{String: "infantry", Valid: true}
+	if focused := targetWeight(&sword, &spear); focused <= unfocused {
+		t.Errorf("expected a target priority to weigh more: %d <= %d", focused, unfocused)
+	}
+}
+
+func TestSteadfastHolds(t *testing.T) {
+	holder := entities.Player{ID: 0, Class: "lightcavalry", Rank: 1, MartialOrder: "The Baaturate", Stance: "hold"}
+	livingPlayers := bst.NewMap(1)
+	livingPlayers.Add(bst.Float64(-1), &holder)
+
+	simulator := NewNormalSimulator(newTestLogger(), nil, nil, TempleRules{}, nil)
+	strength := map[string]int{"The Baaturate": 10}
+	for i := 0; i < 100; i++ {
+		if simulator.breaks(&holder, livingPlayers, strength) {
+			t.Fatal("expected a player holding their ground to never break")
+		}
+	}
+}
+
+func TestNextRoute(t *testing.T) {
+	// 0 - 1 - 2 - 3, with a long way round from 4
+	graph := atlas.Graph{0: {1}, 1: {0, 2, 4}, 2: {1, 3}, 3: {2}, 4: {1}}
+	at := func(location int32) sql.NullInt32 {
+		return sql.NullInt32{Int32: location, Valid: true}
+	}
+
+	tests := []struct {
+		name    string
+		march   entities.March
+		battles map[int32]bool
+		route   []int32
+	}{
+		{"step taken", entities.March{Location: at(1), Route: []int32{1, 2, 3}}, nil, []int32{2, 3}},
+		{"arrived", entities.March{Location: at(3), Route: []int32{3}}, nil, nil},
+		{"caught in battle", entities.March{Location: at(1), Route: []int32{1, 2, 3}}, map[int32]bool{1: true}, nil},
+		{"dead", entities.March{Route: []int32{1, 2, 3}}, nil, nil},
+		{"knocked off course", entities.March{Location: at(4), Route: []int32{1, 2, 3}}, nil, []int32{1, 2, 3}},
+		{"routed onto the path", entities.March{Location: at(0), Route: []int32{2, 3}}, nil, []int32{1, 2, 3}},
+	}
+
+	for _, test := range tests {
+		route := nextRoute(graph, &test.march, test.battles)
+		if !reflect.DeepEqual(route, test.route) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.route, route)
+		}
+	}
+}
+
+func TestSimulatePanic(t *testing.T) {
+	var finished *events.SimulationFinished
+	dispatcher := events.NewDispatcher(newTestLogger())
+	dispatcher.Subscribe(events.SubscriberFunc(func(ctx context.Context, event events.Event) error {
+		if event, ok := event.(events.SimulationFinished); ok {
+			finished = &event
+		}
+		return nil
+	}))
+	lock := &SimLock{}
+	// without a database the first query panics
+	simulator := NewNormalSimulator(newTestLogger(), nil, lock, TempleRules{}, dispatcher)
+
+	err := simulator.Simulate()
+	if err == nil {
+		t.Fatal("expected the panic to come back as an error")
+	}
+	if finished == nil || finished.Err == nil {
+		t.Errorf("expected the failed day to be published, got %v", finished)
+	}
+	if lock.Check() {
+		t.Error("expected the lock to be released")
+	}
+	lock.RUnlock()
+}
+
+// dayResource keeps a small game in memory, enough to simulate whole days on.
+// elections are never held and routed players are always cut down
+type dayResource struct {
+	database.Resource
+	day        int32
+	players    []entities.Player
+	locations  []entities.Location
+	temples    map[string]int32
+	graph      atlas.Graph
+	gains      []entities.ExperienceGain
+	revivals   map[string]int32
+	eliminated []string
+}
+
+func (r *dayResource) location(id int32) *entities.Location {
+	for i := range r.locations {
+		if r.locations[i].ID == id {
+			return &r.locations[i]
+		}
+	}
+	return nil
+}
+
+func (r *dayResource) IncrementDay(ctx context.Context) error {
+	r.day++
+	return nil
+}
+
+func (r *dayResource) GetDay(ctx context.Context) (int32, error) {
+	return r.day, nil
+}
+
+func (r *dayResource) GetDayReturns(ctx context.Context, day int32) ([]entities.ReturnRecord, error) {
+	return nil, nil
+}
+
+func (r *dayResource) GetCommander(ctx context.Context, order string) (*entities.Commander, error) {
+	return &entities.Commander{MartialOrder: order, Player: sql.NullInt32{Int32: 1, Valid: true}}, nil
+}
+
+func (r *dayResource) GetTemples(ctx context.Context) ([]entities.Temple, error) {
+	var temples []entities.Temple
+	for order, id := range r.temples {
+		location := r.location(id)
+		temples = append(temples, entities.Temple{MartialOrder: order, Location: id, LocationName: location.Name, Owner: location.Owner})
+	}
+	return temples, nil
+}
+
+func (r *dayResource) MovePlayers(ctx context.Context) error {
+	for i := range r.players {
+		if r.players[i].Location.Valid && r.players[i].NextLocation.Valid {
+			r.players[i].Location = r.players[i].NextLocation
+		}
+	}
+	return nil
+}
+
+func (r *dayResource) GetAlivePlayers(ctx context.Context) ([]entities.Player, error) {
+	var players []entities.Player
+	for _, player := range r.players {
+		if player.Location.Valid {
+			players = append(players, player)
+		}
+	}
+	return players, nil
+}
+
+func (r *dayResource) GetLocation(ctx context.Context, locationID int32) (*entities.Location, error) {
+	location := *r.location(locationID)
+	return &location, nil
+}
+
+func (r *dayResource) GetLocations(ctx context.Context) ([]entities.Location, error) {
+	return append([]entities.Location(nil), r.locations...), nil
+}
+
+func (r *dayResource) GetMapGraph(ctx context.Context) (atlas.Graph, error) {
+	return r.graph, nil
+}
+
+func (r *dayResource) SetLocationOwner(ctx context.Context, locationID int32, owner string) error {
+	r.location(locationID).Owner = sql.NullString{String: owner, Valid: true}
+	return nil
+}
+
+func (r *dayResource) SetLocationOccupier(ctx context.Context, locationID int32, occupier string) error {
+	r.location(locationID).Occupier = sql.NullString{String: occupier, Valid: occupier != ""}
+	return nil
+}
+
+func (r *dayResource) KillPlayer(ctx context.Context, twitterID string) error {
+	for i := range r.players {
+		if r.players[i].TwitterID == twitterID {
+			r.players[i].Location = sql.NullInt32{}
+		}
+	}
+	return nil
+}
+
+func (r *dayResource) GetRetreatLocations(ctx context.Context, locationID int32, order string) ([]int32, error) {
+	return nil, nil
+}
+
+func (r *dayResource) AwardExperience(ctx context.Context, gains []entities.ExperienceGain) error {
+	r.gains = append(r.gains, gains...)
+	return nil
+}
+
+func (r *dayResource) GetMarches(ctx context.Context) ([]entities.March, error) {
+	return nil, nil
+}
+
+func (r *dayResource) revive(order string, locationID int32) {
+	for i := range r.players {
+		if !r.players[i].Location.Valid && r.players[i].MartialOrder == order {
+			r.players[i].Location = sql.NullInt32{Int32: locationID, Valid: true}
+			r.players[i].NextLocation = r.players[i].Location
+		}
+	}
+}
+
+func (r *dayResource) RevivePlayers(ctx context.Context) error {
+	for order, id := range r.temples {
+		if owner := r.location(id).Owner; owner.Valid && owner.String == order {
+			r.revive(order, id)
+		}
+	}
+	return nil
+}
+
+func (r *dayResource) ReviveOrderPlayers(ctx context.Context, order string, locationID int32, deadSince int32) error {
+	r.revivals[order] = locationID
+	r.revive(order, locationID)
+	return nil
+}
+
+// EliminateDefeatedOrders knocks out orders the way the database does
+func (r *dayResource) EliminateDefeatedOrders(ctx context.Context) ([]string, error) {
+	var eliminated []string
+	for order, id := range r.temples {
+		standing := r.location(id).Owner.String == order
+		for _, player := range r.players {
+			standing = standing || (player.MartialOrder == order && player.Location.Valid)
+		}
+		for _, location := range r.locations {
+			standing = standing || location.Owner.String == order
+		}
+		for _, out := range r.eliminated {
+			standing = standing || out == order
+		}
+		if !standing {
+			eliminated = append(eliminated, order)
+		}
+	}
+	r.eliminated = append(r.eliminated, eliminated...)
+	return eliminated, nil
+}
+
+func (r *dayResource) IsOrderEliminated(ctx context.Context, order string) (bool, error) {
+	for _, out := range r.eliminated {
+		if out == order {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestCaptureExperience(t *testing.T) {
+	at := func(location int32) sql.NullInt32 {
+		return sql.NullInt32{Int32: location, Valid: true}
+	}
+	held := func(order string) sql.NullString {
+		return sql.NullString{String: order, Valid: true}
+	}
+	resource := &dayResource{
+		players: []entities.Player{
+			{ID: 1, TwitterID: "1", MartialOrder: "Order Gorgona", Class: "sword", Rank: 1, Location: at(1), NextLocation: at(1)},
+			{ID: 2, TwitterID: "2", MartialOrder: "Order Gorgona", Class: "sword", Rank: 1, Location: at(1), NextLocation: at(1)},
+			{ID: 3, TwitterID: "3", MartialOrder: "Staghorn Sect", Class: "spear", Rank: 1, Location: at(1), NextLocation: at(1)},
+			// nobody stands in the way of taking the second
+			{ID: 4, TwitterID: "4", MartialOrder: "Order Gorgona", Class: "sword", Rank: 1, Location: at(2), NextLocation: at(2)},
+		},
+		locations: []entities.Location{
+			{ID: 1, Name: "Vessel", Owner: held("Staghorn Sect"), Occupier: held("Order Gorgona")},
+			{ID: 2, Name: "Aral", Owner: held("Staghorn Sect"), Occupier: held("Order Gorgona")},
+			{ID: 3, Name: "Reach", Owner: held("Staghorn Sect"), Occupier: held("Staghorn Sect")},
+		},
+		temples: map[string]int32{"Staghorn Sect": 3},
+	}
+	simulator := NewNormalSimulator(newTestLogger(), resource, &SimLock{}, TempleRules{}, events.NewDispatcher(newTestLogger()))
+
+	// the first takes one of gorgona's two swords with it
+	err := simulator.simulate(func(location int32, players map[string][]entities.Player) (*BattleResult, error) {
+		return &BattleResult{
+			Survivors:  map[string][]*entities.Player{"Order Gorgona": {&players["Order Gorgona"][0]}},
+			Fatalities: map[string][]*entities.Player{"Order Gorgona": {&players["Order Gorgona"][1]}, "Staghorn Sect": {&players["Staghorn Sect"][0]}},
+		}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	captured := make(map[int32]bool)
+	for _, gain := range resource.gains {
+		if gain.Source == entities.CaptureExperience {
+			captured[gain.PlayerID] = true
+		}
+	}
+	if !reflect.DeepEqual(captured, map[int32]bool{1: true, 4: true}) {
+		t.Errorf("expected only the standing capturers to gain experience, got %v", captured)
+	}
+	for _, id := range []int32{1, 2} {
+		if owner := resource.location(id).Owner.String; owner != "Order Gorgona" {
+			t.Errorf("expected gorgona to capture %d, got %s", id, owner)
+		}
+	}
+}
+
+func TestTempleChanges(t *testing.T) {
+	held := func(order string) sql.NullString {
+		return sql.NullString{String: order, Valid: true}
+	}
+	// gorgona has just lost its temple, and staghorn has just taken its own back
+	resource := &dayResource{
+		locations: []entities.Location{
+			{ID: 1, Name: "Vessel", Owner: held("Staghorn Sect")},
+			{ID: 2, Name: "Aral", Owner: held("Staghorn Sect")},
+		},
+		temples: map[string]int32{"Order Gorgona": 1, "Staghorn Sect": 2},
+	}
+	before := []entities.Temple{
+		{MartialOrder: "Order Gorgona", Location: 1, Owner: held("Order Gorgona")},
+		{MartialOrder: "Staghorn Sect", Location: 2, Owner: held("Order Gorgona")},
+	}
+
+	changes := make(map[string]entities.TempleEvent)
+	dispatcher := events.NewDispatcher(newTestLogger())
+	dispatcher.Subscribe(events.SubscriberFunc(func(ctx context.Context, event events.Event) error {
+		if changed, ok := event.(events.TempleChanged); ok {
+			changes[changed.Temple.MartialOrder] = changed.Change
+		}
+		return nil
+	}))
+	simulator := NewNormalSimulator(newTestLogger(), resource, &SimLock{}, TempleRules{}, dispatcher)
+
+	after, err := simulator.templeChanges(3, before)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]entities.TempleEvent{"Order Gorgona": entities.TempleCaptured, "Staghorn Sect": entities.TempleReclaimed}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("expected %v, got %v", expected, changes)
+	}
+	if len(after) != 2 {
+		t.Errorf("expected the temples as they are now, got %v", after)
+	}
+
+	// nothing changes the second time around
+	changes = make(map[string]entities.TempleEvent)
+	_, err = simulator.templeChanges(3, after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+}
+
+func TestFallbackRespawnAndElimination(t *testing.T) {
+	at := func(location int32) sql.NullInt32 {
+		return sql.NullInt32{Int32: location, Valid: true}
+	}
+	held := func(order string) sql.NullString {
+		return sql.NullString{String: order, Valid: true}
+	}
+	// staghorn holds both other temples. gorgona still holds land, the baaturate
+	// holds nothing
+	resource := &dayResource{
+		players: []entities.Player{
+			{ID: 1, TwitterID: "1", MartialOrder: "Order Gorgona", Class: "sword", Rank: 1},
+			{ID: 2, TwitterID: "2", MartialOrder: "The Baaturate", Class: "sword", Rank: 1},
+			{ID: 3, TwitterID: "3", MartialOrder: "Staghorn Sect", Class: "sword", Rank: 1, Location: at(5), NextLocation: at(5)},
+		},
+		locations: []entities.Location{
+			{ID: 1, Name: "Vessel", Owner: held("Staghorn Sect"), Occupier: held("Staghorn Sect")},
+			{ID: 2, Name: "Aral", Owner: held("Staghorn Sect"), Occupier: held("Staghorn Sect")},
+			{ID: 3, Name: "Reach", Owner: held("Order Gorgona"), Occupier: held("Order Gorgona")},
+			{ID: 4, Name: "Hollow", Owner: held("Order Gorgona"), Occupier: held("Order Gorgona")},
+			{ID: 5, Name: "Throne", Owner: held("Staghorn Sect"), Occupier: held("Staghorn Sect")},
+		},
+		temples:  map[string]int32{"Order Gorgona": 1, "The Baaturate": 2, "Staghorn Sect": 5},
+		graph:    atlas.Graph{1: {2}, 2: {1, 3}, 3: {2, 4}, 4: {3, 5}, 5: {4}},
+		revivals: make(map[string]int32),
+	}
+	rules := TempleRules{FallbackRespawnDays: 1, EliminateOrders: true}
+	simulator := NewNormalSimulator(newTestLogger(), resource, &SimLock{}, rules, events.NewDispatcher(newTestLogger()))
+
+	err := simulator.Simulate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// gorgona's dead come back at the nearest land it holds
+	if !reflect.DeepEqual(resource.revivals, map[string]int32{"Order Gorgona": 3}) {
+		t.Errorf("expected gorgona to fall back to the third location, got %v", resource.revivals)
+	}
+	if !reflect.DeepEqual(resource.eliminated, []string{"The Baaturate"}) {
+		t.Errorf("expected only the baaturate to be eliminated, got %v", resource.eliminated)
+	}
+
+	// once out, an order stays out even if it's left holding land
+	resource.location(4).Owner = held("The Baaturate")
+	resource.location(4).Occupier = held("The Baaturate")
+	err = simulator.Simulate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := resource.revivals["The Baaturate"]; ok || resource.players[1].Location.Valid {
+		t.Errorf("expected the eliminated baaturate to stay dead, got %v", resource.revivals)
+	}
+
+	// without the rules the dead wait on their temple, and nobody is knocked out
+	resource.eliminated = nil
+	resource.revivals = make(map[string]int32)
+	simulator = NewNormalSimulator(newTestLogger(), resource, &SimLock{}, TempleRules{}, events.NewDispatcher(newTestLogger()))
+	err = simulator.Simulate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resource.revivals) != 0 || len(resource.eliminated) != 0 {
+		t.Errorf("expected no fallback or elimination, got %v and %v", resource.revivals, resource.eliminated)
+	}
+}
@@ -1,24 +1,31 @@
 package simulation
 
 import (
-	"bufio"
 	"context"
 	"fmt"
-	"io"
+	"io/ioutil"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
-	"github.com/sirupsen/logrus"
+	"github.com/yisaj/heavens_throne/atlas"
 	"github.com/yisaj/heavens_throne/database"
 	"github.com/yisaj/heavens_throne/entities"
+	"github.com/yisaj/heavens_throne/events"
 	"github.com/yisaj/heavens_throne/twitspeak"
 )
 
 // StoryTeller contains the logic to generate combat/battle reports and send them
-// to the player
+// to the player. it listens to the simulator to learn what happened over the
+// day, keeping the story once the day is done so it can still be told after a
+// restart
 type StoryTeller interface {
+	events.Subscriber
 	Tell() error
 }
 
@@ -26,74 +33,175 @@ type StoryTeller interface {
 type canary struct {
 	speaker  twitspeak.TwitterSpeaker
 	resource database.Resource
+	// names the game in the map tweet, unless it's the main game
+	game  string
+	lock  sync.Mutex
+	story entities.DayStory
 }
 
-// NewStoryTeller constructs a new storyteller
-func NewStoryTeller(speaker twitspeak.TwitterSpeaker, resource database.Resource) StoryTeller {
+// NewStoryTeller constructs a new storyteller. a game other than the main one
+// is named in its map tweets, so they can be told apart from the main game's
+func NewStoryTeller(speaker twitspeak.TwitterSpeaker, resource database.Resource, game string) StoryTeller {
 	return &canary{
 		speaker,
 		resource,
+		game,
+		sync.Mutex{},
+		entities.DayStory{},
 	}
 }
 
+// Handle takes note of the events worth telling about, starting over each day
+// and keeping the story once the day has advanced
+func (c *canary) Handle(ctx context.Context, event events.Event) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	switch e := event.(type) {
+	case events.SimulationStarted:
+		c.story = entities.DayStory{}
+	case events.BattleResolved:
+		c.story.Battles = append(c.story.Battles, battleStory(&e))
+	case events.LocationCaptured:
+		c.story.Captures = append(c.story.Captures, entities.CaptureStory{
+			Location:     e.Location.ID,
+			MartialOrder: e.MartialOrder,
+		})
+	case events.PlayerRevived:
+		c.story.Returns = append(c.story.Returns, e.Return)
+	case events.DayAdvanced:
+		err := c.resource.SaveDayStory(ctx, e.Day, &c.story)
+		if err != nil {
+			return errors.Wrap(err, "failed keeping day story")
+		}
+	}
+	return nil
+}
+
+// battleStory sums up how each order fared in a battle
+func battleStory(battle *events.BattleResolved) entities.BattleStory {
+	orders := make([]string, 0, len(battle.Combatants))
+	for order, combatants := range battle.Combatants {
+		if len(combatants) > 0 {
+			orders = append(orders, order)
+		}
+	}
+	sort.Strings(orders)
+
+	story := entities.BattleStory{LocationName: battle.Location.Name, Victor: battle.Victor}
+	for _, order := range orders {
+		story.Orders = append(story.Orders, entities.BattleOrderStory{
+			MartialOrder: order,
+			Fought:       len(battle.Combatants[order]),
+			Fell:         len(battle.Fatalities[order]),
+			Fled:         len(battle.Routs[order]),
+		})
+	}
+	return story
+}
+
 func (c *canary) Tell() error {
 	day, err := c.resource.GetDay(context.TODO())
 	if err != nil {
 		return errors.Wrap(err, "failed telling story")
 	}
+	story, err := c.resource.GetDayStory(context.TODO(), day)
+	if err != nil {
+		return errors.Wrap(err, "failed telling story")
+	}
+	if story == nil {
+		return errors.Errorf("failed telling story: no story was kept of day %d", day)
+	}
+
 	// generate and send DMs to players
+	err = c.sendRoutReports(day)
+	if err != nil {
+		return errors.Wrap(err, "failed telling story")
+	}
 
-	// generate and post the map
-	err = c.generateMapSVG()
+	err = c.sendTempleReports(day)
 	if err != nil {
 		return errors.Wrap(err, "failed telling story")
 	}
 
-	err = c.rasterizeMapSVG()
+	err = c.sendEliminationReports(day)
 	if err != nil {
 		return errors.Wrap(err, "failed telling story")
 	}
 
-	imageID, err := c.speaker.UploadPNG("map.png")
+	err = c.sendReturnReports(day, story.Returns)
 	if err != nil {
 		return errors.Wrap(err, "failed telling story")
 	}
 
-	mapCaption, err := c.generateMapCaption(day)
+	err = c.sendExperienceReports(day)
 	if err != nil {
 		return errors.Wrap(err, "failed telling story")
 	}
-	tweetID, err := c.speaker.Tweet(mapCaption, "", imageID)
+
+	// generate and post the map. every telling draws in a directory of its
+	// own, since games can tell their days at the same time
+	mapDir, err := ioutil.TempDir("", "map")
 	if err != nil {
 		return errors.Wrap(err, "failed telling story")
 	}
+	defer os.RemoveAll(mapDir)
+	svgPath := filepath.Join(mapDir, "map.svg")
+	pngPath := filepath.Join(mapDir, "map.png")
 
-	// generate and post battle reports
-	battleLocations, err := c.resource.GetBattleLocations(context.TODO())
+	err = c.generateMapSVG(svgPath)
+	if err != nil {
+		return errors.Wrap(err, "failed telling story")
+	}
 
-	for _, battleLocation := range battleLocations {
-		// players before combat
-		// class breakdown
+	err = c.rasterizeMapSVG(svgPath, pngPath)
+	if err != nil {
+		return errors.Wrap(err, "failed telling story")
+	}
 
-		// players after combat
-		// class breakdown
+	imageID, err := c.speaker.UploadPNG(pngPath)
+	if err != nil {
+		return errors.Wrap(err, "failed telling story")
+	}
 
-		// results
-		// captured
-		// occupied
-		// stalemate
+	caption := generateMapCaption(day, story.Captures)
+	if c.game != "" {
+		caption = strings.ToUpper(c.game) + " " + caption
+	}
+	mapTweetID, err := c.speaker.Tweet(caption, "", imageID)
+	if err != nil {
+		return errors.Wrap(err, "failed telling story")
+	}
+
+	// post battle reports in a thread under the map
+	for i := range story.Battles {
+		_, err = c.speaker.Tweet(generateLocationReport(day, &story.Battles[i]), mapTweetID, "")
+		if err != nil {
+			return errors.Wrap(err, "failed telling story")
+		}
 	}
 	return nil
 }
 
-func (c *canary) generateMapCaption(day int32) (string, error) {
-	// TODO ENGINEER: the map tweet should also give the day, victories, temple captures, highlights, etc
-
-	// write the day header
+func generateMapCaption(day int32, captures []entities.CaptureStory) string {
+	// TODO ENGINEER: the map tweet should also give victories, temple captures, highlights, etc
+	var caption strings.Builder
+	caption.WriteString("DAY " + strconv.Itoa(int(day)))
 
-	// write the story
-
-	return "DAY " + strconv.Itoa(int(day)), nil
+	captured := make(map[string]int)
+	for _, capture := range captures {
+		captured[capture.MartialOrder]++
+	}
+	for _, order := range entities.MartialOrders {
+		switch captured[order] {
+		case 0:
+		case 1:
+			caption.WriteString(fmt.Sprintf("\n%s captured a location.", order))
+		default:
+			caption.WriteString(fmt.Sprintf("\n%s captured %d locations.", order, captured[order]))
+		}
+	}
+	return caption.String()
 }
 
 func (c *canary) SendNoReports(players []entities.Player) error {
@@ -116,6 +224,212 @@ func (c *canary) SendCombatReports(combatEvents []entities.CombatEvent) error {
 	return nil
 }
 
+// sendExperienceReports tells every player who wants updates what experience they
+// earned over the day
+func (c *canary) sendExperienceReports(day int32) error {
+	players, err := c.resource.GetAllPlayers(context.TODO())
+	if err != nil {
+		return errors.Wrap(err, "failed getting players for experience reports")
+	}
+	gains, err := c.resource.GetDayExperience(context.TODO(), day)
+	if err != nil {
+		return errors.Wrap(err, "failed getting experience for experience reports")
+	}
+
+	gainsByPlayer := make(map[int32][]entities.ExperienceGain)
+	for _, gain := range gains {
+		gainsByPlayer[gain.PlayerID] = append(gainsByPlayer[gain.PlayerID], gain)
+	}
+
+	// every order's orders go out with the report
+	commanders := make(map[string]*entities.Commander)
+	for _, order := range entities.MartialOrders {
+		commanders[order], err = c.resource.GetCommander(context.TODO(), order)
+		if err != nil {
+			return errors.Wrap(err, "failed getting commander for experience reports")
+		}
+	}
+
+	for _, player := range players {
+		playerGains := gainsByPlayer[player.ID]
+		if !player.Active || !player.ReceiveUpdates || len(playerGains) == 0 {
+			continue
+		}
+		report := generateExperienceReport(&player, playerGains) + generateCommanderReport(commanders[player.MartialOrder], day)
+		err = c.speaker.SendDM(player.TwitterID, report)
+		if err != nil {
+			return errors.Wrap(err, "failed to send experience report")
+		}
+	}
+	return nil
+}
+
+// sendRoutReports tells every routed player who wants updates where they fell back
+// to
+func (c *canary) sendRoutReports(day int32) error {
+	routs, err := c.resource.GetDayRouts(context.TODO(), day)
+	if err != nil {
+		return errors.Wrap(err, "failed getting routs for rout reports")
+	}
+
+	for _, rout := range routs {
+		player, err := c.resource.GetPlayer(context.TODO(), rout.TwitterID)
+		if err != nil {
+			return errors.Wrap(err, "failed getting player for rout report")
+		}
+		if player == nil || !player.ReceiveUpdates {
+			continue
+		}
+
+		err = c.speaker.SendDM(rout.TwitterID, generateRoutReport(&rout))
+		if err != nil {
+			return errors.Wrap(err, "failed to send rout report")
+		}
+	}
+	return nil
+}
+
+// sendTempleReports tells every order whose temple changed hands today. it
+// matters to the whole order, so everyone hears about it
+func (c *canary) sendTempleReports(day int32) error {
+	records, err := c.resource.GetDayTempleRecords(context.TODO(), day)
+	if err != nil {
+		return errors.Wrap(err, "failed getting temple records for temple reports")
+	}
+
+	for _, record := range records {
+		players, err := c.resource.GetOrderPlayers(context.TODO(), record.MartialOrder)
+		if err != nil {
+			return errors.Wrap(err, "failed getting players for temple report")
+		}
+
+		report := generateTempleReport(&record)
+		for _, player := range players {
+			err = c.speaker.SendDM(player.TwitterID, report)
+			if err != nil {
+				return errors.Wrap(err, "failed to send temple report")
+			}
+		}
+	}
+	return nil
+}
+
+// sendEliminationReports tells the players of every order knocked out of the
+// war today
+func (c *canary) sendEliminationReports(day int32) error {
+	orders, err := c.resource.GetDayEliminations(context.TODO(), day)
+	if err != nil {
+		return errors.Wrap(err, "failed getting eliminations for elimination reports")
+	}
+
+	for _, order := range orders {
+		players, err := c.resource.GetOrderPlayers(context.TODO(), order)
+		if err != nil {
+			return errors.Wrap(err, "failed getting players for elimination report")
+		}
+
+		for _, player := range players {
+			err = c.speaker.SendDM(player.TwitterID, generateEliminationReport(order))
+			if err != nil {
+				return errors.Wrap(err, "failed to send elimination report")
+			}
+		}
+	}
+	return nil
+}
+
+// sendReturnReports tells every player who came back after their temple was
+// retaken. they hear about it whether or not they want updates, since they've
+// been waiting on it
+func (c *canary) sendReturnReports(day int32, returns []entities.ReturnRecord) error {
+	for _, ret := range returns {
+		err := c.speaker.SendDM(ret.TwitterID, generateReturnReport(&ret, day))
+		if err != nil {
+			return errors.Wrap(err, "failed to send return report")
+		}
+	}
+	return nil
+}
+
+func generateRoutReport(rout *entities.RoutRecord) string {
+	routMsg := `
+Your line broke and you were routed from the field. You fell back to %s.
+`
+	return fmt.Sprintf(routMsg, rout.LocationName)
+}
+
+func generateTempleReport(record *entities.TempleRecord) string {
+	capturedMsg := `
+Your temple in %s has fallen to %s. The dead can't return there until it's retaken.
+`
+	lostMsg := `
+Your temple in %s has fallen. The dead can't return there until it's retaken.
+`
+	reclaimedMsg := `
+Your order has retaken its temple in %s. The dead will return there.
+`
+	if record.Event == entities.TempleReclaimed {
+		return fmt.Sprintf(reclaimedMsg, record.LocationName)
+	}
+	if record.Captor.Valid {
+		return fmt.Sprintf(capturedMsg, record.LocationName, record.Captor.String)
+	}
+	return fmt.Sprintf(lostMsg, record.LocationName)
+}
+
+func generateEliminationReport(order string) string {
+	eliminatedMsg := `
+%s has lost its temple and has no one left standing. Your order is out of the war.
+`
+	return fmt.Sprintf(eliminatedMsg, order)
+}
+
+func generateReturnReport(ret *entities.ReturnRecord, day int32) string {
+	returnMsg := `
+Your temple is back in your order's hands. After %s among the dead, you return to %s.
+`
+	fallbackMsg := `
+Your temple is still lost. After %s among the dead, you return to %s, the nearest ground your order holds.
+`
+	days := "a day"
+	if day-ret.DeathDay > 1 {
+		days = fmt.Sprintf("%d days", day-ret.DeathDay)
+	}
+	if !ret.AtTemple {
+		return fmt.Sprintf(fallbackMsg, days, ret.LocationName)
+	}
+	return fmt.Sprintf(returnMsg, days, ret.LocationName)
+}
+
+func generateExperienceReport(player *entities.Player, gains []entities.ExperienceGain) string {
+	var msg strings.Builder
+	var total int
+	for _, gain := range gains {
+		msg.WriteString(gain.Format())
+		msg.WriteByte('\n')
+		total += int(gain.Amount)
+	}
+	msg.WriteString(fmt.Sprintf("Total: %+d XP (%d XP now)\n", total, player.Experience))
+	if player.Experience >= 100 {
+		msg.WriteString("You have an !advance available\n")
+	}
+	return msg.String()
+}
+
+func generateCommanderReport(commander *entities.Commander, day int32) string {
+	if commander == nil {
+		return ""
+	}
+
+	var msg strings.Builder
+	msg.WriteByte('\n')
+	if commander.StartDay == day && commander.Player.Valid {
+		msg.WriteString(fmt.Sprintf("#%d was elected commander with %d votes.\n", commander.Player.Int32, commander.Votes))
+	}
+	msg.WriteString(commander.Format())
+	return msg.String()
+}
+
 func generateNoReport(player *entities.Player) string {
 	return "No fight"
 }
@@ -123,6 +437,9 @@ func generateNoReport(player *entities.Player) string {
 func generateCombatReport(combatEvent *entities.CombatEvent) string {
 	combatMsg := `
 Your %s was %s.	
+`
+	const routMsg = `
+Your line broke and you fled the battle.
 `
 	var typeStr string
 	var resultStr string
@@ -134,6 +451,8 @@ Your %s was %s.
 		typeStr = "Counter Attack"
 	case entities.Revive:
 		typeStr = "Revive"
+	case entities.Rout:
+		return routMsg
 	}
 
 	switch combatEvent.Result {
@@ -141,21 +460,32 @@ Your %s was %s.
 		resultStr = "Successful"
 	case entities.Failure:
 		resultStr = "Unsuccessful"
+	case entities.Routed:
+		resultStr = "Successful, and sent your foe fleeing"
 	}
 
 	return fmt.Sprintf(combatMsg, typeStr, resultStr)
 }
 
-func generateLocationReport(locationEvent *LocationEvent) string {
-	battleMsg := `
-location: %s, survivors: %d, fatalities: %d	
-`
+// generateLocationReport sums up a battle from how each order fared in it
+func generateLocationReport(day int32, battle *entities.BattleStory) string {
+	const headerMsg = "Battle of %s, day %d"
+	const orderMsg = "\n%s: %d fought, %d fell, %d fled"
+	const victorMsg = "\n%s holds the field."
 
-	return fmt.Sprintf(battleMsg, locationEvent.locationAfter.Name, len(locationEvent.survivors), len(locationEvent.fatalities))
+	var report strings.Builder
+	report.WriteString(fmt.Sprintf(headerMsg, battle.LocationName, day))
+	for _, order := range battle.Orders {
+		report.WriteString(fmt.Sprintf(orderMsg, order.MartialOrder, order.Fought, order.Fell, order.Fled))
+	}
+	if battle.Victor != "" {
+		report.WriteString(fmt.Sprintf(victorMsg, battle.Victor))
+	}
+	return report.String()
 }
 
-func (c *canary) rasterizeMapSVG() error {
-	command := exec.Command("inkscape", "map.svg", "-e", "map.png", "-w", "2000", "-h", "1930")
+func (c *canary) rasterizeMapSVG(svgPath string, pngPath string) error {
+	command := exec.Command("inkscape", svgPath, "-e", pngPath, "-w", "2000", "-h", "1930")
 	err := command.Run()
 	if err != nil {
 		return errors.Wrap(err, "failed running inkscape command")
@@ -164,74 +494,28 @@ func (c *canary) rasterizeMapSVG() error {
 	return nil
 }
 
-func (c *canary) generateMapSVG() error {
-	templateFile, err := os.Open("maptemplate.svg")
-	if err != nil {
-		return errors.Wrap(err, "failed opening map template file")
-	}
-	defer templateFile.Close()
-
-	mapFile, err := os.Create("map.svg")
+func (c *canary) generateMapSVG(svgPath string) error {
+	mapFile, err := os.Create(svgPath)
 	if err != nil {
 		return errors.Wrap(err, "failed opening map output file")
 	}
 	defer mapFile.Close()
 
-	reader := bufio.NewReader(templateFile)
-	for line, _, err := reader.ReadLine(); err == nil; line, _, err = reader.ReadLine() {
-		if len(line) > 0 && line[0] == '*' {
-			logrus.WithFields(logrus.Fields{}).Debug(line)
-			locationID, err := strconv.ParseInt(string(line[5:7]), 16, 32)
-
-			if err != nil {
-				return errors.Wrap(err, "failed converting location id")
-			}
-
-			location, err := c.resource.GetLocation(context.TODO(), int32(locationID))
-			if err != nil {
-				return errors.Wrap(err, "failed getting location for map generation")
-			}
-
-			mapFile.WriteString("url(#")
-
-			if location.Owner.Valid {
-				// write owner color
-				switch location.Owner.String {
-				case "Staghorn Sect":
-					mapFile.WriteString("orange")
-				case "Order Gorgona":
-					mapFile.WriteString("purple")
-				case "The Baaturate":
-					mapFile.WriteString("green")
-				}
-			} else {
-				// write gray
-				mapFile.WriteString("gray")
-			}
-
-			if location.Occupier.Valid {
-				// write dot color
-				switch location.Occupier.String {
-				case "Staghorn Sect":
-					mapFile.WriteString("dotorange)")
-				case "Order Gorgona":
-					mapFile.WriteString("dotpurple)")
-				case "The Baaturate":
-					mapFile.WriteString("dotgreen)")
-				}
-			} else {
-				mapFile.WriteString("dotgray)")
-			}
-
-			mapFile.WriteString(string(line[7:]))
-
-		} else {
-			mapFile.WriteString(string(line))
-		}
+	locations, err := c.resource.GetLocations(context.TODO())
+	if err != nil {
+		return errors.Wrap(err, "failed getting locations for map generation")
 	}
-	if err != io.EOF {
-		return errors.Wrap(err, "failed reading maptemplate.svg")
+	locationsByID := make(map[int32]entities.Location)
+	for _, location := range locations {
+		locationsByID[location.ID] = location
 	}
 
+	err = atlas.RenderMap(strings.NewReader(atlas.MapTemplate), mapFile, func(locationID int32) string {
+		location := locationsByID[locationID]
+		return atlas.MapFill(location.Owner, location.Occupier)
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed generating map")
+	}
 	return nil
 }
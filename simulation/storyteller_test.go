@@ -0,0 +1,90 @@
+package simulation
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/yisaj/heavens_throne/database"
+	"github.com/yisaj/heavens_throne/entities"
+	"github.com/yisaj/heavens_throne/events"
+)
+
+// storyResource keeps the stories the storyteller saves, by day
+type storyResource struct {
+	database.Resource
+	stories map[int32]entities.DayStory
+}
+
+func (r *storyResource) SaveDayStory(ctx context.Context, day int32, story *entities.DayStory) error {
+	r.stories[day] = *story
+	return nil
+}
+
+func TestGenerateLocationReport(t *testing.T) {
+	player := &entities.Player{}
+	battle := battleStory(&events.BattleResolved{
+		Day:      4,
+		Location: entities.Location{Name: "Asteria"},
+		Victor:   "Order Gorgona",
+		Combatants: map[string][]entities.Player{
+			"Order Gorgona": {{}, {}, {}},
+			"Staghorn Sect": {{}, {}},
+			"The Baaturate": nil,
+		},
+		Fatalities: map[string][]*entities.Player{"Staghorn Sect": {player}},
+		Routs:      map[string][]*entities.Player{"Staghorn Sect": {player}},
+	})
+
+	expected := "Battle of Asteria, day 4\n" +
+		"Order Gorgona: 3 fought, 0 fell, 0 fled\n" +
+		"Staghorn Sect: 2 fought, 1 fell, 1 fled\n" +
+		"Order Gorgona holds the field."
+	if report := generateLocationReport(4, &battle); report != expected {
+		t.Errorf("expected %q, got %q", expected, report)
+	}
+}
+
+func TestStoryTellerHandle(t *testing.T) {
+	resource := &storyResource{stories: make(map[int32]entities.DayStory)}
+	teller := NewStoryTeller(nil, resource, "")
+	told := []events.Event{
+		events.LocationCaptured{Day: 3, MartialOrder: "The Baaturate"},
+		events.SimulationStarted{Day: 4},
+		events.LocationCaptured{Day: 4, Location: entities.Location{ID: 1}, MartialOrder: "The Baaturate"},
+		events.LocationCaptured{Day: 4, Location: entities.Location{ID: 2}, MartialOrder: "The Baaturate"},
+		events.LocationCaptured{Day: 4, Location: entities.Location{ID: 3}, MartialOrder: "Staghorn Sect"},
+		events.BattleResolved{Day: 4, Location: entities.Location{Name: "Asteria"}, Victor: "Staghorn Sect"},
+		events.PlayerRevived{Day: 4, Return: entities.ReturnRecord{TwitterID: "1"}},
+		events.DayAdvanced{Day: 4},
+	}
+	for _, event := range told {
+		err := teller.Handle(context.Background(), event)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// each simulation starts a new story, kept once the day advances
+	story, ok := resource.stories[4]
+	if !ok || len(resource.stories) != 1 {
+		t.Fatalf("expected only day 4's story to be kept, got %+v", resource.stories)
+	}
+	expectedStory := entities.DayStory{
+		Battles: []entities.BattleStory{{LocationName: "Asteria", Victor: "Staghorn Sect"}},
+		Captures: []entities.CaptureStory{
+			{Location: 1, MartialOrder: "The Baaturate"},
+			{Location: 2, MartialOrder: "The Baaturate"},
+			{Location: 3, MartialOrder: "Staghorn Sect"},
+		},
+		Returns: []entities.ReturnRecord{{TwitterID: "1"}},
+	}
+	if !reflect.DeepEqual(story, expectedStory) {
+		t.Errorf("expected %+v, got %+v", expectedStory, story)
+	}
+
+	expected := "DAY 4\nStaghorn Sect captured a location.\nThe Baaturate captured 2 locations."
+	if caption := generateMapCaption(4, story.Captures); caption != expected {
+		t.Errorf("expected %q, got %q", expected, caption)
+	}
+}
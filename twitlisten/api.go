@@ -0,0 +1,285 @@
+package twitlisten
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yisaj/heavens_throne/database"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// where the current version of the public api is served
+const apiPrefix = "/api/v1/"
+
+// apiEndpoints lists everything the api serves, for the index
+var apiEndpoints = []string{
+	apiPrefix + "day",
+	apiPrefix + "locations",
+	apiPrefix + "adjacency",
+	apiPrefix + "temples",
+	apiPrefix + "battles",
+	apiPrefix + "battles/{day}",
+	apiPrefix + "ownership",
+	streamPath,
+}
+
+// api serves the game state as read only json
+type api struct {
+	resource database.Resource
+	logger   *logrus.Logger
+	cache    *responseCache
+}
+
+type apiLocation struct {
+	ID       int32   `json:"id"`
+	Name     string  `json:"name"`
+	Owner    *string `json:"owner"`
+	Occupier *string `json:"occupier"`
+	Strength *string `json:"strength"`
+}
+
+type apiTemple struct {
+	MartialOrder string  `json:"order"`
+	Location     int32   `json:"location"`
+	LocationName string  `json:"location_name"`
+	Owner        *string `json:"owner"`
+	Held         bool    `json:"held"`
+}
+
+type apiBattleOrder struct {
+	MartialOrder string `json:"order"`
+	Combatants   int32  `json:"combatants"`
+	Casualties   int32  `json:"casualties"`
+}
+
+type apiBattle struct {
+	Location     int32            `json:"location"`
+	LocationName string           `json:"location_name"`
+	Orders       []apiBattleOrder `json:"orders"`
+}
+
+type apiOwnershipRecord struct {
+	Day          int32  `json:"day"`
+	Location     int32  `json:"location"`
+	LocationName string `json:"location_name"`
+	Event        string `json:"event"`
+	MartialOrder string `json:"order"`
+}
+
+// newAPI constructs the public api handler
+func newAPI(resource database.Resource, logger *logrus.Logger, cache *responseCache) http.Handler {
+	return &api{
+		resource,
+		logger,
+		cache,
+	}
+}
+
+// ServeHTTP serves the cached response for the path, building it if needed
+func (a *api) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" && r.Method != "HEAD" {
+		w.Header().Set("Allow", "GET, HEAD")
+		a.writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	a.cache.serve(w, r, "application/json", a.build, a.writeError)
+}
+
+// build renders the response for a path under the api prefix
+func (a *api) build(ctx context.Context, path string) ([]byte, error) {
+	var body interface{}
+	var err error
+
+	// the path comes without its trailing slash
+	rest := strings.TrimPrefix(path, strings.TrimSuffix(apiPrefix, "/"))
+	route := strings.Split(strings.TrimPrefix(rest, "/"), "/")
+	switch {
+	case route[0] == "" && len(route) == 1:
+		body = map[string]interface{}{"version": 1, "endpoints": apiEndpoints}
+	case route[0] == "day" && len(route) == 1:
+		body, err = a.day(ctx)
+	case route[0] == "locations" && len(route) == 1:
+		body, err = a.locations(ctx)
+	case route[0] == "adjacency" && len(route) == 1:
+		body, err = a.adjacency(ctx)
+	case route[0] == "temples" && len(route) == 1:
+		body, err = a.temples(ctx)
+	case route[0] == "battles" && len(route) <= 2:
+		day := int64(-1)
+		if len(route) == 2 {
+			day, err = strconv.ParseInt(route[1], 10, 32)
+			if err != nil || day < 0 {
+				return nil, errNotFound
+			}
+		}
+		body, err = a.battles(ctx, int32(day))
+	case route[0] == "ownership" && len(route) == 1:
+		body, err = a.ownership(ctx)
+	default:
+		return nil, errNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed encoding api response")
+	}
+	return encoded, nil
+}
+
+func (a *api) day(ctx context.Context) (interface{}, error) {
+	day, err := a.resource.GetDay(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting api day")
+	}
+	return map[string]int32{"day": day}, nil
+}
+
+// locations lists every location with a rough idea of how many units stand
+// there. exact numbers are for the orders that can scout them, and contested
+// locations give away nothing at all
+func (a *api) locations(ctx context.Context) (interface{}, error) {
+	locations, err := a.resource.GetLocations(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting api locations")
+	}
+	populations, err := a.resource.GetLocationPopulations(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting api locations")
+	}
+
+	units := make(map[int32]int32)
+	for _, population := range populations {
+		units[population.Location] = population.Count
+	}
+
+	apiLocations := make([]apiLocation, len(locations))
+	for i, location := range locations {
+		apiLocations[i] = apiLocation{
+			location.ID,
+			location.Name,
+			nullableString(location.Owner),
+			nullableString(location.Occupier),
+			nil,
+		}
+		if !location.Occupier.Valid || location.Occupier == location.Owner {
+			strength := locationStrength(units[location.ID])
+			apiLocations[i].Strength = &strength
+		}
+	}
+	return apiLocations, nil
+}
+
+// locationStrength buckets the units at a location so they can't be counted
+func locationStrength(units int32) string {
+	switch {
+	case units == 0:
+		return "empty"
+	case units < 10:
+		return "few"
+	case units < 50:
+		return "many"
+	default:
+		return "horde"
+	}
+}
+
+func (a *api) adjacency(ctx context.Context) (interface{}, error) {
+	graph, err := a.resource.GetMapGraph(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting api adjacency")
+	}
+	for _, adjacent := range graph {
+		sort.Slice(adjacent, func(i int, j int) bool {
+			return adjacent[i] < adjacent[j]
+		})
+	}
+	return graph, nil
+}
+
+func (a *api) temples(ctx context.Context) (interface{}, error) {
+	temples, err := a.resource.GetTemples(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting api temples")
+	}
+
+	apiTemples := make([]apiTemple, len(temples))
+	for i, temple := range temples {
+		apiTemples[i] = apiTemple{
+			temple.MartialOrder,
+			temple.Location,
+			temple.LocationName,
+			nullableString(temple.Owner),
+			temple.Held(),
+		}
+	}
+	return apiTemples, nil
+}
+
+// battles summarizes the battles fought on a day. a negative day means the
+// latest one
+func (a *api) battles(ctx context.Context, day int32) (interface{}, error) {
+	if day < 0 {
+		var err error
+		day, err = a.resource.GetDay(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed getting api battles")
+		}
+	}
+
+	summaries, err := a.resource.GetBattleSummaries(ctx, day)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting api battles")
+	}
+
+	// the summaries come sorted by location, one row per order
+	battles := []apiBattle{}
+	for _, summary := range summaries {
+		if len(battles) == 0 || battles[len(battles)-1].Location != summary.Location {
+			battles = append(battles, apiBattle{summary.Location, summary.LocationName, nil})
+		}
+		battle := &battles[len(battles)-1]
+		battle.Orders = append(battle.Orders, apiBattleOrder{summary.MartialOrder, summary.Combatants, summary.Casualties})
+	}
+	return map[string]interface{}{"day": day, "battles": battles}, nil
+}
+
+func (a *api) ownership(ctx context.Context) (interface{}, error) {
+	records, err := a.resource.GetOwnershipRecords(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting api ownership")
+	}
+
+	apiRecords := make([]apiOwnershipRecord, len(records))
+	for i, record := range records {
+		apiRecords[i] = apiOwnershipRecord(record)
+	}
+	return apiRecords, nil
+}
+
+// writeError writes a json error body with the status code
+func (a *api) writeError(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	err := json.NewEncoder(w).Encode(map[string]string{"error": msg})
+	if err != nil {
+		a.logger.WithError(err).Error("failed writing api error")
+	}
+}
+
+// nullableString turns a missing string into a json null
+func nullableString(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
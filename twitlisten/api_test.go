@@ -0,0 +1,189 @@
+package twitlisten
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/yisaj/heavens_throne/database"
+	"github.com/yisaj/heavens_throne/entities"
+	"github.com/yisaj/heavens_throne/events"
+	"github.com/yisaj/heavens_throne/simulation"
+
+	"github.com/sirupsen/logrus"
+)
+
+// apiResource fakes the few database methods the api tests touch, counting how
+// often the day is read
+type apiResource struct {
+	database.Resource
+	day       int32
+	dayReads  int
+	summaries []entities.BattleSummary
+	locations []entities.Location
+	units     []entities.Population
+}
+
+func (r *apiResource) GetDay(ctx context.Context) (int32, error) {
+	r.dayReads++
+	return r.day, nil
+}
+
+func (r *apiResource) GetBattleSummaries(ctx context.Context, day int32) ([]entities.BattleSummary, error) {
+	if day != r.day {
+		return nil, nil
+	}
+	return r.summaries, nil
+}
+
+func (r *apiResource) GetLocations(ctx context.Context) ([]entities.Location, error) {
+	return r.locations, nil
+}
+
+func (r *apiResource) GetLocationPopulations(ctx context.Context) ([]entities.Population, error) {
+	return r.units, nil
+}
+
+func newTestLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(ioutil.Discard)
+	return logger
+}
+
+func newTestAPI(resource database.Resource, cache *responseCache) http.Handler {
+	return newAPI(resource, newTestLogger(), cache)
+}
+
+func get(handler http.Handler, path string, etag string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest("GET", path, nil)
+	if etag != "" {
+		request.Header.Set("If-None-Match", etag)
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestAPICache(t *testing.T) {
+	resource := &apiResource{day: 3}
+	simlock := &simulation.SimLock{}
+	cache := newResponseCache(simlock, newTestLogger())
+	handler := newTestAPI(resource, cache)
+
+	first := get(handler, "/api/v1/day", "")
+	if first.Code != http.StatusOK || first.Body.String() != `{"day":3}` {
+		t.Fatalf("expected day 3, got %d %s", first.Code, first.Body.String())
+	}
+	etag := first.Header().Get("ETag")
+
+	// repeat requests come out of the cache
+	if cached := get(handler, "/api/v1/day", etag); cached.Code != http.StatusNotModified {
+		t.Errorf("expected a matching etag to get a 304, got %d", cached.Code)
+	}
+	if resource.dayReads != 1 {
+		t.Errorf("expected the day to be read once, got %d", resource.dayReads)
+	}
+
+	// a running simulation can't be read from until it's done
+	simlock.WLock()
+	if busy := get(handler, "/api/v1/battles", ""); busy.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected a 503 while simulating, got %d", busy.Code)
+	}
+	resource.day = 4
+	simlock.WUnlock()
+	err := cache.Handle(context.Background(), events.SimulationFinished{Day: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fresh := get(handler, "/api/v1/day", etag)
+	if fresh.Code != http.StatusOK || fresh.Body.String() != `{"day":4}` {
+		t.Errorf("expected the cache to clear after simulating, got %d %s", fresh.Code, fresh.Body.String())
+	}
+	if fresh.Header().Get("ETag") == etag {
+		t.Error("expected a new etag after simulating")
+	}
+}
+
+func TestAPIRoutes(t *testing.T) {
+	resource := &apiResource{
+		day: 2,
+		summaries: []entities.BattleSummary{
+			{Location: 1, LocationName: "Asteria", MartialOrder: "Order Gorgona", Combatants: 4, Casualties: 1},
+			{Location: 1, LocationName: "Asteria", MartialOrder: "Staghorn Sect", Combatants: 6, Casualties: 3},
+			{Location: 5, LocationName: "Yerk", MartialOrder: "The Baaturate", Combatants: 2, Casualties: 0},
+		},
+	}
+	simlock := &simulation.SimLock{}
+	handler := newTestAPI(resource, newResponseCache(simlock, newTestLogger()))
+
+	tests := []struct {
+		path   string
+		status int
+	}{
+		{"/api/v1/", http.StatusOK},
+		{"/api/v1/battles/2", http.StatusOK},
+		{"/api/v1/battles/yesterday", http.StatusNotFound},
+		{"/api/v1/battles/2/3", http.StatusNotFound},
+		{"/api/v1/players", http.StatusNotFound},
+	}
+	for _, test := range tests {
+		if response := get(handler, test.path, ""); response.Code != test.status {
+			t.Errorf("%s: expected %d, got %d", test.path, test.status, response.Code)
+		}
+	}
+
+	var battles struct {
+		Day     int32
+		Battles []apiBattle
+	}
+	err := json.Unmarshal(get(handler, "/api/v1/battles", "").Body.Bytes(), &battles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []apiBattle{
+		{1, "Asteria", []apiBattleOrder{{"Order Gorgona", 4, 1}, {"Staghorn Sect", 6, 3}}},
+		{5, "Yerk", []apiBattleOrder{{"The Baaturate", 2, 0}}},
+	}
+	if battles.Day != 2 || !reflect.DeepEqual(battles.Battles, expected) {
+		t.Errorf("expected the battles grouped by location, got %+v", battles)
+	}
+}
+
+func TestAPILocations(t *testing.T) {
+	held := func(order string) sql.NullString {
+		return sql.NullString{String: order, Valid: true}
+	}
+	resource := &apiResource{
+		locations: []entities.Location{
+			{ID: 1, Name: "Asteria", Owner: held("Staghorn Sect"), Occupier: held("Staghorn Sect")},
+			{ID: 2, Name: "Yerk", Owner: held("Staghorn Sect"), Occupier: held("Order Gorgona")},
+			{ID: 3, Name: "Reach"},
+		},
+		units: []entities.Population{{Location: 1, Count: 23}, {Location: 2, Count: 7}},
+	}
+	handler := newTestAPI(resource, newResponseCache(&simulation.SimLock{}, newTestLogger()))
+
+	var locations []map[string]interface{}
+	err := json.Unmarshal(get(handler, "/api/v1/locations", "").Body.Bytes(), &locations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []interface{}{"many", nil, "empty"}
+	if len(locations) != len(expected) {
+		t.Fatalf("expected %d locations, got %v", len(expected), locations)
+	}
+	for i, location := range locations {
+		if _, ok := location["units"]; ok {
+			t.Errorf("expected no unit counts, got %v", location)
+		}
+		if location["strength"] != expected[i] {
+			t.Errorf("expected %v strength at %v, got %v", expected[i], location["name"], location["strength"])
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package twitlisten
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/yisaj/heavens_throne/events"
+	"github.com/yisaj/heavens_throne/simulation"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// how long clients may hold on to a response before checking it again
+	cacheControl = "public, max-age=60"
+	// how long clients should wait out a simulation before asking again
+	retryAfter = "30"
+)
+
+// errNotFound is returned by response builders for paths they don't serve
+var errNotFound = errors.New("not found")
+
+// responseCache holds rendered responses until it hears the next simulation
+// finish, since nothing public changes in between
+type responseCache struct {
+	simlock    *simulation.SimLock
+	logger     *logrus.Logger
+	lock       sync.Mutex
+	entries    map[string]*cachedResponse
+	generation uint64
+}
+
+// cachedResponse is a rendered response body along with its entity tag
+type cachedResponse struct {
+	body []byte
+	etag string
+}
+
+// newResponseCache constructs an empty response cache
+func newResponseCache(simlock *simulation.SimLock, logger *logrus.Logger) *responseCache {
+	return &responseCache{
+		simlock,
+		logger,
+		sync.Mutex{},
+		make(map[string]*cachedResponse),
+		0,
+	}
+}
+
+// Handle empties the cache once a simulation finishes
+func (c *responseCache) Handle(ctx context.Context, event events.Event) error {
+	if _, ok := event.(events.SimulationFinished); ok {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		c.entries = make(map[string]*cachedResponse)
+		c.generation++
+	}
+	return nil
+}
+
+// serve writes the cached response for the request's path, building it first if
+// there isn't one. failures are written with fail
+func (c *responseCache) serve(w http.ResponseWriter, r *http.Request, contentType string,
+	build func(ctx context.Context, path string) ([]byte, error), fail func(w http.ResponseWriter, status int, msg string)) {
+	key := strings.TrimSuffix(r.URL.Path, "/")
+	response, generation := c.lookup(key)
+	if response == nil {
+		// the database is only half way through a day while simulating
+		if c.simlock.Check() {
+			w.Header().Set("Retry-After", retryAfter)
+			fail(w, http.StatusServiceUnavailable, "simulating")
+			return
+		}
+		body, err := build(r.Context(), key)
+		c.simlock.RUnlock()
+		if err == errNotFound {
+			fail(w, http.StatusNotFound, "not found")
+			return
+		}
+		if err != nil {
+			c.logger.WithError(err).WithField("path", r.URL.Path).Error("failed building response")
+			fail(w, http.StatusInternalServerError, "internal error")
+			return
+		}
+		response = c.store(key, generation, body)
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", cacheControl)
+	w.Header().Set("ETag", response.etag)
+	if r.Header.Get("If-None-Match") == response.etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	_, err := w.Write(response.body)
+	if err != nil {
+		c.logger.WithError(err).Error("failed writing response")
+	}
+}
+
+// lookup returns the cached response for a path, along with how many times the
+// cache has been emptied
+func (c *responseCache) lookup(key string) (*cachedResponse, uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.entries[key], c.generation
+}
+
+// store caches a response body, unless the cache was emptied while it was
+// being built
+func (c *responseCache) store(key string, generation uint64, body []byte) *cachedResponse {
+	hash := sha256.Sum256(body)
+	response := &cachedResponse{body, `"` + hex.EncodeToString(hash[:16]) + `"`}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if generation == c.generation {
+		c.entries[key] = response
+	}
+	return response
+}
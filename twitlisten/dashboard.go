@@ -0,0 +1,463 @@
+package twitlisten
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"html/template"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yisaj/heavens_throne/atlas"
+	"github.com/yisaj/heavens_throne/database"
+	"github.com/yisaj/heavens_throne/entities"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// where the dashboard is served
+const dashboardPrefix = "/dashboard/"
+
+// swatches are the colours behind the map's pattern names, for everything
+// drawn outside the map
+var swatches = map[string]string{
+	"orange": "#EC731B",
+	"purple": "#7851A9",
+	"green":  "#065526",
+	"gray":   "#606060",
+}
+
+// dashboard serves server rendered pages for following the war in a browser.
+// everything it needs is in the page, save for the api it fetches battles from
+type dashboard struct {
+	resource database.Resource
+	logger   *logrus.Logger
+	cache    *responseCache
+}
+
+type dashboardStanding struct {
+	entities.Standing
+	Color      string
+	TempleHeld bool
+}
+
+type dashboardBattle struct {
+	Location     int32
+	LocationName string
+	Orders       []entities.BattleSummary
+}
+
+type dashboardOwnership struct {
+	Day      int32  `json:"day"`
+	Location int32  `json:"location"`
+	Event    string `json:"event"`
+	Color    string `json:"color"`
+}
+
+type overviewPage struct {
+	Day       int32
+	Territory []dashboardStanding
+	Kills     []dashboardStanding
+	Map       template.HTML
+	Battles   []dashboardBattle
+	History   []dashboardOwnership
+	Names     map[int32]string
+}
+
+type battlePage struct {
+	Day          int32
+	LocationName string
+	Orders       []entities.BattleSummary
+	Events       []string
+}
+
+// newDashboard constructs the dashboard handler
+func newDashboard(resource database.Resource, logger *logrus.Logger, cache *responseCache) http.Handler {
+	return &dashboard{
+		resource,
+		logger,
+		cache,
+	}
+}
+
+// ServeHTTP serves the cached page for the path, building it if needed
+func (d *dashboard) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" && r.Method != "HEAD" {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	d.cache.serve(w, r, "text/html; charset=utf-8", d.build, func(w http.ResponseWriter, status int, msg string) {
+		http.Error(w, msg, status)
+	})
+}
+
+// build renders the page for a path under the dashboard prefix
+func (d *dashboard) build(ctx context.Context, path string) ([]byte, error) {
+	// the path comes without its trailing slash
+	rest := strings.TrimPrefix(path, strings.TrimSuffix(dashboardPrefix, "/"))
+	route := strings.Split(strings.TrimPrefix(rest, "/"), "/")
+
+	var page bytes.Buffer
+	switch {
+	case route[0] == "" && len(route) == 1:
+		overview, err := d.overview(ctx)
+		if err != nil {
+			return nil, err
+		}
+		err = overviewTemplate.Execute(&page, overview)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed rendering dashboard overview")
+		}
+	case route[0] == "battles" && len(route) == 3:
+		day, dayErr := strconv.ParseInt(route[1], 10, 32)
+		locationID, locationErr := strconv.ParseInt(route[2], 10, 32)
+		if dayErr != nil || locationErr != nil {
+			return nil, errNotFound
+		}
+		battle, err := d.battle(ctx, int32(day), int32(locationID))
+		if err != nil {
+			return nil, err
+		}
+		err = battleTemplate.Execute(&page, battle)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed rendering dashboard battle")
+		}
+	default:
+		return nil, errNotFound
+	}
+	return page.Bytes(), nil
+}
+
+// overview gathers the main page: the standings, the map as it is now and its
+// history, and the latest day's battles
+func (d *dashboard) overview(ctx context.Context) (*overviewPage, error) {
+	day, err := d.resource.GetDay(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting dashboard overview")
+	}
+	standings, err := d.resource.GetOrderStandings(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting dashboard overview")
+	}
+	temples, err := d.resource.GetTemples(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting dashboard overview")
+	}
+	locations, err := d.resource.GetLocations(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting dashboard overview")
+	}
+	records, err := d.resource.GetOwnershipRecords(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting dashboard overview")
+	}
+	summaries, err := d.resource.GetBattleSummaries(ctx, day)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting dashboard overview")
+	}
+
+	page := &overviewPage{Day: day, Names: make(map[int32]string)}
+
+	held := make(map[string]bool)
+	for _, temple := range temples {
+		held[temple.MartialOrder] = temple.Held()
+	}
+	for _, standing := range standings {
+		page.Territory = append(page.Territory, dashboardStanding{
+			standing,
+			swatches[atlas.MapColors[standing.MartialOrder]],
+			held[standing.MartialOrder],
+		})
+	}
+	page.Kills = append([]dashboardStanding(nil), page.Territory...)
+	sort.SliceStable(page.Kills, func(i int, j int) bool {
+		return page.Kills[i].Kills > page.Kills[j].Kills
+	})
+
+	locationsByID := make(map[int32]entities.Location)
+	for _, location := range locations {
+		locationsByID[location.ID] = location
+		page.Names[location.ID] = location.Name
+	}
+	page.Map, err = d.renderMap(locationsByID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting dashboard overview")
+	}
+
+	for _, record := range records {
+		page.History = append(page.History, dashboardOwnership{
+			record.Day,
+			record.Location,
+			record.Event,
+			atlas.MapColors[record.MartialOrder],
+		})
+	}
+
+	// the summaries come sorted by location, one row per order
+	for _, summary := range summaries {
+		if len(page.Battles) == 0 || page.Battles[len(page.Battles)-1].Location != summary.Location {
+			page.Battles = append(page.Battles, dashboardBattle{summary.Location, summary.LocationName, nil})
+		}
+		battle := &page.Battles[len(page.Battles)-1]
+		battle.Orders = append(battle.Orders, summary)
+	}
+	return page, nil
+}
+
+// renderMap draws the map as it stands, from the same template as the one
+// tweeted every day. every tile's fill can be swapped out from the page through
+// its own css variable, to replay the map's history
+func (d *dashboard) renderMap(locations map[int32]entities.Location) (template.HTML, error) {
+	var svg strings.Builder
+	err := atlas.RenderMap(strings.NewReader(atlas.MapTemplate), &svg, func(locationID int32) string {
+		location := locations[locationID]
+		return fmt.Sprintf("var(--tile-%d,%s)", locationID, atlas.MapFill(location.Owner, location.Occupier))
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed rendering dashboard map")
+	}
+
+	// the xml prolog doesn't belong inline
+	rendered := svg.String()
+	if start := strings.Index(rendered, "<svg"); start >= 0 {
+		rendered = rendered[start:]
+	}
+	return template.HTML(rendered), nil
+}
+
+// battle gathers a single battle, blow by blow
+func (d *dashboard) battle(ctx context.Context, day int32, locationID int32) (*battlePage, error) {
+	location, err := d.resource.GetLocation(ctx, locationID)
+	if errors.Cause(err) == sql.ErrNoRows {
+		return nil, errNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting dashboard battle")
+	}
+	summaries, err := d.resource.GetBattleSummaries(ctx, day)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting dashboard battle")
+	}
+	records, err := d.resource.GetCombatRecords(ctx, day, locationID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting dashboard battle")
+	}
+	if len(records) == 0 {
+		return nil, errNotFound
+	}
+
+	page := &battlePage{Day: day, LocationName: location.Name}
+	for _, summary := range summaries {
+		if summary.Location == locationID {
+			page.Orders = append(page.Orders, summary)
+		}
+	}
+	for _, record := range records {
+		page.Events = append(page.Events, formatCombatRecord(&record))
+	}
+	return page, nil
+}
+
+// formatCombatRecord describes a combat event in a line
+func formatCombatRecord(record *entities.CombatRecord) string {
+	attacker := formatCombatant(record.AttackerOrder, record.AttackerClass, record.AttackerStance)
+	defender := "nobody"
+	if record.DefenderClass.Valid {
+		defender = formatCombatant(record.DefenderOrder.String, record.DefenderClass.String, record.DefenderStance)
+	}
+
+	switch record.Type {
+	case "revive":
+		return fmt.Sprintf("%s tried to revive %s: %s", attacker, defender, record.Result)
+	case "rout":
+		return fmt.Sprintf("%s broke and ran", attacker)
+	case "counterattack":
+		return fmt.Sprintf("%s struck back at %s: %s", attacker, defender, record.Result)
+	}
+	return fmt.Sprintf("%s attacked %s: %s", attacker, defender, record.Result)
+}
+
+// formatCombatant names a combatant by order and class, along with the stance
+// they fought in. records from before stances were kept have none
+func formatCombatant(order string, class string, stance sql.NullString) string {
+	combatant := fmt.Sprintf("%s %s", order, entities.FormatClassName(class))
+	if stance.Valid {
+		combatant += fmt.Sprintf(" (%s)", stance.String)
+	}
+	return combatant
+}
+
+const dashboardStyle = `
+body { font-family: Georgia, serif; background: #1d1b18; color: #e8e2d6; margin: 0 auto; max-width: 1100px; padding: 1em; }
+a { color: #f0b860; }
+h1, h2 { font-weight: normal; }
+table { border-collapse: collapse; margin-bottom: 1em; }
+th, td { padding: 0.2em 0.8em; text-align: left; border-bottom: 1px solid #3a362f; }
+.swatch { display: inline-block; width: 0.8em; height: 0.8em; margin-right: 0.4em; }
+.boards { display: flex; flex-wrap: wrap; gap: 2em; }
+#map svg { max-width: 100%; height: auto; cursor: pointer; }
+#slider { width: 100%; }
+`
+
+var overviewTemplate = template.Must(template.New("overview").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Heaven's Throne - Day {{.Day}}</title>
+<style>` + dashboardStyle + `</style>
+</head>
+<body>
+<h1>Heaven's Throne</h1>
+<p>Day {{.Day}}</p>
+
+<div class="boards">
+<section>
+<h2>Territory</h2>
+<table>
+<tr><th>Order</th><th>Held</th><th>Standing</th><th>Temple</th></tr>
+{{range .Territory}}<tr><td><span class="swatch" style="background: {{.Color}}"></span>{{.MartialOrder}}</td><td>{{.Locations}}</td><td>{{.Units}}</td><td>{{if .TempleHeld}}held{{else}}lost{{end}}</td></tr>
+{{end}}</table>
+</section>
+<section>
+<h2>Kills</h2>
+<table>
+<tr><th>Order</th><th>Kills</th></tr>
+{{range .Kills}}<tr><td><span class="swatch" style="background: {{.Color}}"></span>{{.MartialOrder}}</td><td>{{.Kills}}</td></tr>
+{{end}}</table>
+</section>
+</div>
+
+<section>
+<h2>Map</h2>
+<label for="slider">Day <output id="slider-day">{{.Day}}</output></label>
+<input type="range" id="slider" min="0" max="{{.Day}}" value="{{.Day}}">
+<p id="caption">Click a location to see its battle.</p>
+<div id="map">{{.Map}}</div>
+</section>
+
+<section>
+<h2>Battles on day <span id="battle-day">{{.Day}}</span></h2>
+<ul id="battles">
+{{range .Battles}}<li><a href="battles/{{$.Day}}/{{.Location}}">{{.LocationName}}</a>:{{range .Orders}} {{.MartialOrder}} ({{.Combatants}} fought, {{.Casualties}} fell){{end}}</li>
+{{else}}<li>No battles.</li>
+{{end}}</ul>
+</section>
+
+<script>
+(function() {
+	var today = {{.Day}};
+	var history = {{.History}} || [];
+	var names = {{.Names}};
+	var svg = document.querySelector("#map svg");
+	var slider = document.getElementById("slider");
+	var battles = {};
+	var selected = today;
+
+	// replay the ownership records up to a day and recolour every tile
+	function drawDay(day) {
+		var owners = {}, occupiers = {};
+		history.forEach(function(record) {
+			if (record.day > day) {
+				return;
+			}
+			occupiers[record.location] = record.color;
+			if (record.event === "capture") {
+				owners[record.location] = record.color;
+			}
+		});
+		Object.keys(names).forEach(function(id) {
+			if (day === today) {
+				svg.style.removeProperty("--tile-" + id);
+				return;
+			}
+			var fill = "url(#" + (owners[id] || "gray") + "dot" + (occupiers[id] || "gray") + ")";
+			svg.style.setProperty("--tile-" + id, fill);
+		});
+	}
+
+	// list the battles of a day, from the api
+	function listBattles(day) {
+		document.getElementById("battle-day").textContent = day;
+		fetch("/api/v1/battles/" + day).then(function(response) {
+			return response.json();
+		}).then(function(body) {
+			var list = document.getElementById("battles");
+			list.innerHTML = "";
+			battles = {};
+			(body.battles || []).forEach(function(battle) {
+				battles[battle.location] = true;
+				var item = document.createElement("li");
+				var link = document.createElement("a");
+				link.href = "battles/" + day + "/" + battle.location;
+				link.textContent = battle.location_name;
+				item.appendChild(link);
+				battle.orders.forEach(function(order) {
+					item.appendChild(document.createTextNode(" " + order.order + " (" + order.combatants +
+						" fought, " + order.casualties + " fell)"));
+				});
+				list.appendChild(item);
+			});
+			if (list.children.length === 0) {
+				list.innerHTML = "<li>No battles.</li>";
+			}
+		});
+	}
+
+	slider.addEventListener("input", function() {
+		selected = parseInt(slider.value, 10);
+		document.getElementById("slider-day").textContent = selected;
+		drawDay(selected);
+	});
+	slider.addEventListener("change", function() {
+		listBattles(selected);
+	});
+
+	svg.addEventListener("click", function(event) {
+		var match = /--tile-(\d+)/.exec(event.target.getAttribute("style") || "");
+		if (!match) {
+			return;
+		}
+		if (battles[match[1]]) {
+			window.location = "battles/" + selected + "/" + match[1];
+			return;
+		}
+		document.getElementById("caption").textContent = names[match[1]] + " saw no battle on day " + selected + ".";
+	});
+
+	{{range .Battles}}battles[{{.Location}}] = true;
+	{{end}}
+})();
+</script>
+</body>
+</html>
+`))
+
+var battleTemplate = template.Must(template.New("battle").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Heaven's Throne - Battle of {{.LocationName}}, day {{.Day}}</title>
+<style>` + dashboardStyle + `</style>
+</head>
+<body>
+<p><a href="../../">Back to the map</a></p>
+<h1>Battle of {{.LocationName}}</h1>
+<p>Day {{.Day}}</p>
+<table>
+<tr><th>Order</th><th>Fought</th><th>Fell</th></tr>
+{{range .Orders}}<tr><td>{{.MartialOrder}}</td><td>{{.Combatants}}</td><td>{{.Casualties}}</td></tr>
+{{end}}</table>
+<h2>Blow by blow</h2>
+<ol>
+{{range .Events}}<li>{{.}}</li>
+{{end}}</ol>
+</body>
+</html>
+`))
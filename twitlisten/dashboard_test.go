@@ -0,0 +1,114 @@
+package twitlisten
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/yisaj/heavens_throne/entities"
+	"github.com/yisaj/heavens_throne/simulation"
+)
+
+// dashboardResource fakes the database behind the dashboard pages
+type dashboardResource struct {
+	apiResource
+}
+
+func (r *dashboardResource) GetOrderStandings(ctx context.Context) ([]entities.Standing, error) {
+	return []entities.Standing{
+		{MartialOrder: "Staghorn Sect", Locations: 4, Units: 20, Kills: 3},
+		{MartialOrder: "Order Gorgona", Locations: 2, Units: 25, Kills: 9},
+	}, nil
+}
+
+func (r *dashboardResource) GetTemples(ctx context.Context) ([]entities.Temple, error) {
+	return []entities.Temple{
+		{MartialOrder: "Staghorn Sect", Location: 39, Owner: sql.NullString{String: "Staghorn Sect", Valid: true}},
+	}, nil
+}
+
+func (r *dashboardResource) GetLocations(ctx context.Context) ([]entities.Location, error) {
+	return []entities.Location{
+		{ID: 1, Name: "Asteria", Owner: sql.NullString{String: "Order Gorgona", Valid: true}},
+		{ID: 23, Name: "Yerk"},
+	}, nil
+}
+
+func (r *dashboardResource) GetLocation(ctx context.Context, locationID int32) (*entities.Location, error) {
+	if locationID != 1 {
+		return nil, sql.ErrNoRows
+	}
+	return &entities.Location{ID: 1, Name: "Asteria"}, nil
+}
+
+func (r *dashboardResource) GetOwnershipRecords(ctx context.Context) ([]entities.OwnershipRecord, error) {
+	return []entities.OwnershipRecord{
+		{Day: 1, Location: 1, LocationName: "Asteria", Event: "occupy", MartialOrder: "Order Gorgona"},
+		{Day: 2, Location: 1, LocationName: "Asteria", Event: "capture", MartialOrder: "Order Gorgona"},
+	}, nil
+}
+
+func (r *dashboardResource) GetCombatRecords(ctx context.Context, day int32, locationID int32) ([]entities.CombatRecord, error) {
+	if day != r.day || locationID != 1 {
+		return nil, nil
+	}
+	return []entities.CombatRecord{
+		{Type: "attack", Result: "success", AttackerOrder: "Order Gorgona", AttackerClass: "spear",
+			AttackerStance: sql.NullString{String: "aggressive", Valid: true},
+			DefenderOrder:  sql.NullString{String: "Staghorn Sect", Valid: true},
+			DefenderClass:  sql.NullString{String: "recruit", Valid: true},
+			DefenderStance: sql.NullString{String: "hold", Valid: true}},
+		{Type: "rout", Result: "routed", AttackerOrder: "Staghorn Sect", AttackerClass: "archer"},
+	}, nil
+}
+
+func TestDashboard(t *testing.T) {
+	resource := &dashboardResource{apiResource{
+		day: 2,
+		summaries: []entities.BattleSummary{
+			{Location: 1, LocationName: "Asteria", MartialOrder: "Order Gorgona", Combatants: 4, Casualties: 1},
+		},
+	}}
+	simlock := &simulation.SimLock{}
+	handler := newDashboard(resource, newTestLogger(), newResponseCache(simlock, newTestLogger()))
+
+	overview := get(handler, "/dashboard/", "")
+	if overview.Code != http.StatusOK {
+		t.Fatalf("expected the overview, got %d %s", overview.Code, overview.Body.String())
+	}
+	for _, expected := range []string{
+		"Day 2",
+		"Order Gorgona</td><td>2</td><td>25</td><td>lost</td>",
+		"var(--tile-23,url(#graydotgray));",
+		`<a href="battles/2/1">Asteria</a>: Order Gorgona (4 fought, 1 fell)`,
+	} {
+		if !strings.Contains(overview.Body.String(), expected) {
+			t.Errorf("expected %q in the overview", expected)
+		}
+	}
+	if strings.Contains(overview.Body.String(), "<?xml") {
+		t.Error("expected the map's xml prolog to be dropped")
+	}
+
+	battle := get(handler, "/dashboard/battles/2/1", "")
+	if battle.Code != http.StatusOK {
+		t.Fatalf("expected the battle, got %d %s", battle.Code, battle.Body.String())
+	}
+	for _, expected := range []string{
+		"Battle of Asteria",
+		"<li>Order Gorgona Spear (aggressive) attacked Staghorn Sect Initiate (hold): success</li>",
+		"<li>Staghorn Sect Archer broke and ran</li>",
+	} {
+		if !strings.Contains(battle.Body.String(), expected) {
+			t.Errorf("expected %q in the battle", expected)
+		}
+	}
+
+	for _, path := range []string{"/dashboard/battles/1/1", "/dashboard/battles/2/7", "/dashboard/players"} {
+		if response := get(handler, path, ""); response.Code != http.StatusNotFound {
+			t.Errorf("%s: expected a 404, got %d", path, response.Code)
+		}
+	}
+}
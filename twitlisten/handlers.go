@@ -58,7 +58,10 @@ type Event struct {
 		MessageCreate struct {
 			SenderID    string `json:"sender_id"`
 			MessageData struct {
-				Text string
+				Text               string
+				QuickReplyResponse struct {
+					Metadata string
+				} `json:"quick_reply_response"`
 			} `json:"message_data"`
 		} `json:"message_create"`
 	} `json:"direct_message_events"`
@@ -108,6 +111,10 @@ I'm busy simulating right now.'
 			}
 			// TODO ENGINEER: confirm the locks work the way that I want it to
 			msg := html.UnescapeString(messageEvent.MessageCreate.MessageData.Text)
+			// quick reply buttons carry the command they stand for
+			if metadata := messageEvent.MessageCreate.MessageData.QuickReplyResponse.Metadata; metadata != "" {
+				msg = metadata
+			}
 			//simulating := h.simlock.Check()
 			if false { //simulating {
 				h.simlock.RUnlock()
@@ -0,0 +1,327 @@
+package twitlisten
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/yisaj/heavens_throne/database"
+	"github.com/yisaj/heavens_throne/entities"
+	"github.com/yisaj/heavens_throne/events"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// how long every readiness check gets altogether
+	readinessTimeout = 5 * time.Second
+	// how late a scheduled simulation can finish before the game isn't ready
+	simulationGrace = 15 * time.Minute
+	// how far back to look for the last scheduled simulation
+	scheduleLookback = 48 * time.Hour
+	// how many errors the status page remembers
+	recentErrorsSize = 50
+)
+
+// health keeps track of whether every game is running the way it should
+type health struct {
+	resource         database.Resource
+	statusToken      string
+	checkSimulations bool
+	started          time.Time
+	lock             sync.Mutex
+	games            []*gameHealth
+	errors           []loggedError
+}
+
+// gameHealth keeps track of a single game's simulations
+type gameHealth struct {
+	name       string
+	resource   database.Resource
+	schedule   cron.Schedule
+	lock       sync.Mutex
+	simulation *simulationRun
+}
+
+// simulationRun is when a simulation last finished, and how
+type simulationRun struct {
+	Day      int32     `json:"day"`
+	Finished time.Time `json:"finished"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// gameStatus is where a single game is at, as the status page shows it
+type gameStatus struct {
+	Name           string         `json:"name"`
+	Day            int32          `json:"day"`
+	DayError       string         `json:"day_error,omitempty"`
+	NextSimulation time.Time      `json:"next_simulation"`
+	Simulation     check          `json:"simulation"`
+	LastSimulation *simulationRun `json:"last_simulation"`
+}
+
+// loggedError is an error that was logged, as the status page shows it
+type loggedError struct {
+	Time    time.Time `json:"time"`
+	Message string    `json:"message"`
+	Error   string    `json:"error,omitempty"`
+}
+
+// check is the outcome of a single readiness check
+type check struct {
+	Name  string `json:"name"`
+	Error string `json:"error,omitempty"`
+}
+
+// newHealth constructs the health tracker around the main game's database. a
+// blank status token keeps the status page shut, and checking simulations
+// takes the game out of rotation when one fails or never runs
+func newHealth(resource database.Resource, statusToken string, checkSimulations bool) *health {
+	return &health{
+		resource:         resource,
+		statusToken:      statusToken,
+		checkSimulations: checkSimulations,
+		started:          time.Now(),
+	}
+}
+
+// watch starts keeping track of a game. the game's simulations are only known
+// about once what it returns is subscribed to the game's dispatcher
+func (h *health) watch(name string, resource database.Resource, schedule cron.Schedule) *gameHealth {
+	game := &gameHealth{
+		name:     name,
+		resource: resource,
+		schedule: schedule,
+	}
+	h.lock.Lock()
+	h.games = append(h.games, game)
+	h.lock.Unlock()
+	return game
+}
+
+// Handle takes note of every simulation of the game that finishes
+func (g *gameHealth) Handle(ctx context.Context, event events.Event) error {
+	if finished, ok := event.(events.SimulationFinished); ok {
+		run := &simulationRun{Day: finished.Day, Finished: time.Now()}
+		if finished.Err != nil {
+			run.Error = finished.Err.Error()
+		}
+		g.lock.Lock()
+		g.simulation = run
+		g.lock.Unlock()
+	}
+	return nil
+}
+
+// Levels hooks the health tracker into every error the logger logs
+func (h *health) Levels() []logrus.Level {
+	return []logrus.Level{logrus.PanicLevel, logrus.FatalLevel, logrus.ErrorLevel}
+}
+
+// Fire remembers a logged error for the status page
+func (h *health) Fire(entry *logrus.Entry) error {
+	logged := loggedError{Time: entry.Time, Message: entry.Message}
+	if err, ok := entry.Data[logrus.ErrorKey].(error); ok {
+		logged.Error = err.Error()
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if len(h.errors) == recentErrorsSize {
+		h.errors = h.errors[1:]
+	}
+	h.errors = append(h.errors, logged)
+	return nil
+}
+
+// lastScheduled returns the latest time a simulation of the game was scheduled
+// for, or the zero time if there wasn't one recently
+func (g *gameHealth) lastScheduled(now time.Time) time.Time {
+	var last time.Time
+	for next := g.schedule.Next(now.Add(-scheduleLookback)); !next.IsZero() && !next.After(now); next = g.schedule.Next(next) {
+		last = next
+	}
+	return last
+}
+
+// checkSimulation fails if a scheduled simulation of the game should have
+// finished by now but hasn't, whichever instance ran it. runs scheduled from
+// before the given start can't be known about
+func (g *gameHealth) checkSimulation(ctx context.Context, now time.Time, started time.Time) error {
+	due := g.lastScheduled(now)
+	if due.IsZero() || due.Before(started) || now.Sub(due) < simulationGrace {
+		return nil
+	}
+
+	last, err := g.resource.GetLastScheduledRun(ctx)
+	if err != nil {
+		return err
+	}
+	if last == nil || last.ScheduledFor.Before(due) {
+		return errors.Errorf("simulation scheduled for %s never ran", due.Format(time.RFC3339))
+	}
+	switch last.Status {
+	case entities.RunRunning:
+		return errors.Errorf("simulation scheduled for %s never finished", last.ScheduledFor.Format(time.RFC3339))
+	case entities.RunFailed:
+		return errors.Errorf("simulation scheduled for %s failed", last.ScheduledFor.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// status sums up where the game is at
+func (g *gameHealth) status(ctx context.Context, now time.Time, started time.Time) gameStatus {
+	status := gameStatus{
+		Name:           g.name,
+		NextSimulation: g.schedule.Next(now),
+		Simulation:     check{Name: "simulation"},
+	}
+	day, err := g.resource.GetDay(ctx)
+	if err != nil {
+		status.DayError = err.Error()
+	}
+	status.Day = day
+	err = g.checkSimulation(ctx, now, started)
+	if err != nil {
+		status.Simulation.Error = err.Error()
+	}
+
+	g.lock.Lock()
+	status.LastSimulation = g.simulation
+	g.lock.Unlock()
+	return status
+}
+
+// checkMapAssets fails if the map can't be drawn. the template is built in, so
+// only the rasterizer can go missing
+func checkMapAssets() error {
+	_, err := exec.LookPath("inkscape")
+	if err != nil {
+		return errors.Wrap(err, "failed finding map rasterizer")
+	}
+	return nil
+}
+
+// checks runs every readiness check, returning whether they all passed. every
+// game's database has to be reachable, and unless turned off, every game's
+// last scheduled simulation has to have run
+func (h *health) checks(ctx context.Context) ([]check, bool) {
+	ctx, cancel := context.WithTimeout(ctx, readinessTimeout)
+	defer cancel()
+
+	results := []check{
+		{Name: "database"},
+		{Name: "webhooks"},
+		{Name: "map"},
+	}
+	errs := make([]error, len(results))
+	errs[0] = h.resource.Ping(ctx)
+	if errs[0] == nil {
+		webhooksID, err := h.resource.GetWebhooksID(ctx)
+		if err == nil && webhooksID == "" {
+			err = errors.New("no webhook registered")
+		}
+		errs[1] = err
+	} else {
+		errs[1] = errors.New("database unreachable")
+	}
+	errs[2] = checkMapAssets()
+
+	h.lock.Lock()
+	games := h.games
+	h.lock.Unlock()
+	for _, game := range games {
+		if game.resource == h.resource {
+			continue
+		}
+		result := check{Name: "database " + game.name}
+		err := game.resource.Ping(ctx)
+		results = append(results, result)
+		errs = append(errs, err)
+	}
+	if h.checkSimulations {
+		now := time.Now()
+		for _, game := range games {
+			result := check{Name: "simulation"}
+			if game.resource != h.resource {
+				result.Name += " " + game.name
+			}
+			err := game.checkSimulation(ctx, now, h.started)
+			results = append(results, result)
+			errs = append(errs, err)
+		}
+	}
+
+	ready := true
+	for i, err := range errs {
+		if err != nil {
+			results[i].Error = err.Error()
+			ready = false
+		}
+	}
+	return results, ready
+}
+
+// serveHealthz answers as long as the process is up
+func (h *health) serveHealthz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain")
+	w.Write([]byte("ok\n"))
+}
+
+// serveReadyz answers with every readiness check, failing if any of them did
+func (h *health) serveReadyz(w http.ResponseWriter, r *http.Request) {
+	results, ready := h.checks(r.Context())
+	status := http.StatusOK
+	if !ready {
+		status = http.StatusServiceUnavailable
+	}
+	writeJSON(w, status, map[string]interface{}{"ready": ready, "checks": results})
+}
+
+// serveStatus shows the people running the game where it's at. it needs the
+// status token as a bearer token
+func (h *health) serveStatus(w http.ResponseWriter, r *http.Request) {
+	expected := "Bearer " + h.statusToken
+	if h.statusToken == "" || subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte(expected)) != 1 {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="status"`)
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+		return
+	}
+
+	status := make(map[string]interface{})
+	now := time.Now()
+	status["now"] = now
+	status["started"] = h.started
+	status["checks"], status["ready"] = h.checks(r.Context())
+
+	h.lock.Lock()
+	games := h.games
+	recent := make([]loggedError, len(h.errors))
+	// newest first
+	for i, logged := range h.errors {
+		recent[len(h.errors)-1-i] = logged
+	}
+	h.lock.Unlock()
+	status["recent_errors"] = recent
+
+	gameStatuses := make([]gameStatus, len(games))
+	for i, game := range games {
+		gameStatuses[i] = game.status(r.Context(), now, h.started)
+	}
+	status["games"] = gameStatuses
+
+	writeJSON(w, http.StatusOK, status)
+}
+
+// writeJSON writes a json body with the status code
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
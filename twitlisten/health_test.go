@@ -0,0 +1,231 @@
+package twitlisten
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/yisaj/heavens_throne/entities"
+	"github.com/yisaj/heavens_throne/events"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+)
+
+// healthResource fakes the database the readiness checks touch
+type healthResource struct {
+	apiResource
+	pingErr    error
+	webhooksID string
+	lastRun    *entities.ScheduledRun
+}
+
+func (r *healthResource) Ping(ctx context.Context) error {
+	return r.pingErr
+}
+
+func (r *healthResource) GetWebhooksID(ctx context.Context) (string, error) {
+	return r.webhooksID, nil
+}
+
+func (r *healthResource) GetLastScheduledRun(ctx context.Context) (*entities.ScheduledRun, error) {
+	return r.lastRun, nil
+}
+
+// newTestHealth keeps track of a main game simulated every midnight
+func newTestHealth(t *testing.T, resource *healthResource, token string) *health {
+	h := newHealth(resource, token, true)
+	h.watch("main", resource, newTestSchedule(t))
+	return h
+}
+
+// onceSchedule is scheduled just the once
+type onceSchedule time.Time
+
+func (s onceSchedule) Next(t time.Time) time.Time {
+	if t.Before(time.Time(s)) {
+		return time.Time(s)
+	}
+	return time.Time{}
+}
+
+func newTestSchedule(t *testing.T) cron.Schedule {
+	schedule, err := cron.ParseStandard("0 0 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return schedule
+}
+
+func TestCheckSimulation(t *testing.T) {
+	resource := &healthResource{}
+	game := newTestHealth(t, resource, "").games[0]
+	ctx := context.Background()
+	midnight := time.Date(2020, 6, 2, 0, 0, 0, 0, time.Local)
+	started := midnight.Add(-time.Hour)
+
+	// still inside the grace period
+	if err := game.checkSimulation(ctx, midnight.Add(time.Minute), started); err != nil {
+		t.Errorf("expected no error in the grace period, got %v", err)
+	}
+	// the run never happened
+	if err := game.checkSimulation(ctx, midnight.Add(time.Hour), started); err == nil {
+		t.Error("expected a missed simulation to fail")
+	}
+	resource.lastRun = &entities.ScheduledRun{ScheduledFor: midnight.Add(-24 * time.Hour), Status: entities.RunFinished}
+	if err := game.checkSimulation(ctx, midnight.Add(time.Hour), started); err == nil {
+		t.Error("expected yesterday's simulation not to count")
+	}
+
+	for status, fails := range map[entities.RunStatus]bool{
+		entities.RunFinished: false,
+		entities.RunSkipped:  false,
+		entities.RunRunning:  true,
+		entities.RunFailed:   true,
+	} {
+		resource.lastRun = &entities.ScheduledRun{ScheduledFor: midnight, Status: status}
+		if err := game.checkSimulation(ctx, midnight.Add(time.Hour), started); (err != nil) != fails {
+			t.Errorf("expected a %s simulation to fail %t, got %v", status, fails, err)
+		}
+	}
+
+	// runs from before the game started aren't counted against it
+	started = midnight.Add(time.Minute)
+	resource.lastRun = nil
+	if err := game.checkSimulation(ctx, midnight.Add(time.Hour), started); err != nil {
+		t.Errorf("expected a run before startup to be ignored, got %v", err)
+	}
+}
+
+func TestReadyz(t *testing.T) {
+	resource := &healthResource{pingErr: errors.New("down")}
+	h := newTestHealth(t, resource, "")
+	h.watch("fast", &healthResource{pingErr: errors.New("down")}, newTestSchedule(t))
+	handler := newOpsHandler(h)
+
+	recorder := get(handler, "/healthz", "")
+	if recorder.Code != http.StatusOK {
+		t.Errorf("expected healthz to be ok, got %d", recorder.Code)
+	}
+
+	recorder = get(handler, "/readyz", "")
+	if recorder.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected an unreachable database to fail readiness, got %d", recorder.Code)
+	}
+	var body struct {
+		Ready  bool
+		Checks []check
+	}
+	err := json.Unmarshal(recorder.Body.Bytes(), &body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	failed := make(map[string]bool)
+	for _, result := range body.Checks {
+		failed[result.Name] = result.Error != ""
+	}
+	if body.Ready || !failed["database"] || !failed["webhooks"] || !failed["database fast"] {
+		t.Errorf("unexpected readiness %s", recorder.Body.String())
+	}
+	if failed["simulation"] || failed["simulation fast"] {
+		t.Errorf("expected simulations since startup not to fail readiness, got %s", recorder.Body.String())
+	}
+}
+
+func TestReadyzSimulation(t *testing.T) {
+	resource := &healthResource{webhooksID: "1"}
+	for _, checkSimulations := range []bool{true, false} {
+		h := newHealth(resource, "", checkSimulations)
+		h.started = time.Now().Add(-2 * time.Hour)
+		h.watch("main", resource, onceSchedule(time.Now().Add(-time.Hour)))
+
+		results, _ := h.checks(context.Background())
+		failed := make(map[string]bool)
+		for _, result := range results {
+			if result.Name == "simulation" {
+				failed[result.Name] = result.Error != ""
+			}
+		}
+		if checkSimulations && !failed["simulation"] {
+			t.Errorf("expected a simulation that never ran to fail readiness, got %+v", results)
+		}
+		if _, ok := failed["simulation"]; !checkSimulations && ok {
+			t.Errorf("expected the simulation to be left out of readiness, got %+v", results)
+		}
+	}
+}
+
+func TestStatus(t *testing.T) {
+	resource := &healthResource{apiResource: apiResource{day: 9}, webhooksID: "1"}
+	h := newTestHealth(t, resource, "secret")
+	h.watch("fast", &healthResource{apiResource: apiResource{day: 3}}, newTestSchedule(t))
+	err := h.games[1].Handle(context.Background(), events.SimulationFinished{Day: 3, Err: errors.New("bust")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger := newTestLogger()
+	logger.AddHook(h)
+	logger.WithError(errors.New("boom")).Error("failed something")
+	handler := newOpsHandler(h)
+
+	for _, authorization := range []string{"", "Bearer wrong", "secret"} {
+		request := httptest.NewRequest("GET", "/status", nil)
+		request.Header.Set("Authorization", authorization)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		if recorder.Code != http.StatusUnauthorized {
+			t.Errorf("expected %q to be unauthorized, got %d", authorization, recorder.Code)
+		}
+	}
+
+	request := httptest.NewRequest("GET", "/status", nil)
+	request.Header.Set("Authorization", "Bearer secret")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected the status page, got %d", recorder.Code)
+	}
+	var body struct {
+		Games        []gameStatus
+		RecentErrors []loggedError `json:"recent_errors"`
+	}
+	err = json.Unmarshal(recorder.Body.Bytes(), &body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(body.Games) != 2 {
+		t.Fatalf("expected both games on the status page, got %s", recorder.Body.String())
+	}
+	for i, expected := range []struct {
+		name string
+		day  int32
+		last string
+	}{{"main", 9, ""}, {"fast", 3, "bust"}} {
+		game := body.Games[i]
+		if game.Name != expected.name || game.Day != expected.day || game.NextSimulation.Before(time.Now()) ||
+			game.Simulation.Name != "simulation" {
+			t.Errorf("unexpected %s status %+v", expected.name, game)
+		}
+		if (game.LastSimulation != nil && game.LastSimulation.Error != expected.last) ||
+			(game.LastSimulation == nil) != (expected.last == "") {
+			t.Errorf("expected %s's last simulation to have failed with %q, got %+v", expected.name, expected.last,
+				game.LastSimulation)
+		}
+	}
+	if len(body.RecentErrors) != 1 || body.RecentErrors[0].Error != "boom" {
+		t.Errorf("expected the logged error, got %v", body.RecentErrors)
+	}
+
+	// no token, no status page
+	handler = newOpsHandler(newTestHealth(t, resource, ""))
+	request = httptest.NewRequest("GET", "/status", nil)
+	request.Header.Set("Authorization", "Bearer ")
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	if recorder.Code != http.StatusUnauthorized {
+		t.Errorf("expected a blank token to shut the status page, got %d", recorder.Code)
+	}
+}
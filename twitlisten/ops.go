@@ -0,0 +1,18 @@
+package twitlisten
+
+import (
+	"net/http"
+
+	"github.com/yisaj/heavens_throne/metrics"
+)
+
+// newOpsHandler serves what the people running the game need, as opposed to
+// the players
+func newOpsHandler(health *health) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	mux.HandleFunc("/healthz", health.serveHealthz)
+	mux.HandleFunc("/readyz", health.serveReadyz)
+	mux.HandleFunc("/status", health.serveStatus)
+	return mux
+}
@@ -0,0 +1,41 @@
+package twitlisten
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/yisaj/heavens_throne/metrics"
+
+	"github.com/sirupsen/logrus"
+)
+
+var panicsTotal = metrics.NewCounter("http_panics_total",
+	"Panics recovered from while serving requests, by server.", "server")
+
+// recoverer keeps a panicking handler from taking the connection down with it,
+// reporting the panic and answering with a 500 instead
+func recoverer(server string, logger *logrus.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+			// the server aborts responses this way on purpose
+			if recovered == http.ErrAbortHandler {
+				panic(recovered)
+			}
+
+			panicsTotal.Inc(server)
+			logger.WithFields(logrus.Fields{
+				"panic":  fmt.Sprint(recovered),
+				"method": r.Method,
+				"path":   r.URL.Path,
+				"stack":  string(debug.Stack()),
+			}).Error("recovered handler panic")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,34 @@
+package twitlisten
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestRecoverer(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/panic", func(w http.ResponseWriter, r *http.Request) {
+		panic("oh no")
+	})
+	mux.HandleFunc("/fine", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("fine"))
+	})
+	handler := recoverer("test", newTestLogger(), mux)
+
+	if recorder := get(handler, "/panic", ""); recorder.Code != http.StatusInternalServerError {
+		t.Errorf("expected a panic to be a 500, got %d", recorder.Code)
+	}
+	if recorder := get(handler, "/fine", ""); recorder.Code != http.StatusOK || recorder.Body.String() != "fine" {
+		t.Errorf("expected the handler to keep serving, got %d %s", recorder.Code, recorder.Body.String())
+	}
+
+	defer func() {
+		if recover() != http.ErrAbortHandler {
+			t.Error("expected aborted handlers to still abort")
+		}
+	}()
+	mux.HandleFunc("/abort", func(w http.ResponseWriter, r *http.Request) {
+		panic(http.ErrAbortHandler)
+	})
+	get(handler, "/abort", "")
+}
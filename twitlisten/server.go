@@ -8,27 +8,45 @@ import (
 
 	"github.com/yisaj/heavens_throne/config"
 	"github.com/yisaj/heavens_throne/database"
+	"github.com/yisaj/heavens_throne/events"
 	"github.com/yisaj/heavens_throne/input"
 	"github.com/yisaj/heavens_throne/simulation"
 	"github.com/yisaj/heavens_throne/twitspeak"
 
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/crypto/acme/autocert"
 )
 
+const (
+	// how long the public pages have to write their response
+	writeTimeout = 5 * time.Second
+	// how long twitter's webhook requests have to be answered. DM commands
+	// send their replies before responding, so they get longer
+	webhookTimeout = 30 * time.Second
+	// how long the servers have to finish what they're doing when shutting down,
+	// which is mostly direct messages being answered
+	shutdownTimeout = 30 * time.Second
+)
+
 // Listen spins up the HTTPS autocert server, hooks into the twitter api, and
-// starts listening for twitter user events
-func Listen(conf *config.Config, speaker twitspeak.TwitterSpeaker, resource database.Resource, logger *logrus.Logger, simLock *simulation.SimLock, simulator simulation.Simulator) {
+// listens for twitter user events until the context is done or a server dies.
+// the servers are shut down gracefully either way. DMs go to whichever of the
+// games their sender is playing, and the health checks cover every game on its
+// schedule, named by game. the public pages are for the main game, whose
+// resource, lock and dispatcher are given
+func Listen(ctx context.Context, conf *config.Config, speaker twitspeak.TwitterSpeaker, resource database.Resource, logger *logrus.Logger, simLock *simulation.SimLock, dispatcher *events.Dispatcher, bus *events.Bus, schedules map[string]cron.Schedule, games []input.Game) error {
 	// check for webhooks id in database
-	webhooksID, err := resource.GetWebhooksID(context.TODO())
+	webhooksID, err := resource.GetWebhooksID(ctx)
 	if err != nil {
-		logger.WithError(err).Panic("failed querying database for webhook id")
+		return errors.Wrap(err, "failed querying database for webhook id")
 	}
 
 	// check twitter for webhooks id
 	webhooksID, err = speaker.GetWebhook()
 	if err != nil {
-		logger.WithError(err).Panic("failed querying twitter for webhook id")
+		return errors.Wrap(err, "failed querying twitter for webhook id")
 	}
 
 	// autocert manager
@@ -44,25 +62,43 @@ func Listen(conf *config.Config, speaker twitspeak.TwitterSpeaker, resource data
 		Addr:    ":http",
 	}
 
-	// run challenge server
-	go func() {
-		logger.Info("starting autocert challenge server")
-		err := challengeServer.ListenAndServe()
-		if err != nil {
-			logger.WithError(err).Panic("autocert challenge server died")
-		}
-	}()
+	// keep track of every game's health for the ops server
+	health := newHealth(resource, conf.StatusToken, conf.ReadyOnSimulation)
+	logger.AddHook(health)
+	for _, game := range games {
+		game.Dispatcher.SubscribeBestEffort(health.watch(game.Name, game.Resource, schedules[game.Name]))
+	}
+
+	// the ops server is for scraping metrics and checking health from inside
+	// the deployment
+	opsServer := &http.Server{
+		Handler: recoverer("ops", logger, newOpsHandler(health)),
+		Addr:    conf.OpsAddr,
+	}
 
 	// build the twitter webhooks server
-	dmParser := input.NewDMParser(resource, speaker, logger, simulator)
+	dmParser := input.NewDMParser(conf, speaker, logger, games)
 	twitterHandler := newHandler(conf, logger, dmParser, speaker, simLock)
+
+	// the public api, event stream and dashboard are served alongside the
+	// webhooks. the event stream stays open, so everything else is cut off by
+	// its own handler rather than the server
+	cache := newResponseCache(simLock, logger)
+	dispatcher.SubscribeBestEffort(cache)
+	stream := newStream(bus, logger)
+	mux := http.NewServeMux()
+	mux.Handle(apiPrefix, http.TimeoutHandler(newAPI(resource, logger, cache), writeTimeout, ""))
+	mux.Handle(streamPath, stream)
+	mux.Handle(dashboardPrefix, http.TimeoutHandler(newDashboard(resource, logger, cache), writeTimeout, ""))
+	mux.Handle("/", http.TimeoutHandler(twitterHandler, webhookTimeout, ""))
+
 	server := &http.Server{
-		ReadTimeout:  5 * time.Second,
-		WriteTimeout: 5 * time.Second,
-		IdleTimeout:  120 * time.Second,
-		Handler:      twitterHandler,
-		Addr:         ":https",
+		ReadTimeout: 5 * time.Second,
+		IdleTimeout: 120 * time.Second,
+		Handler:     recoverer("public", logger, mux),
+		Addr:        ":https",
 	}
+	server.RegisterOnShutdown(stream.close)
 
 	// start listening on https socket
 	tlsConf := &tls.Config{
@@ -70,37 +106,67 @@ func Listen(conf *config.Config, speaker twitspeak.TwitterSpeaker, resource data
 	}
 	listener, err := tls.Listen("tcp", ":https", tlsConf)
 	if err != nil {
-		logger.WithError(err).Panic("failed listening on https socket")
+		return errors.Wrap(err, "failed listening on https socket")
 	}
 
+	// whatever goes wrong first in the background takes the servers down
+	failures := make(chan error, 4)
+	serve := func(name string, run func() error) {
+		logger.Infof("starting %s", name)
+		err := run()
+		if err != nil && err != http.ErrServerClosed {
+			failures <- errors.Wrapf(err, "failed serving %s", name)
+		}
+	}
+	go serve("ops server", opsServer.ListenAndServe)
+	go serve("autocert challenge server", challengeServer.ListenAndServe)
+	go serve("twitter listener", func() error {
+		return server.Serve(listener)
+	})
+
 	if webhooksID != "" {
 		// trigger a CRC manually
 		go func() {
-			err = speaker.TriggerCRC(webhooksID)
+			err := speaker.TriggerCRC(webhooksID)
 			if err != nil {
-				logger.WithError(err).Panic("error while triggering crc")
+				failures <- errors.Wrap(err, "failed triggering crc")
 			}
 		}()
 	} else {
 		// register the webhook
 		go func(handler *handler) {
 			id, err := speaker.RegisterWebhook()
-			if err != nil || id == "" {
-				logger.WithError(err).Panic("error while registering webhooks url")
+			if err == nil && id == "" {
+				err = errors.New("twitter gave no webhook id")
+			}
+			if err != nil {
+				failures <- errors.Wrap(err, "failed registering webhooks url")
+				return
 			}
 
-			err = resource.SetWebhooksID(context.TODO(), webhooksID)
+			err = resource.SetWebhooksID(ctx, id)
 			if err != nil {
-				logger.WithError(err).Panic("error while setting webhooks id in database")
+				failures <- errors.Wrap(err, "failed setting webhooks id in database")
+				return
 			}
-			handler.WebhooksID = webhooksID
+			handler.WebhooksID = id
 		}(twitterHandler.(*handler))
 	}
 
-	// start serving on the twitter webhooks listener
-	logger.Info("starting twitter listener")
-	err = server.Serve(listener)
-	if err != nil {
-		logger.WithError(err).Panic("twitter listener server died")
+	select {
+	case <-ctx.Done():
+	case err = <-failures:
+	}
+
+	// stop taking requests and let the ones in flight finish
+	logger.Info("shutting down servers")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	for _, s := range []*http.Server{server, challengeServer, opsServer} {
+		shutdownErr := s.Shutdown(shutdownCtx)
+		if shutdownErr != nil {
+			logger.WithError(shutdownErr).Error("failed shutting down server gracefully")
+		}
 	}
+	return err
 }
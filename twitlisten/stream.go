@@ -0,0 +1,129 @@
+package twitlisten
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yisaj/heavens_throne/events"
+
+	"github.com/sirupsen/logrus"
+)
+
+// where live game events are streamed, as server sent events
+const streamPath = apiPrefix + "events"
+
+const (
+	// how many events a client can fall behind before it's dropped
+	streamBuffer = 64
+	// how often idle streams are pinged so proxies don't close them
+	streamHeartbeat = 30 * time.Second
+	// how long dropped clients should wait before reconnecting, in milliseconds
+	streamRetry = 5000
+)
+
+// stream serves the event bus to dashboards and bots as it happens
+type stream struct {
+	bus       *events.Bus
+	logger    *logrus.Logger
+	heartbeat time.Duration
+	closing   chan struct{}
+	closeOnce sync.Once
+}
+
+// newStream constructs the live event stream handler
+func newStream(bus *events.Bus, logger *logrus.Logger) *stream {
+	return &stream{
+		bus,
+		logger,
+		streamHeartbeat,
+		make(chan struct{}),
+		sync.Once{},
+	}
+}
+
+// close ends every open stream, since the server won't wait on them when it
+// shuts down. clients reconnect to wherever the game comes back up
+func (s *stream) close() {
+	s.closeOnce.Do(func() {
+		close(s.closing)
+	})
+}
+
+// ServeHTTP streams events until the client goes away. clients reconnecting
+// with a Last-Event-ID get whatever they missed, as far as the bus remembers,
+// and can pick which events they want with ?types=battle_resolved,...
+func (s *stream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		w.Header().Set("Allow", "GET")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	var wanted map[string]bool
+	if types := r.URL.Query().Get("types"); types != "" {
+		wanted = make(map[string]bool)
+		for _, messageType := range strings.Split(types, ",") {
+			wanted[strings.TrimSpace(messageType)] = true
+		}
+	}
+
+	var subscription <-chan events.Message
+	lastID, err := strconv.ParseUint(r.Header.Get("Last-Event-ID"), 10, 64)
+	if err == nil {
+		subscription = s.bus.Resume(lastID, streamBuffer)
+	} else {
+		subscription = s.bus.Subscribe(streamBuffer)
+	}
+	defer s.bus.Unsubscribe(subscription)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	_, err = fmt.Fprintf(w, "retry: %d\n\n", streamRetry)
+	if err != nil {
+		return
+	}
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(s.heartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-s.closing:
+			return
+		case <-heartbeat.C:
+			_, err = fmt.Fprint(w, ": heartbeat\n\n")
+		case message, ok := <-subscription:
+			if !ok {
+				// fell too far behind. the client comes back with its last id
+				return
+			}
+			if wanted != nil && !wanted[message.Type] {
+				continue
+			}
+			var data []byte
+			data, err = json.Marshal(message)
+			if err != nil {
+				s.logger.WithError(err).Error("failed encoding event")
+				continue
+			}
+			_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", message.ID, message.Type, data)
+		}
+		if err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,75 @@
+package twitlisten
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/yisaj/heavens_throne/events"
+)
+
+// readEvent reads the next event off a stream, skipping comments and retry hints
+func readEvent(t *testing.T, reader *bufio.Reader) []string {
+	var lines []string
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "" && len(lines) > 0:
+			return lines
+		case line == "", strings.HasPrefix(line, ":"), strings.HasPrefix(line, "retry:"):
+		default:
+			lines = append(lines, line)
+		}
+	}
+}
+
+func TestStream(t *testing.T) {
+	bus := events.NewBus()
+	server := httptest.NewServer(newStream(bus, newTestLogger()))
+	defer server.Close()
+
+	bus.Publish("simulation_started", events.Simulation{Day: 4})
+
+	request, err := http.NewRequest("GET", server.URL+"?types=battle_resolved,simulation_finished", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.Header.Set("Last-Event-ID", "0")
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+	if response.Header.Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("expected an event stream, got %s", response.Header.Get("Content-Type"))
+	}
+
+	// the stream is subscribed once the headers are in
+	bus.Publish("player_joined", events.Join{MartialOrder: "The Baaturate"})
+	bus.Publish("simulation_finished", events.Simulation{Day: 4})
+
+	reader := bufio.NewReader(response.Body)
+	expected := []string{
+		"id: 3",
+		"event: simulation_finished",
+		`data: {"id":3,"type":"simulation_finished","time":`,
+	}
+	lines := readEvent(t, reader)
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %d lines, got %q", len(expected), lines)
+	}
+	for i := range expected {
+		if !strings.HasPrefix(lines[i], expected[i]) {
+			t.Errorf("expected %q, got %q", expected[i], lines[i])
+		}
+	}
+	if !strings.HasSuffix(lines[2], `"data":{"day":4}}`) {
+		t.Errorf("expected the simulation data, got %q", lines[2])
+	}
+}
@@ -31,6 +31,8 @@ const (
 	nonceRunes   = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ1234567890"
 	nonceMax     = 6
 	nonceMask    = 1<<uint(nonceMax) - 1
+	// twitter allows at most 20 quick reply options per message
+	maxQuickReplyOptions = 20
 )
 
 var (
@@ -49,11 +51,20 @@ type TwitterSpeaker interface {
 	GetWebhook() (string, error)
 	RegisterWebhook() (string, error)
 	SendDM(userID string, msg string) error
+	SendDMWithOptions(userID string, msg string, options []QuickReplyOption) error
 	SubscribeUser() error
 	Tweet(msg string, target string, mediaID string) (string, error)
 	UploadPNG(filename string) (string, error)
 }
 
+// QuickReplyOption is a button offered under a DM. pressing it sends the label
+// back as the player's reply, along with the metadata
+type QuickReplyOption struct {
+	Label       string `json:"label"`
+	Description string `json:"description,omitempty"`
+	Metadata    string `json:"metadata,omitempty"`
+}
+
 // twitterError is the standard error format for a twitter api error
 type twitterError struct {
 	Message string
@@ -80,7 +91,8 @@ func mergeTwitterErrors(te []twitterError) error {
 // NewSpeaker returns a new speaker to send messages to the twitter api with
 func NewSpeaker(conf *config.Config, logger *logrus.Logger) TwitterSpeaker {
 	client := &http.Client{
-		Timeout: time.Second * 10,
+		Transport: meteredTransport{http.DefaultTransport},
+		Timeout:   time.Second * 10,
 	}
 
 	return &speaker{
@@ -337,18 +349,49 @@ func (s *speaker) RegisterWebhook() (string, error) {
 
 // SendDM sends a twitter direct message to a given user
 func (s *speaker) SendDM(userID string, msg string) error {
-	// escape common control characters
-	replacer := strings.NewReplacer("\n", `\n`, "\r", `\r`, "\t", `\t`)
-	msg = replacer.Replace(msg)
+	return s.SendDMWithOptions(userID, msg, nil)
+}
 
+// SendDMWithOptions sends a direct message to a user with a menu of quick reply
+// buttons. options past the api limit are dropped
+func (s *speaker) SendDMWithOptions(userID string, msg string, options []QuickReplyOption) error {
 	sendDMPath := "/direct_messages/events/new.json"
-	eventFmt := `{"event": { "type": "message_create", 
-		"message_create": {
-			"target": {"recipient_id": "%s"},
-			"message_data":{"text": "%s"}}}}`
-	eventString := fmt.Sprintf(eventFmt, userID, msg)
 
-	req, err := http.NewRequest("POST", apiPrefix+sendDMPath, strings.NewReader(eventString))
+	type quickReply struct {
+		Type    string             `json:"type"`
+		Options []QuickReplyOption `json:"options"`
+	}
+	type messageData struct {
+		Text       string      `json:"text"`
+		QuickReply *quickReply `json:"quick_reply,omitempty"`
+	}
+	var event struct {
+		Event struct {
+			Type          string `json:"type"`
+			MessageCreate struct {
+				Target struct {
+					RecipientID string `json:"recipient_id"`
+				} `json:"target"`
+				MessageData messageData `json:"message_data"`
+			} `json:"message_create"`
+		} `json:"event"`
+	}
+	event.Event.Type = "message_create"
+	event.Event.MessageCreate.Target.RecipientID = userID
+	event.Event.MessageCreate.MessageData.Text = msg
+	if len(options) > 0 {
+		if len(options) > maxQuickReplyOptions {
+			options = options[:maxQuickReplyOptions]
+		}
+		event.Event.MessageCreate.MessageData.QuickReply = &quickReply{"options", options}
+	}
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(err, "failed encoding direct message event")
+	}
+
+	req, err := http.NewRequest("POST", apiPrefix+sendDMPath, bytes.NewReader(eventBytes))
 	if err != nil {
 		return errors.Wrap(err, "failed building post direct message request")
 	}
@@ -0,0 +1,35 @@
+package twitspeak
+
+import (
+	"strings"
+)
+
+// labeledSpeaker heads every DM with a label. everything else goes straight
+// through to the real speaker
+type labeledSpeaker struct {
+	TwitterSpeaker
+	label string
+}
+
+// NewLabeledSpeaker constructs a speaker that heads every DM with the label in
+// brackets, so players in more than one game can tell whose DMs are whose
+func NewLabeledSpeaker(speaker TwitterSpeaker, label string) TwitterSpeaker {
+	return &labeledSpeaker{
+		speaker,
+		label,
+	}
+}
+
+// SendDM sends a labeled DM
+func (s *labeledSpeaker) SendDM(userID string, msg string) error {
+	return s.SendDMWithOptions(userID, msg, nil)
+}
+
+// SendDMWithOptions sends a labeled DM with quick reply options
+func (s *labeledSpeaker) SendDMWithOptions(userID string, msg string, options []QuickReplyOption) error {
+	header := "[" + strings.ToUpper(s.label) + "]"
+	if !strings.HasPrefix(msg, "\n") {
+		header += "\n"
+	}
+	return s.TwitterSpeaker.SendDMWithOptions(userID, header+msg, options)
+}
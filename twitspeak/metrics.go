@@ -0,0 +1,69 @@
+package twitspeak
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yisaj/heavens_throne/metrics"
+)
+
+var (
+	twitterRequests = metrics.NewCounter("twitter_requests_total",
+		"Requests made to twitter, by endpoint, method and response status.", "endpoint", "method", "status")
+	twitterDuration = metrics.NewHistogram("twitter_request_duration_seconds",
+		"How long requests to twitter took, by endpoint.", metrics.DefaultBuckets, "endpoint")
+	rateLimitLimit = metrics.NewGauge("twitter_rate_limit_limit",
+		"Requests allowed per rate limit window, from twitter's latest response, by endpoint.", "endpoint")
+	rateLimitRemaining = metrics.NewGauge("twitter_rate_limit_remaining",
+		"Requests left in the current rate limit window, from twitter's latest response, by endpoint.", "endpoint")
+	rateLimitReset = metrics.NewGauge("twitter_rate_limit_reset_timestamp_seconds",
+		"When the current rate limit window resets, from twitter's latest response, by endpoint.", "endpoint")
+)
+
+// meteredTransport counts and times every request to twitter, and keeps track
+// of how much of each rate limit is left
+type meteredTransport struct {
+	next http.RoundTripper
+}
+
+// RoundTrip makes the request with the next transport, taking note of how it went
+func (t meteredTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoint := endpointLabel(req.URL)
+	started := time.Now()
+	res, err := t.next.RoundTrip(req)
+	twitterDuration.Observe(time.Since(started).Seconds(), endpoint)
+	if err != nil {
+		twitterRequests.Inc(endpoint, req.Method, "error")
+		return res, err
+	}
+	twitterRequests.Inc(endpoint, req.Method, strconv.Itoa(res.StatusCode))
+
+	// not every endpoint is rate limited
+	for header, gauge := range map[string]*metrics.Gauge{
+		"X-Rate-Limit-Limit":     rateLimitLimit,
+		"X-Rate-Limit-Remaining": rateLimitRemaining,
+		"X-Rate-Limit-Reset":     rateLimitReset,
+	} {
+		value, err := strconv.ParseFloat(res.Header.Get(header), 64)
+		if err == nil {
+			gauge.Set(value, endpoint)
+		}
+	}
+	return res, nil
+}
+
+// endpointLabel names the endpoint a request goes to, with ids taken out so
+// every request to an endpoint shares a label
+func endpointLabel(u *url.URL) string {
+	segments := strings.Split(u.Path, "/")
+	for i, segment := range segments {
+		id := strings.TrimSuffix(segment, ".json")
+		if _, err := strconv.ParseUint(id, 10, 64); err == nil {
+			segments[i] = ":id" + strings.TrimPrefix(segment, id)
+		}
+	}
+	return u.Host + strings.Join(segments, "/")
+}